@@ -28,13 +28,57 @@ func VerifyAccountBalance(
 	from common.Address,
 	ethTx *ethtypes.Transaction,
 ) error {
+	account, err := verifySenderAccount(ctx, evmKeeper, accountKeeper, account, from)
+	if err != nil {
+		return err
+	}
+
+	if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(account.Balance.ToBig()), ethTx); err != nil {
+		return errorsmod.Wrap(err, "failed to check sender balance")
+	}
+
+	return nil
+}
+
+// VerifySponsoredAccountBalance checks that the account balance is greater than the value of a
+// transaction whose fees are paid by a sponsor. Like VerifyAccountBalance, the account will be set
+// to store if it doesn't exist and the method fails if the from address is NOT an EOA.
+func VerifySponsoredAccountBalance(
+	ctx sdk.Context,
+	evmKeeper anteinterfaces.EVMKeeper,
+	accountKeeper anteinterfaces.AccountKeeper,
+	account *statedb.Account,
+	from common.Address,
+	ethTx *ethtypes.Transaction,
+) error {
+	account, err := verifySenderAccount(ctx, evmKeeper, accountKeeper, account, from)
+	if err != nil {
+		return err
+	}
+
+	if err := keeper.CheckSponsoredSenderBalance(sdkmath.NewIntFromBigInt(account.Balance.ToBig()), ethTx); err != nil {
+		return errorsmod.Wrap(err, "failed to check sender balance")
+	}
+
+	return nil
+}
+
+// verifySenderAccount checks that the sender is an EOA and creates its account if it doesn't
+// exist, in which case the returned account is empty.
+func verifySenderAccount(
+	ctx sdk.Context,
+	evmKeeper anteinterfaces.EVMKeeper,
+	accountKeeper anteinterfaces.AccountKeeper,
+	account *statedb.Account,
+	from common.Address,
+) (*statedb.Account, error) {
 	// Only EOA are allowed to send transactions.
 	if account != nil && account.HasCodeHash() {
 		// check eip-7702
 		code := evmKeeper.GetCode(ctx, common.BytesToHash(account.CodeHash))
 		_, delegated := ethtypes.ParseDelegation(code)
 		if len(code) > 0 && !delegated {
-			return errorsmod.Wrapf(
+			return nil, errorsmod.Wrapf(
 				errortypes.ErrInvalidType,
 				"the sender is not EOA: address %s", from,
 			)
@@ -45,10 +89,5 @@ func VerifyAccountBalance(
 		accountKeeper.SetAccount(ctx, acc)
 		account = statedb.NewEmptyAccount()
 	}
-
-	if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(account.Balance.ToBig()), ethTx); err != nil {
-		return errorsmod.Wrap(err, "failed to check sender balance")
-	}
-
-	return nil
+	return account, nil
 }
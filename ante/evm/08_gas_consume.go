@@ -61,6 +61,32 @@ func ConsumeFeesAndEmitEvent(
 	return nil
 }
 
+// ConsumeSponsoredFeesAndEmitEvent deduces the fees of a tx of the sender calling a sponsored
+// contract from its sponsor and emits the event
+func ConsumeSponsoredFeesAndEmitEvent(
+	ctx sdktypes.Context,
+	evmKeeper anteinterfaces.EVMKeeper,
+	fees sdktypes.Coins,
+	contract common.Address,
+	sender common.Address,
+	sponsor common.Address,
+) error {
+	if !fees.IsZero() {
+		if err := evmKeeper.DeductTxCostsFromSponsor(ctx, fees, contract, sender); err != nil {
+			return errorsmod.Wrapf(err, "failed to deduct transaction costs from sponsor balance")
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdktypes.NewEvent(
+			sdktypes.EventTypeTx,
+			sdktypes.NewAttribute(sdktypes.AttributeKeyFee, fees.String()),
+			sdktypes.NewAttribute(sdktypes.AttributeKeyFeePayer, sdktypes.AccAddress(sponsor.Bytes()).String()),
+		),
+	)
+	return nil
+}
+
 // deductFee checks if the fee payer has enough funds to pay for the fees and deducts them.
 func deductFees(
 	ctx sdktypes.Context,
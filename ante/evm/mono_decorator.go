@@ -174,12 +174,20 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 	from := ethMsg.GetFrom()
 	fromAddr := common.BytesToAddress(from)
 
+	// The fees of the txs calling a sponsored contract are paid by the
+	// sponsor, if the sponsorship covers them.
+	sponsor, sponsored := md.evmKeeper.GetTxSponsor(ctx, ethTx, fromAddr)
+
 	// 6. account balance verification
 	// We get the account with the balance from the EVM keeper because it is
 	// using a wrapper of the bank keeper as a dependency to scale all
 	// balances to 18 decimals.
 	account := md.evmKeeper.GetAccount(ctx, fromAddr)
-	if err := VerifyAccountBalance(
+	verifyBalance := VerifyAccountBalance
+	if sponsored {
+		verifyBalance = VerifySponsoredAccountBalance
+	}
+	if err := verifyBalance(
 		ctx,
 		md.evmKeeper,
 		md.accountKeeper,
@@ -217,12 +225,23 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		return ctx, err
 	}
 
-	err = ConsumeFeesAndEmitEvent(
-		ctx,
-		md.evmKeeper,
-		msgFees,
-		from,
-	)
+	if sponsored {
+		err = ConsumeSponsoredFeesAndEmitEvent(
+			ctx,
+			md.evmKeeper,
+			msgFees,
+			*ethTx.To(),
+			fromAddr,
+			sponsor,
+		)
+	} else {
+		err = ConsumeFeesAndEmitEvent(
+			ctx,
+			md.evmKeeper,
+			msgFees,
+			from,
+		)
+	}
 	if err != nil {
 		return ctx, err
 	}
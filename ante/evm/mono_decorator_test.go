@@ -54,6 +54,14 @@ func (k *ExtendedEVMKeeper) DeductTxCostsFromUserBalance(_ sdk.Context, _ sdk.Co
 	return nil
 }
 
+func (k *ExtendedEVMKeeper) GetTxSponsor(_ sdk.Context, _ *ethtypes.Transaction, _ common.Address) (common.Address, bool) {
+	return common.Address{}, false
+}
+
+func (k *ExtendedEVMKeeper) DeductTxCostsFromSponsor(_ sdk.Context, _ sdk.Coins, _, _ common.Address) error {
+	return nil
+}
+
 func (k *ExtendedEVMKeeper) SpendableCoin(ctx sdk.Context, addr common.Address) *uint256.Int {
 	account := k.GetAccount(ctx, addr)
 	if account != nil {
@@ -4,6 +4,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/holiman/uint256"
 
@@ -22,6 +23,8 @@ type EVMKeeper interface {
 	NewEVM(ctx sdk.Context, msg core.Message, cfg *statedb.EVMConfig, tracer *tracing.Hooks,
 		stateDB vm.StateDB) *vm.EVM
 	DeductTxCostsFromUserBalance(ctx sdk.Context, fees sdk.Coins, from common.Address) error
+	GetTxSponsor(ctx sdk.Context, ethTx *ethtypes.Transaction, sender common.Address) (common.Address, bool)
+	DeductTxCostsFromSponsor(ctx sdk.Context, fees sdk.Coins, contract, sender common.Address) error
 	SpendableCoin(ctx sdk.Context, addr common.Address) *uint256.Int
 	GetParams(ctx sdk.Context) evmtypes.Params
 }
@@ -19,9 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_EthereumTx_FullMethodName          = "/cosmos.evm.vm.v1.Msg/EthereumTx"
-	Msg_UpdateParams_FullMethodName        = "/cosmos.evm.vm.v1.Msg/UpdateParams"
-	Msg_RegisterPreinstalls_FullMethodName = "/cosmos.evm.vm.v1.Msg/RegisterPreinstalls"
+	Msg_EthereumTx_FullMethodName             = "/cosmos.evm.vm.v1.Msg/EthereumTx"
+	Msg_UpdateParams_FullMethodName           = "/cosmos.evm.vm.v1.Msg/UpdateParams"
+	Msg_RegisterPreinstalls_FullMethodName    = "/cosmos.evm.vm.v1.Msg/RegisterPreinstalls"
+	Msg_ScheduleForkActivation_FullMethodName = "/cosmos.evm.vm.v1.Msg/ScheduleForkActivation"
+	Msg_UpdatePermissions_FullMethodName      = "/cosmos.evm.vm.v1.Msg/UpdatePermissions"
+	Msg_SetSponsorship_FullMethodName         = "/cosmos.evm.vm.v1.Msg/SetSponsorship"
+	Msg_RemoveSponsorship_FullMethodName      = "/cosmos.evm.vm.v1.Msg/RemoveSponsorship"
 )
 
 // MsgClient is the client API for Msg service.
@@ -38,6 +42,21 @@ type MsgClient interface {
 	// preinstalled contracts in the EVM. The authority is the same as is used for
 	// Params updates.
 	RegisterPreinstalls(ctx context.Context, in *MsgRegisterPreinstalls, opts ...grpc.CallOption) (*MsgRegisterPreinstallsResponse, error)
+	// ScheduleForkActivation defines a governance operation for scheduling the
+	// activation of an Ethereum hard fork at a future block height or time. The
+	// authority is the same as is used for Params updates.
+	ScheduleForkActivation(ctx context.Context, in *MsgScheduleForkActivation, opts ...grpc.CallOption) (*MsgScheduleForkActivationResponse, error)
+	// UpdatePermissions defines a governance operation for adding and removing
+	// entries of the on-chain permission lists. The authority is the same as is
+	// used for Params updates.
+	UpdatePermissions(ctx context.Context, in *MsgUpdatePermissions, opts ...grpc.CallOption) (*MsgUpdatePermissionsResponse, error)
+	// SetSponsorship defines a method for an account to pay the fees of the
+	// Ethereum transactions calling a contract. Only the sponsor of a contract
+	// can update its sponsorship.
+	SetSponsorship(ctx context.Context, in *MsgSetSponsorship, opts ...grpc.CallOption) (*MsgSetSponsorshipResponse, error)
+	// RemoveSponsorship defines a method for removing the sponsorship of a
+	// contract, either by its sponsor or by the governance authority.
+	RemoveSponsorship(ctx context.Context, in *MsgRemoveSponsorship, opts ...grpc.CallOption) (*MsgRemoveSponsorshipResponse, error)
 }
 
 type msgClient struct {
@@ -75,6 +94,42 @@ func (c *msgClient) RegisterPreinstalls(ctx context.Context, in *MsgRegisterPrei
 	return out, nil
 }
 
+func (c *msgClient) ScheduleForkActivation(ctx context.Context, in *MsgScheduleForkActivation, opts ...grpc.CallOption) (*MsgScheduleForkActivationResponse, error) {
+	out := new(MsgScheduleForkActivationResponse)
+	err := c.cc.Invoke(ctx, Msg_ScheduleForkActivation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdatePermissions(ctx context.Context, in *MsgUpdatePermissions, opts ...grpc.CallOption) (*MsgUpdatePermissionsResponse, error) {
+	out := new(MsgUpdatePermissionsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdatePermissions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSponsorship(ctx context.Context, in *MsgSetSponsorship, opts ...grpc.CallOption) (*MsgSetSponsorshipResponse, error) {
+	out := new(MsgSetSponsorshipResponse)
+	err := c.cc.Invoke(ctx, Msg_SetSponsorship_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveSponsorship(ctx context.Context, in *MsgRemoveSponsorship, opts ...grpc.CallOption) (*MsgRemoveSponsorshipResponse, error) {
+	out := new(MsgRemoveSponsorshipResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveSponsorship_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -89,6 +144,21 @@ type MsgServer interface {
 	// preinstalled contracts in the EVM. The authority is the same as is used for
 	// Params updates.
 	RegisterPreinstalls(context.Context, *MsgRegisterPreinstalls) (*MsgRegisterPreinstallsResponse, error)
+	// ScheduleForkActivation defines a governance operation for scheduling the
+	// activation of an Ethereum hard fork at a future block height or time. The
+	// authority is the same as is used for Params updates.
+	ScheduleForkActivation(context.Context, *MsgScheduleForkActivation) (*MsgScheduleForkActivationResponse, error)
+	// UpdatePermissions defines a governance operation for adding and removing
+	// entries of the on-chain permission lists. The authority is the same as is
+	// used for Params updates.
+	UpdatePermissions(context.Context, *MsgUpdatePermissions) (*MsgUpdatePermissionsResponse, error)
+	// SetSponsorship defines a method for an account to pay the fees of the
+	// Ethereum transactions calling a contract. Only the sponsor of a contract
+	// can update its sponsorship.
+	SetSponsorship(context.Context, *MsgSetSponsorship) (*MsgSetSponsorshipResponse, error)
+	// RemoveSponsorship defines a method for removing the sponsorship of a
+	// contract, either by its sponsor or by the governance authority.
+	RemoveSponsorship(context.Context, *MsgRemoveSponsorship) (*MsgRemoveSponsorshipResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -105,6 +175,18 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) RegisterPreinstalls(context.Context, *MsgRegisterPreinstalls) (*MsgRegisterPreinstallsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterPreinstalls not implemented")
 }
+func (UnimplementedMsgServer) ScheduleForkActivation(context.Context, *MsgScheduleForkActivation) (*MsgScheduleForkActivationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleForkActivation not implemented")
+}
+func (UnimplementedMsgServer) UpdatePermissions(context.Context, *MsgUpdatePermissions) (*MsgUpdatePermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePermissions not implemented")
+}
+func (UnimplementedMsgServer) SetSponsorship(context.Context, *MsgSetSponsorship) (*MsgSetSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSponsorship not implemented")
+}
+func (UnimplementedMsgServer) RemoveSponsorship(context.Context, *MsgRemoveSponsorship) (*MsgRemoveSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSponsorship not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -172,6 +254,78 @@ func _Msg_RegisterPreinstalls_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ScheduleForkActivation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleForkActivation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleForkActivation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ScheduleForkActivation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleForkActivation(ctx, req.(*MsgScheduleForkActivation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdatePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePermissions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdatePermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePermissions(ctx, req.(*MsgUpdatePermissions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSponsorship)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetSponsorship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSponsorship(ctx, req.(*MsgSetSponsorship))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveSponsorship)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveSponsorship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveSponsorship(ctx, req.(*MsgRemoveSponsorship))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,6 +345,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterPreinstalls",
 			Handler:    _Msg_RegisterPreinstalls_Handler,
 		},
+		{
+			MethodName: "ScheduleForkActivation",
+			Handler:    _Msg_ScheduleForkActivation_Handler,
+		},
+		{
+			MethodName: "UpdatePermissions",
+			Handler:    _Msg_UpdatePermissions_Handler,
+		},
+		{
+			MethodName: "SetSponsorship",
+			Handler:    _Msg_SetSponsorship_Handler,
+		},
+		{
+			MethodName: "RemoveSponsorship",
+			Handler:    _Msg_RemoveSponsorship_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/vm/v1/tx.proto",
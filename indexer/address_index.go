@@ -0,0 +1,173 @@
+package indexer
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	dbm "github.com/cosmos/cosmos-db"
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	KeyPrefixAddress     = 3
+	KeyPrefixSenderNonce = 4
+
+	// AddressKeyLength is the length of the address appearance key
+	AddressKeyLength = 1 + common.AddressLength + 8 + 8
+)
+
+// SetAddressIndex enables or disables the address indexes. When enabled,
+// IndexBlock also records the addresses involved in every eth tx together
+// with their roles: sender, recipient, created contract and log emitters.
+func (kv *KVIndexer) SetAddressIndex(enabled bool) {
+	kv.addressIndex = enabled
+}
+
+// AddressIndexEnabled returns true if the address indexes are built.
+func (kv *KVIndexer) AddressIndexEnabled() bool {
+	return kv.addressIndex
+}
+
+// GetByAddress returns up to limit eth txs in which the address appears with
+// one of the given roles, starting from the given position, included, towards
+// the older txs if reverse is true or the newer ones otherwise. It also
+// returns the position of the next page, nil if there is none.
+func (kv *KVIndexer) GetByAddress(
+	address common.Address,
+	roles servertypes.AddressRole,
+	start servertypes.TxPosition,
+	reverse bool,
+	limit int,
+) ([]*servertypes.AddressTxResult, *servertypes.TxPosition, error) {
+	var (
+		it  dbm.Iterator
+		err error
+	)
+	if reverse {
+		// keys have a fixed length, so the start position is included
+		end := append(AddressKey(address, start.Height, start.EthTxIndex), 0)
+		it, err = kv.db.ReverseIterator(addressPrefix(address), end)
+	} else {
+		it, err = kv.db.Iterator(AddressKey(address, start.Height, start.EthTxIndex), addressPrefixEnd(address))
+	}
+	if err != nil {
+		return nil, nil, errorsmod.Wrapf(err, "GetByAddress %s", address.Hex())
+	}
+	defer it.Close()
+
+	var results []*servertypes.AddressTxResult
+	for ; it.Valid(); it.Next() {
+		position, err := parseAddressKey(it.Key())
+		if err != nil {
+			return nil, nil, err
+		}
+		txHash, txRoles, err := parseAddressValue(it.Value())
+		if err != nil {
+			return nil, nil, err
+		}
+		if txRoles&roles == 0 {
+			continue
+		}
+		if len(results) >= limit {
+			return results, position, nil
+		}
+		res, err := kv.GetByTxHash(txHash)
+		if err != nil {
+			return nil, nil, err
+		}
+		results = append(results, &servertypes.AddressTxResult{TxHash: txHash, Roles: txRoles, Result: res})
+	}
+	return results, nil, nil
+}
+
+// GetTxHashBySenderAndNonce returns the hash of the eth tx sent by the given
+// address with the given nonce, returns nil if not found.
+func (kv *KVIndexer) GetTxHashBySenderAndNonce(sender common.Address, nonce uint64) (*common.Hash, error) {
+	bz, err := kv.db.Get(SenderNonceKey(sender, nonce))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetTxHashBySenderAndNonce %s %d", sender.Hex(), nonce)
+	}
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	hash := common.BytesToHash(bz)
+	return &hash, nil
+}
+
+// AddressKey returns the key for db entry: `(address, block number, tx index) -> (tx hash, roles)`
+func AddressKey(address common.Address, blockNumber int64, txIndex int32) []byte {
+	bz1 := sdk.Uint64ToBigEndian(uint64(blockNumber)) //nolint:gosec // G115 // block number won't exceed uint64
+	bz2 := sdk.Uint64ToBigEndian(uint64(txIndex))     //nolint:gosec // G115 // index won't exceed uint64
+	return append(append(addressPrefix(address), bz1...), bz2...)
+}
+
+// SenderNonceKey returns the key for db entry: `(sender, nonce) -> tx hash`
+func SenderNonceKey(sender common.Address, nonce uint64) []byte {
+	return append(append([]byte{KeyPrefixSenderNonce}, sender.Bytes()...), sdk.Uint64ToBigEndian(nonce)...)
+}
+
+func addressPrefix(address common.Address) []byte {
+	return append([]byte{KeyPrefixAddress}, address.Bytes()...)
+}
+
+func addressPrefixEnd(address common.Address) []byte {
+	return storetypes.PrefixEndBytes(addressPrefix(address))
+}
+
+// saveAddressAppearances index the addresses involved in the eth tx into the kv db batch
+func saveAddressAppearances(batch dbm.Batch, msg *evmtypes.MsgEthereumTx, txResult *servertypes.TxResult, logs []*ethtypes.Log) error {
+	txHash := msg.Hash()
+	tx := msg.AsTransaction()
+	sender := msg.GetSender()
+
+	roles := map[common.Address]servertypes.AddressRole{sender: servertypes.AddressRoleSender}
+	if to := tx.To(); to != nil {
+		roles[*to] |= servertypes.AddressRoleRecipient
+	} else {
+		roles[crypto.CreateAddress(sender, tx.Nonce())] |= servertypes.AddressRoleContractCreated
+	}
+	for _, ethLog := range logs {
+		roles[ethLog.Address] |= servertypes.AddressRoleLogEmitter
+	}
+
+	for address, role := range roles {
+		value := append(txHash.Bytes(), byte(role))
+		if err := batch.Set(AddressKey(address, txResult.Height, txResult.EthTxIndex), value); err != nil {
+			return errorsmod.Wrap(err, "set address key")
+		}
+	}
+	if err := batch.Set(SenderNonceKey(sender, tx.Nonce()), txHash.Bytes()); err != nil {
+		return errorsmod.Wrap(err, "set sender-nonce key")
+	}
+	return nil
+}
+
+// parseAddressKey returns the tx position of an address key
+func parseAddressKey(key []byte) (*servertypes.TxPosition, error) {
+	if len(key) != AddressKeyLength {
+		return nil, fmt.Errorf("wrong address key length, expect: %d, got: %d", AddressKeyLength, len(key))
+	}
+
+	offset := 1 + common.AddressLength
+	return &servertypes.TxPosition{
+		Height:     int64(sdk.BigEndianToUint64(key[offset : offset+8])),    //#nosec G115 -- block number is unlikely to exceed int64
+		EthTxIndex: int32(sdk.BigEndianToUint64(key[offset+8 : offset+16])), //#nosec G115 -- index was encoded from an int32
+	}, nil
+}
+
+// parseAddressValue returns the tx hash and the roles of an address entry
+func parseAddressValue(value []byte) (common.Hash, servertypes.AddressRole, error) {
+	if len(value) != common.HashLength+1 {
+		return common.Hash{}, 0, fmt.Errorf("wrong address value length, expect: %d, got: %d", common.HashLength+1, len(value))
+	}
+	return common.BytesToHash(value[:common.HashLength]), servertypes.AddressRole(value[common.HashLength]), nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmttypes "github.com/cometbft/cometbft/types"
@@ -37,11 +38,18 @@ type KVIndexer struct {
 	db        dbm.DB
 	logger    log.Logger
 	clientCtx client.Context
+
+	// addressIndex enables the optional address appearance index
+	addressIndex bool
+	// logIndex enables the optional log index
+	logIndex bool
+	// syntheticLogs enables the synthetic logs in the log index
+	syntheticLogs bool
 }
 
 // NewKVIndexer creates the KVIndexer
 func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVIndexer {
-	return &KVIndexer{db, logger, clientCtx}
+	return &KVIndexer{db: db, logger: logger, clientCtx: clientCtx}
 }
 
 // IndexBlock index all the eth txs in a block through the following steps:
@@ -50,6 +58,22 @@ func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVInd
 // - Iterates over all the messages of the Tx
 // - Builds and stores a indexer.TxResult based on parsed events for every message
 func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
+	return kv.indexBlock(block, txResults, true, kv.addressIndex, kv.logIndex)
+}
+
+// IndexBlockAddresses only builds the address indexes of the eth txs in a
+// block, it's used to backfill the address indexes of an existing db.
+func (kv *KVIndexer) IndexBlockAddresses(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
+	return kv.indexBlock(block, txResults, false, true, false)
+}
+
+// IndexBlockLogs only builds the log index of a block, it's used to backfill
+// the log index of an existing db.
+func (kv *KVIndexer) IndexBlockLogs(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
+	return kv.indexBlock(block, txResults, false, false, true)
+}
+
+func (kv *KVIndexer) indexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult, saveTxs, saveAddresses, saveLogs bool) error {
 	height := block.Height
 
 	batch := kv.db.NewBatch()
@@ -112,9 +136,39 @@ func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxR
 			txResult.CumulativeGasUsed = cumulativeGasUsed
 			ethTxIndex++
 
-			if err := saveTxResult(kv.clientCtx.Codec, batch, txHash, &txResult); err != nil {
-				return errorsmod.Wrapf(err, "IndexBlock %d", height)
+			if saveTxs {
+				if err := saveTxResult(kv.clientCtx.Codec, batch, txHash, &txResult); err != nil {
+					return errorsmod.Wrapf(err, "IndexBlock %d", height)
+				}
 			}
+
+			var logs []*ethtypes.Log
+			if (saveAddresses || saveLogs) && result.Code == abci.CodeTypeOK {
+				logs, err = evmtypes.DecodeMsgLogs(result.Data, msgIndex, uint64(height)) //#nosec G115 -- height is positive
+				if err != nil {
+					kv.logger.Error("Fail to decode logs", "err", err, "block", height, "txIndex", txIndex, "msgIndex", msgIndex)
+				}
+			}
+			if saveAddresses {
+				if err := saveAddressAppearances(batch, ethMsg, &txResult, logs); err != nil {
+					return errorsmod.Wrapf(err, "IndexBlock %d", height)
+				}
+			}
+			if saveLogs {
+				if err := saveLogPostings(batch, height, logs); err != nil {
+					return errorsmod.Wrapf(err, "IndexBlock %d", height)
+				}
+			}
+		}
+	}
+	if saveLogs && kv.syntheticLogs {
+		if err := kv.saveSyntheticLogPostings(batch, block, txResults); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
+		}
+	}
+	if saveLogs {
+		if err := kv.saveLogIndexRange(batch, height); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
 		}
 	}
 	if err := batch.Write(); err != nil {
@@ -0,0 +1,443 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	KeyPrefixLogAddress = 5
+	KeyPrefixLogTopic   = 6
+	KeyLogIndexRange    = 7
+
+	// logPositionLength is the length of the (block number, log index) suffix of the log keys
+	logPositionLength = 8 + 8
+)
+
+// SetLogIndex enables or disables the log index. When enabled, IndexBlock
+// also records the emitter address and the topics of every log into posting
+// lists: `(address, block number, log index)` and
+// `(topic position, topic, block number, log index)`.
+func (kv *KVIndexer) SetLogIndex(enabled bool) {
+	kv.logIndex = enabled
+}
+
+// SetSyntheticLogs enables or disables the indexing of the synthetic Transfer
+// logs of the bank transfers of the Cosmos txs in the log index, see
+// rpctypes.SyntheticLogs.
+func (kv *KVIndexer) SetSyntheticLogs(enabled bool) {
+	kv.syntheticLogs = enabled
+}
+
+// LogIndexEnabled returns true if the log index is built.
+func (kv *KVIndexer) LogIndexEnabled() bool {
+	return kv.logIndex
+}
+
+// LogIndexedRange returns the latest contiguous range of blocks covered by the
+// log index, returns -1, -1 if the log index is empty.
+func (kv *KVIndexer) LogIndexedRange() (int64, int64, error) {
+	it, err := kv.db.ReverseIterator([]byte{KeyLogIndexRange}, []byte{KeyLogIndexRange + 1})
+	if err != nil {
+		return 0, 0, errorsmod.Wrap(err, "LogIndexedRange")
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, -1, it.Error()
+	}
+	return parseLogIndexRange(it.Key(), it.Value())
+}
+
+// LogIndexedRangeAt returns the contiguous range of blocks covered by the log
+// index which contains the block, returns -1, -1 if the block isn't covered.
+func (kv *KVIndexer) LogIndexedRangeAt(height int64) (int64, int64, error) {
+	first, last, err := kv.logIndexedRangeFrom(height)
+	if err != nil {
+		return 0, 0, errorsmod.Wrap(err, "LogIndexedRangeAt")
+	}
+	if first == -1 || last < height {
+		return -1, -1, nil
+	}
+	return first, last, nil
+}
+
+// logIndexedRangeFrom returns the covered range with the greatest first block
+// not after the given block, -1, -1 if there is none.
+func (kv *KVIndexer) logIndexedRangeFrom(height int64) (int64, int64, error) {
+	it, err := kv.db.ReverseIterator([]byte{KeyLogIndexRange}, LogIndexRangeKey(height+1))
+	if err != nil {
+		return 0, 0, err
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, -1, it.Error()
+	}
+	return parseLogIndexRange(it.Key(), it.Value())
+}
+
+// GetLogBlocks returns the sorted numbers of the blocks in [from, to] that
+// contain a log matching the addresses and the topics, with the semantics of
+// eth_getLogs. At least one address or topic is required.
+//
+// The posting lists are merged while they are iterated, so the memory used
+// doesn't depend on the number of matching logs.
+func (kv *KVIndexer) GetLogBlocks(addresses []common.Address, topics [][]common.Hash, from, to int64) (_ []int64, err error) {
+	var criteria []logPostings
+	defer func() {
+		for _, c := range criteria {
+			c.close()
+		}
+	}()
+	addCriterion := func(prefixes [][]byte) error {
+		union, err := kv.newLogUnion(prefixes, from, to)
+		if err != nil {
+			return err
+		}
+		criteria = append(criteria, union)
+		return nil
+	}
+
+	if len(addresses) > 0 {
+		prefixes := make([][]byte, len(addresses))
+		for i, address := range addresses {
+			prefixes[i] = logAddressPrefix(address)
+		}
+		if err := addCriterion(prefixes); err != nil {
+			return nil, err
+		}
+	}
+	for position, sub := range topics {
+		if len(sub) == 0 {
+			// wildcard
+			continue
+		}
+		prefixes := make([][]byte, len(sub))
+		for i, topic := range sub {
+			prefixes[i] = logTopicPrefix(position, topic)
+		}
+		if err := addCriterion(prefixes); err != nil {
+			return nil, err
+		}
+	}
+	if len(criteria) == 0 {
+		return nil, errors.New("log index requires an address or a topic")
+	}
+
+	blocks := make([]int64, 0)
+	for {
+		pos, ok, err := intersectNext(criteria)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "GetLogBlocks")
+		}
+		if !ok {
+			return blocks, nil
+		}
+		if len(blocks) == 0 || blocks[len(blocks)-1] != pos.height {
+			blocks = append(blocks, pos.height)
+		}
+	}
+}
+
+// logPos is the position of a log in the chain.
+type logPos struct {
+	height int64
+	index  uint64
+}
+
+func (p logPos) less(o logPos) bool {
+	return p.height < o.height || (p.height == o.height && p.index < o.index)
+}
+
+// logPostings iterates over sorted log positions.
+type logPostings interface {
+	// valid returns false once the positions are exhausted
+	valid() bool
+	// pos returns the current position
+	pos() logPos
+	// next moves to the next position
+	next() error
+	close()
+}
+
+// logPostingList iterates over the positions of a posting list in a range of
+// blocks.
+type logPostingList struct {
+	it      dbm.Iterator
+	prefix  []byte
+	current logPos
+	ok      bool
+}
+
+func newLogPostingList(db dbm.DB, prefix []byte, from, to int64) (*logPostingList, error) {
+	start := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(from))...) //#nosec G115 -- block number is positive
+	end := storetypes.PrefixEndBytes(append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(to))...))
+	it, err := db.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	l := &logPostingList{it: it, prefix: prefix}
+	if err := l.load(); err != nil {
+		it.Close()
+		return nil, err
+	}
+	return l, nil
+}
+
+// load decodes the position of the current key of the iterator
+func (l *logPostingList) load() error {
+	if !l.it.Valid() {
+		l.ok = false
+		return l.it.Error()
+	}
+	key := l.it.Key()
+	if len(key) != len(l.prefix)+logPositionLength {
+		return fmt.Errorf("wrong log key length, expect: %d, got: %d", len(l.prefix)+logPositionLength, len(key))
+	}
+	l.current = logPos{
+		height: int64(sdk.BigEndianToUint64(key[len(l.prefix) : len(l.prefix)+8])), //#nosec G115 -- block number is unlikely to exceed int64
+		index:  sdk.BigEndianToUint64(key[len(l.prefix)+8:]),
+	}
+	l.ok = true
+	return nil
+}
+
+func (l *logPostingList) valid() bool { return l.ok }
+func (l *logPostingList) pos() logPos { return l.current }
+func (l *logPostingList) close()      { l.it.Close() }
+
+func (l *logPostingList) next() error {
+	l.it.Next()
+	return l.load()
+}
+
+// logUnion merges the sorted positions of several posting lists, without
+// duplicates.
+type logUnion struct {
+	lists []*logPostingList
+}
+
+// newLogUnion returns the union of the posting lists in the blocks [from, to].
+func (kv *KVIndexer) newLogUnion(prefixes [][]byte, from, to int64) (*logUnion, error) {
+	u := &logUnion{}
+	for _, prefix := range prefixes {
+		l, err := newLogPostingList(kv.db, prefix, from, to)
+		if err != nil {
+			u.close()
+			return nil, errorsmod.Wrap(err, "GetLogBlocks")
+		}
+		u.lists = append(u.lists, l)
+	}
+	return u, nil
+}
+
+func (u *logUnion) valid() bool {
+	for _, l := range u.lists {
+		if l.valid() {
+			return true
+		}
+	}
+	return false
+}
+
+func (u *logUnion) pos() logPos {
+	var (
+		lowest logPos
+		found  bool
+	)
+	for _, l := range u.lists {
+		if l.valid() && (!found || l.pos().less(lowest)) {
+			lowest, found = l.pos(), true
+		}
+	}
+	return lowest
+}
+
+func (u *logUnion) next() error {
+	current := u.pos()
+	for _, l := range u.lists {
+		if l.valid() && l.pos() == current {
+			if err := l.next(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (u *logUnion) close() {
+	for _, l := range u.lists {
+		l.close()
+	}
+}
+
+// intersectNext returns the next position present in all the postings, and
+// moves them past it. It returns false once one of the postings is exhausted.
+func intersectNext(postings []logPostings) (logPos, bool, error) {
+	for {
+		var highest logPos
+		for i, p := range postings {
+			if !p.valid() {
+				return logPos{}, false, nil
+			}
+			if i == 0 || highest.less(p.pos()) {
+				highest = p.pos()
+			}
+		}
+
+		aligned := true
+		for _, p := range postings {
+			for p.valid() && p.pos().less(highest) {
+				if err := p.next(); err != nil {
+					return logPos{}, false, err
+				}
+			}
+			if !p.valid() {
+				return logPos{}, false, nil
+			}
+			if p.pos() != highest {
+				aligned = false
+			}
+		}
+		if !aligned {
+			continue
+		}
+
+		for _, p := range postings {
+			if err := p.next(); err != nil {
+				return logPos{}, false, err
+			}
+		}
+		return highest, true, nil
+	}
+}
+
+// LogAddressKey returns the key for db entry: `(address, block number, log index) -> nil`
+func LogAddressKey(address common.Address, blockNumber int64, logIndex uint) []byte {
+	return append(logAddressPrefix(address), logPosition(blockNumber, logIndex)...)
+}
+
+// LogTopicKey returns the key for db entry: `(topic position, topic, block number, log index) -> nil`
+func LogTopicKey(position int, topic common.Hash, blockNumber int64, logIndex uint) []byte {
+	return append(logTopicPrefix(position, topic), logPosition(blockNumber, logIndex)...)
+}
+
+func logAddressPrefix(address common.Address) []byte {
+	return append([]byte{KeyPrefixLogAddress}, address.Bytes()...)
+}
+
+func logTopicPrefix(position int, topic common.Hash) []byte {
+	return append([]byte{KeyPrefixLogTopic, byte(position)}, topic.Bytes()...) //#nosec G115 -- logs have at most 4 topics
+}
+
+func logPosition(blockNumber int64, logIndex uint) []byte {
+	bz1 := sdk.Uint64ToBigEndian(uint64(blockNumber)) //nolint:gosec // G115 // block number won't exceed uint64
+	bz2 := sdk.Uint64ToBigEndian(uint64(logIndex))
+	return append(bz1, bz2...)
+}
+
+// saveLogPostings index the emitter address and the topics of the logs into the kv db batch
+func saveLogPostings(batch dbm.Batch, height int64, logs []*ethtypes.Log) error {
+	for _, ethLog := range logs {
+		if err := batch.Set(LogAddressKey(ethLog.Address, height, ethLog.Index), []byte{}); err != nil {
+			return errorsmod.Wrap(err, "set log address key")
+		}
+		for position, topic := range ethLog.Topics {
+			if err := batch.Set(LogTopicKey(position, topic, height, ethLog.Index), []byte{}); err != nil {
+				return errorsmod.Wrap(err, "set log topic key")
+			}
+		}
+	}
+	return nil
+}
+
+// saveSyntheticLogPostings records the synthetic logs of a block into the log
+// index. The token pairs are queried at the block height, only the logs of
+// the EVM denom are indexed if they can't be.
+func (kv *KVIndexer) saveSyntheticLogPostings(batch dbm.Batch, block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
+	ctx := rpctypes.ContextWithHeight(context.Background(), block.Height)
+	pairs, err := rpctypes.QueryTokenPairs(ctx, erc20types.NewQueryClient(kv.clientCtx))
+	if err != nil {
+		kv.logger.Error("Fail to query token pairs", "err", err, "block", block.Height)
+	}
+
+	synthetic := rpctypes.NewSyntheticLogs(evmtypes.GetEVMCoinDenom(), pairs)
+	blockLogs, err := synthetic.BlockLogs(block.Height, common.BytesToHash(block.Hash()), block.Txs, txResults, nil)
+	if err != nil {
+		kv.logger.Error("Fail to build synthetic logs", "err", err, "block", block.Height)
+		return nil
+	}
+	for _, logs := range blockLogs {
+		if err := saveLogPostings(batch, block.Height, logs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// saveLogIndexRange adds the given block to the ranges of blocks covered by the
+// log index. The block extends, or joins, the ranges it is contiguous with,
+// otherwise it starts a new range, so that the blocks indexed by a backfill and
+// by the live indexer are all recorded.
+func (kv *KVIndexer) saveLogIndexRange(batch dbm.Batch, height int64) error {
+	prevFirst, prevLast, err := kv.logIndexedRangeFrom(height)
+	if err != nil {
+		return errorsmod.Wrap(err, "saveLogIndexRange")
+	}
+	if prevFirst != -1 && prevLast >= height {
+		// already covered
+		return nil
+	}
+
+	first, last := height, height
+	if prevFirst != -1 && prevLast == height-1 {
+		first = prevFirst
+	}
+	bz, err := kv.db.Get(LogIndexRangeKey(height + 1))
+	if err != nil {
+		return errorsmod.Wrap(err, "saveLogIndexRange")
+	}
+	if len(bz) > 0 {
+		// the block joins the following range
+		if _, last, err = parseLogIndexRange(LogIndexRangeKey(height+1), bz); err != nil {
+			return err
+		}
+		if err := batch.Delete(LogIndexRangeKey(height + 1)); err != nil {
+			return errorsmod.Wrap(err, "delete log index range key")
+		}
+	}
+
+	if err := batch.Set(LogIndexRangeKey(first), sdk.Uint64ToBigEndian(uint64(last))); err != nil { //#nosec G115 -- block numbers are positive
+		return errorsmod.Wrap(err, "set log index range key")
+	}
+	return nil
+}
+
+// LogIndexRangeKey returns the key for db entry: `(first block) -> last block`
+// of a range of blocks covered by the log index
+func LogIndexRangeKey(first int64) []byte {
+	return append([]byte{KeyLogIndexRange}, sdk.Uint64ToBigEndian(uint64(first))...) //#nosec G115 -- block numbers are positive
+}
+
+func parseLogIndexRange(key, value []byte) (int64, int64, error) {
+	if len(key) != 1+8 || len(value) != 8 {
+		return 0, 0, fmt.Errorf("wrong log index range length, expect: 9 and 8, got: %d and %d", len(key), len(value))
+	}
+	return int64(sdk.BigEndianToUint64(key[1:])), int64(sdk.BigEndianToUint64(value)), nil //#nosec G115 -- block numbers are unlikely to exceed int64
+}
@@ -15,10 +15,12 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/spf13/cast"
 
+	_ "github.com/cosmos/evm/precompiles/tracer"
 	_ "github.com/ethereum/go-ethereum/eth/tracers/js"
 	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	dbm "github.com/cosmos/cosmos-db"
 	evmante "github.com/cosmos/evm/ante"
@@ -47,6 +49,7 @@ import (
 	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
 	"github.com/cosmos/evm/x/vm"
 	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
+	evmparallel "github.com/cosmos/evm/x/vm/parallel"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 	"github.com/cosmos/gogoproto/proto"
 	ibccallbacks "github.com/cosmos/ibc-go/v10/modules/apps/callbacks"
@@ -739,6 +742,11 @@ func NewExampleApp(
 		panic(fmt.Sprintf("failed to configure EVM mempool: %s", err.Error()))
 	}
 
+	// execute the blocks which only contain Ethereum txs in parallel with block-STM if enabled (see evmparallel.TxRunner)
+	if workers := cast.ToInt(appOpts.Get(srvflags.EVMParallelExecutionWorkers)); workers > 0 {
+		evmparallel.SetTxRunner(app.BaseApp, app.EVMKeeper, app.txConfig.TxDecoder(), nonTransientKeys, workers)
+	}
+
 	// In v0.46, the SDK introduces _postHandlers_. PostHandlers are like
 	// antehandlers, but are run _after_ the `runMsgs` execution. They are also
 	// defined as a chain, and have the same signature as antehandlers.
@@ -777,6 +785,14 @@ func NewExampleApp(
 			logger.Error("error on loading last version", "err", err)
 			os.Exit(1)
 		}
+
+		// the forks activated by governance must be applied before CheckTx and
+		// the RPC serve requests
+		ctx := app.NewContextLegacy(true, tmproto.Header{Height: app.LastBlockHeight()})
+		if err := app.EVMKeeper.LoadActivatedForks(ctx); err != nil {
+			logger.Error("error on loading the activated forks", "err", err)
+			os.Exit(1)
+		}
 	}
 
 	return app
@@ -12,3 +12,13 @@ func TestKVIndexer(t *testing.T) {
 	create := testapp.ToEvmAppCreator[evm.IntegrationNetworkApp](CreateEvmd, "evm.IntegrationNetworkApp")
 	indexer.TestKVIndexer(t, create)
 }
+
+func TestKVIndexerAddressIndex(t *testing.T) {
+	create := testapp.ToEvmAppCreator[evm.IntegrationNetworkApp](CreateEvmd, "evm.IntegrationNetworkApp")
+	indexer.TestKVIndexerAddressIndex(t, create)
+}
+
+func TestKVIndexerLogIndex(t *testing.T) {
+	create := testapp.ToEvmAppCreator[evm.IntegrationNetworkApp](CreateEvmd, "evm.IntegrationNetworkApp")
+	indexer.TestKVIndexerLogIndex(t, create)
+}
@@ -94,6 +94,14 @@ func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, s)
 }
 
+func TestKeeperTestSuiteParallelExecution(t *testing.T) {
+	create := testapp.ToEvmAppCreator[evm.VMIntegrationApp](CreateEvmd, "evm.VMIntegrationApp")
+	s := vm.NewKeeperTestSuite(create, network.WithParallelExecution(4))
+	s.EnableFeemarket = false
+	s.EnableLondonHF = true
+	suite.Run(t, s)
+}
+
 func TestNestedEVMExtensionCallSuite(t *testing.T) {
 	create := testapp.ToEvmAppCreator[evm.VMIntegrationApp](CreateEvmd, "evm.VMIntegrationApp")
 	s := vm.NewNestedEVMExtensionCallSuite(create)
@@ -116,3 +124,8 @@ func TestIterateContracts(t *testing.T) {
 	create := testapp.ToEvmAppCreator[evm.VMIntegrationApp](CreateEvmd, "evm.VMIntegrationApp")
 	vm.TestIterateContracts(t, create)
 }
+
+func TestParallelExecution(t *testing.T) {
+	create := testapp.ToEvmAppCreator[evm.VMIntegrationApp](CreateEvmd, "evm.VMIntegrationApp")
+	vm.TestParallelExecution(t, create)
+}
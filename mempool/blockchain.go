@@ -27,6 +27,7 @@ import (
 var (
 	_ txpool.BlockChain     = &Blockchain{}
 	_ legacypool.BlockChain = &Blockchain{}
+	_ legacypool.TxSponsors = &Blockchain{}
 )
 
 // Blockchain implements the BlockChain interface required by Ethereum transaction pools.
@@ -227,6 +228,19 @@ func (b *Blockchain) StateAt(hash common.Hash) (vm.StateDB, error) {
 	return stateDB, nil
 }
 
+// IsSponsoredTx returns whether the fees of the transaction are paid by a sponsor at the latest
+// state, as checked by the ante handler, in which case the pool only charges its value to the sender.
+func (b *Blockchain) IsSponsoredTx(tx *types.Transaction, from common.Address) bool {
+	ctx, err := b.GetLatestContext()
+	if err != nil {
+		return false
+	}
+	// Isolate the read-only lookup from concurrent commits, like StateAt.
+	cacheCtx, _ := ctx.CacheContext()
+	_, sponsored := b.vmKeeper.GetTxSponsor(cacheCtx.WithGasMeter(sdktypes.NewInfiniteGasMeter()), tx, from)
+	return sponsored
+}
+
 // BeginCommit acquires an exclusive lock to prevent mempool state reads during Commit.
 // This avoids data races in the underlying storage (e.g., IAVL) when tests run with -race.
 func (b *Blockchain) BeginCommit() { b.testingCommitMu.Lock() }
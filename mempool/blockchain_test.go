@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
@@ -132,3 +133,24 @@ func TestBlockchainRaceCondition(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, stateDB)
 }
+
+// TestIsSponsoredTx tests that the pool looks up the sponsor of a tx with the lookup of the ante
+// handler, on the latest state.
+func TestIsSponsoredTx(t *testing.T) {
+	mockVMKeeper := mocks.NewVMKeeper(t)
+	mockFeeMarketKeeper := mocks.NewFeeMarketKeeper(t)
+	getCtxCallback := func(int64, bool) (sdk.Context, error) {
+		return createMockContext(), nil
+	}
+	blockchain := mempool.NewBlockchain(getCtxCallback, log.NewNopLogger(), mockVMKeeper, mockFeeMarketKeeper, 21000000)
+
+	contract := common.Address{1}
+	sender := common.Address{2}
+	sponsoredTx := ethtypes.NewTx(&ethtypes.LegacyTx{To: &contract, Gas: 21000, GasPrice: big.NewInt(1)})
+	otherTx := ethtypes.NewTx(&ethtypes.LegacyTx{To: &sender, Gas: 21000, GasPrice: big.NewInt(1)})
+	mockVMKeeper.On("GetTxSponsor", mock.Anything, sponsoredTx, sender).Return(common.Address{3}, true)
+	mockVMKeeper.On("GetTxSponsor", mock.Anything, otherTx, sender).Return(common.Address{}, false)
+
+	require.True(t, blockchain.IsSponsoredTx(sponsoredTx, sender))
+	require.False(t, blockchain.IsSponsoredTx(otherTx, sender))
+}
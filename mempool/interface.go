@@ -4,6 +4,7 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/cosmos/evm/x/vm/statedb"
 	vmtypes "github.com/cosmos/evm/x/vm/types"
@@ -28,6 +29,7 @@ type VMKeeperI interface {
 	DeleteCode(ctx sdk.Context, codeHash []byte)
 	SetCode(ctx sdk.Context, codeHash []byte, code []byte)
 	DeleteAccount(ctx sdk.Context, addr common.Address) error
+	GetTxSponsor(ctx sdk.Context, ethTx *ethtypes.Transaction, sender common.Address) (common.Address, bool)
 	KVStoreKeys() map[string]storetypes.StoreKey
 	SetEvmMempool(evmMempool *ExperimentalEVMMempool)
 }
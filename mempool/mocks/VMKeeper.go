@@ -8,6 +8,8 @@ import (
 	mempool "github.com/cosmos/evm/mempool"
 	common "github.com/ethereum/go-ethereum/common"
 
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
 	mock "github.com/stretchr/testify/mock"
 
 	statedb "github.com/cosmos/evm/x/vm/statedb"
@@ -193,6 +195,36 @@ func (_m *VMKeeper) GetState(ctx types.Context, addr common.Address, key common.
 	return r0
 }
 
+// GetTxSponsor provides a mock function with given fields: ctx, ethTx, sender
+func (_m *VMKeeper) GetTxSponsor(ctx types.Context, ethTx *ethtypes.Transaction, sender common.Address) (common.Address, bool) {
+	ret := _m.Called(ctx, ethTx, sender)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTxSponsor")
+	}
+
+	var r0 common.Address
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(types.Context, *ethtypes.Transaction, common.Address) (common.Address, bool)); ok {
+		return rf(ctx, ethTx, sender)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, *ethtypes.Transaction, common.Address) common.Address); ok {
+		r0 = rf(ctx, ethTx, sender)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(common.Address)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, *ethtypes.Transaction, common.Address) bool); ok {
+		r1 = rf(ctx, ethTx, sender)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // KVStoreKeys provides a mock function with no fields
 func (_m *VMKeeper) KVStoreKeys() map[string]storetypes.StoreKey {
 	ret := _m.Called()
@@ -135,6 +135,15 @@ type BlockChain interface {
 	StateAt(root common.Hash) (vm.StateDB, error)
 }
 
+// TxSponsors is implemented by the chains on which the fees of a transaction
+// can be paid by a sponsor, in which case only the value of the transaction is
+// charged to its sender.
+type TxSponsors interface {
+	// IsSponsoredTx returns whether the fees of the transaction are paid by a
+	// sponsor at the head of the chain.
+	IsSponsoredTx(tx *types.Transaction, from common.Address) bool
+}
+
 // Config are the configuration parameters of the transaction pool.
 type Config struct {
 	Locals    []common.Address // Addresses that should be treated by default as local
@@ -229,6 +238,7 @@ type LegacyPool struct {
 	config      Config
 	chainconfig *params.ChainConfig
 	chain       BlockChain
+	sponsors    TxSponsors // Sponsorships of the chain, nil if not supported
 	gasTip      atomic.Pointer[uint256.Int]
 	txFeed      event.Feed
 	signer      types.Signer
@@ -286,10 +296,34 @@ func New(config Config, chain BlockChain) *LegacyPool {
 		initDoneCh:      make(chan struct{}),
 	}
 	pool.priced = newPricedList(pool.all)
+	if sponsors, ok := chain.(TxSponsors); ok {
+		pool.sponsors = sponsors
+	}
 
 	return pool
 }
 
+// txCost returns the cost of a transaction charged to its sender, which is only
+// the value of the transaction if its fees are paid by a sponsor.
+func (pool *LegacyPool) txCost(tx *types.Transaction) *big.Int {
+	if pool.sponsors != nil {
+		from, _ := types.Sender(pool.signer, tx) // already validated
+		if pool.sponsors.IsSponsoredTx(tx, from) {
+			return new(big.Int).Set(tx.Value())
+		}
+	}
+	return tx.Cost()
+}
+
+// newList creates a transaction list charging the senders the cost returned
+// by txCost.
+func (pool *LegacyPool) newList(strict bool) *list {
+	if pool.sponsors == nil {
+		return newList(strict)
+	}
+	return newListWithCost(strict, pool.txCost)
+}
+
 // Filter returns whether the given transaction can be consumed by the legacy
 // pool, specifically, whether it is a Legacy, AccessList or Dynamic transaction.
 func (pool *LegacyPool) Filter(tx *types.Transaction) bool {
@@ -584,6 +618,7 @@ func (pool *LegacyPool) ValidateTxBasics(tx *types.Transaction) error {
 func (pool *LegacyPool) validateTx(tx *types.Transaction) error {
 	opts := &txpool.ValidationOptionsWithState{
 		State: pool.currentState,
+		Cost:  pool.txCost,
 
 		FirstNonceGap:    nil, // Pool allows arbitrary arrival order, don't invalidate nonce gaps
 		UsedAndLeftSlots: nil, // Pool has own mechanism to limit the number of transactions
@@ -595,9 +630,7 @@ func (pool *LegacyPool) validateTx(tx *types.Transaction) error {
 		},
 		ExistingCost: func(addr common.Address, nonce uint64) *big.Int {
 			if list := pool.pending[addr]; list != nil {
-				if tx := list.txs.Get(nonce); tx != nil {
-					return tx.Cost()
-				}
+				return list.Cost(nonce)
 			}
 			return nil
 		},
@@ -616,7 +649,9 @@ func (pool *LegacyPool) checkDelegationLimit(tx *types.Transaction) error {
 	from, _ := types.Sender(pool.signer, tx) // validated
 
 	// Short circuit if the sender has neither delegation nor pending delegation.
-	if pool.currentState.GetCodeHash(from) == types.EmptyCodeHash && !pool.all.hasAuth(from) {
+	// The sender may not exist yet if its transactions are sponsored.
+	codeHash := pool.currentState.GetCodeHash(from)
+	if (codeHash == types.EmptyCodeHash || codeHash == (common.Hash{})) && !pool.all.hasAuth(from) {
 		return nil
 	}
 	pending := pool.pending[from]
@@ -840,7 +875,7 @@ func (pool *LegacyPool) enqueueTx(hash common.Hash, tx *types.Transaction, addAl
 	// Try to insert the transaction into the future queue
 	from, _ := types.Sender(pool.signer, tx) // already validated
 	if pool.queue[from] == nil {
-		pool.queue[from] = newList(false)
+		pool.queue[from] = pool.newList(false)
 	}
 	inserted, old := pool.queue[from].Add(tx, pool.config.PriceBump)
 	if !inserted {
@@ -880,7 +915,7 @@ func (pool *LegacyPool) enqueueTx(hash common.Hash, tx *types.Transaction, addAl
 func (pool *LegacyPool) promoteTx(addr common.Address, hash common.Hash, tx *types.Transaction) bool {
 	// Try to insert the transaction into the pending queue
 	if pool.pending[addr] == nil {
-		pool.pending[addr] = newList(true)
+		pool.pending[addr] = pool.newList(true)
 	}
 	list := pool.pending[addr]
 
@@ -394,6 +394,76 @@ func TestStateChangeDuringReset(t *testing.T) {
 	}
 }
 
+// sponsoredChain is a test chain on which the fees of the txs calling the
+// sponsored contract are paid by a sponsor while the sponsorship is active.
+type sponsoredChain struct {
+	*testBlockChain
+	contract common.Address
+	active   atomic.Bool
+}
+
+func (c *sponsoredChain) IsSponsoredTx(tx *types.Transaction, _ common.Address) bool {
+	return c.active.Load() && tx.To() != nil && *tx.To() == c.contract
+}
+
+// TestSponsoredTransactions tests that the txs calling a sponsored contract
+// only charge their value to the sender, so that a sender without funds can be
+// sponsored, and that they are dropped once the sponsorship no longer covers
+// them.
+func TestSponsoredTransactions(t *testing.T) {
+	t.Parallel()
+
+	statedb, _ := state.New(types.EmptyRootHash, state.NewDatabaseForTesting())
+	blockchain := &sponsoredChain{
+		testBlockChain: newTestBlockChain(params.TestChainConfig, 10000000, statedb, new(event.Feed)),
+		contract:       common.Address{0x01},
+	}
+	blockchain.active.Store(true)
+
+	pool := New(testTxPoolConfig, blockchain)
+	if err := pool.Init(testTxPoolConfig.PriceLimit, blockchain.CurrentBlock(), newReserver()); err != nil {
+		t.Fatal(err)
+	}
+	defer pool.Close()
+
+	key, _ := crypto.GenerateKey()
+	sponsoredTx := func(nonce uint64, value int64) *types.Transaction {
+		tx, _ := types.SignTx(types.NewTransaction(nonce, blockchain.contract, big.NewInt(value), 100000, big.NewInt(1), nil), types.HomesteadSigner{}, key)
+		return tx
+	}
+
+	// the unfunded sender can call the sponsored contract without value
+	if err := pool.addRemoteSync(sponsoredTx(0, 0)); err != nil {
+		t.Fatalf("failed to add sponsored transaction: %v", err)
+	}
+	if err := pool.addRemoteSync(sponsoredTx(1, 0)); err != nil {
+		t.Fatalf("failed to add sponsored transaction: %v", err)
+	}
+	// but the value isn't sponsored, nor are the calls of other contracts
+	if err := pool.addRemote(sponsoredTx(2, 1)); !errors.Is(err, core.ErrInsufficientFunds) {
+		t.Fatalf("want %v have %v", core.ErrInsufficientFunds, err)
+	}
+	if err := pool.addRemote(transaction(2, 100000, key)); !errors.Is(err, core.ErrInsufficientFunds) {
+		t.Fatalf("want %v have %v", core.ErrInsufficientFunds, err)
+	}
+	if pending, queued := pool.Stats(); pending != 2 || queued != 0 {
+		t.Fatalf("pending/queued mismatch: have %d/%d, want 2/0", pending, queued)
+	}
+	if err := validatePoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+
+	// the txs are dropped once the sponsorship no longer covers them
+	blockchain.active.Store(false)
+	<-pool.requestReset(nil, nil)
+	if pending, queued := pool.Stats(); pending != 0 || queued != 0 {
+		t.Fatalf("pending/queued mismatch: have %d/%d, want 0/0", pending, queued)
+	}
+	if err := validatePoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+}
+
 func testAddBalance(pool *LegacyPool, addr common.Address, amount *big.Int) {
 	pool.mu.Lock()
 	pool.currentState.AddBalance(addr, uint256.MustFromBig(amount), tracing.BalanceChangeUnspecified)
@@ -276,6 +276,10 @@ type list struct {
 	costcap   *uint256.Int // Price of the highest costing transaction (reset only if exceeds balance)
 	gascap    uint64       // Gas limit of the highest spending transaction (reset only if exceeds block limit)
 	totalcost *uint256.Int // Total cost of all transactions in the list
+
+	costFn    func(*types.Transaction) *big.Int // Cost of a transaction charged to its sender, nil for tx.Cost()
+	costs     map[uint64]*uint256.Int           // Cost of the transactions when added, by nonce
+	sponsored int                               // Number of transactions added with a cost below tx.Cost()
 }
 
 // newList creates a new transaction list for maintaining nonce-indexable fast,
@@ -286,7 +290,34 @@ func newList(strict bool) *list {
 		txs:       NewSortedMap(),
 		costcap:   new(uint256.Int),
 		totalcost: new(uint256.Int),
+		costs:     make(map[uint64]*uint256.Int),
+	}
+}
+
+// newListWithCost creates a new transaction list whose transactions charge
+// their sender the cost returned by costFn, which may differ from tx.Cost()
+// for the sponsored transactions.
+func newListWithCost(strict bool, costFn func(*types.Transaction) *big.Int) *list {
+	l := newList(strict)
+	l.costFn = costFn
+	return l
+}
+
+// txCost returns the current cost of a transaction charged to its sender.
+func (l *list) txCost(tx *types.Transaction) *big.Int {
+	if l.costFn != nil {
+		return l.costFn(tx)
 	}
+	return tx.Cost()
+}
+
+// Cost returns the cost, when added, of the transaction with the provided
+// nonce, or nil if there is none.
+func (l *list) Cost(nonce uint64) *big.Int {
+	if cost, ok := l.costs[nonce]; ok {
+		return cost.ToBig()
+	}
+	return nil
 }
 
 // Contains returns whether the  list contains a transaction
@@ -327,11 +358,15 @@ func (l *list) Add(tx *types.Transaction, priceBump uint64) (bool, *types.Transa
 		l.subTotalCost([]*types.Transaction{old})
 	}
 	// Add new tx cost to totalcost
-	cost, overflow := uint256.FromBig(tx.Cost())
+	cost, overflow := uint256.FromBig(l.txCost(tx))
 	if overflow {
 		return false, nil
 	}
 	l.totalcost.Add(l.totalcost, cost)
+	l.costs[tx.Nonce()] = cost
+	if cost.ToBig().Cmp(tx.Cost()) < 0 {
+		l.sponsored++
+	}
 
 	// Otherwise overwrite the old transaction with the current one
 	l.txs.Put(tx)
@@ -363,8 +398,10 @@ func (l *list) Forward(threshold uint64) types.Transactions {
 // is lower than the costgas cap, the caps will be reset to a new high after removing
 // the newly invalidated transactions.
 func (l *list) Filter(costLimit *uint256.Int, gasLimit uint64) (types.Transactions, types.Transactions) {
-	// If all transactions are below the threshold, short circuit
-	if l.costcap.Cmp(costLimit) <= 0 && l.gascap <= gasLimit {
+	// If all transactions are below the threshold, short circuit. The costs of
+	// the sponsored transactions are always recomputed since the sponsorship
+	// may no longer cover them.
+	if l.costcap.Cmp(costLimit) <= 0 && l.gascap <= gasLimit && l.sponsored == 0 {
 		return nil, nil
 	}
 	l.costcap = new(uint256.Int).Set(costLimit) // Lower the caps to the thresholds
@@ -372,7 +409,7 @@ func (l *list) Filter(costLimit *uint256.Int, gasLimit uint64) (types.Transactio
 
 	// Filter out all the transactions above the account's funds
 	removed := l.txs.Filter(func(tx *types.Transaction) bool {
-		return tx.Gas() > gasLimit || tx.Cost().Cmp(costLimit.ToBig()) > 0
+		return tx.Gas() > gasLimit || l.txCost(tx).Cmp(costLimit.ToBig()) > 0
 	})
 
 	if len(removed) == 0 {
@@ -459,11 +496,19 @@ func (l *list) LastElement() *types.Transaction {
 	return l.txs.LastElement()
 }
 
-// subTotalCost subtracts the cost of the given transactions from the
-// total cost of all transactions.
+// subTotalCost subtracts the cost of the given transactions, as recorded when
+// they were added, from the total cost of all transactions.
 func (l *list) subTotalCost(txs []*types.Transaction) {
 	for _, tx := range txs {
-		_, underflow := l.totalcost.SubOverflow(l.totalcost, uint256.MustFromBig(tx.Cost()))
+		cost, ok := l.costs[tx.Nonce()]
+		if !ok {
+			cost = uint256.MustFromBig(l.txCost(tx))
+		}
+		delete(l.costs, tx.Nonce())
+		if ok && cost.ToBig().Cmp(tx.Cost()) < 0 {
+			l.sponsored--
+		}
+		_, underflow := l.totalcost.SubOverflow(l.totalcost, cost)
 		if underflow {
 			panic("totalcost underflow")
 		}
@@ -0,0 +1,364 @@
+// Package tracer implements the precompileTracer, a native tracer that decodes
+// the calls to the Cosmos EVM precompiles into their method names, arguments
+// and return values using the ABIs embedded in the precompile packages.
+//
+// The tracer is registered in the go-ethereum tracers directory, so it can be
+// used with debug_traceTransaction and the other debug tracing endpoints:
+//
+//	> debug.traceTransaction("0x...", {tracer: "precompileTracer"})
+//	[
+//	  {
+//	    "type": "CALL",
+//	    "from": "0x...",
+//	    "to": "0x0000000000000000000000000000000000000800",
+//	    "precompile": "staking",
+//	    "method": "delegate",
+//	    "signature": "delegate(address,string,uint256)",
+//	    "inputs": [
+//	      {"name": "delegatorAddress", "type": "address", "value": "0x..."},
+//	      {"name": "validatorAddress", "type": "string", "value": "cosmosvaloper1..."},
+//	      {"name": "amount", "type": "uint256", "value": "1000000000000000000"}
+//	    ],
+//	    "outputs": [{"name": "success", "type": "bool", "value": true}]
+//	  }
+//	]
+//
+// It is also registered with the x/vm tracers, in which case the decoded calls
+// of every transaction are written to stderr.
+//
+// The static precompiles are identified by their address. The ERC-20 and
+// WERC20 precompiles are registered dynamically for each token pair, so the
+// calls to accounts without code whose selector belongs to their ABI are
+// decoded as ERC-20 or WERC20 calls.
+package tracer
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"os"
+	"reflect"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/cosmos/evm/precompiles/bank"
+	"github.com/cosmos/evm/precompiles/bech32"
+	"github.com/cosmos/evm/precompiles/distribution"
+	"github.com/cosmos/evm/precompiles/erc20"
+	"github.com/cosmos/evm/precompiles/gov"
+	"github.com/cosmos/evm/precompiles/ics02"
+	"github.com/cosmos/evm/precompiles/ics20"
+	"github.com/cosmos/evm/precompiles/slashing"
+	"github.com/cosmos/evm/precompiles/staking"
+	"github.com/cosmos/evm/precompiles/werc20"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// Name is the name of the tracer
+const Name = "precompileTracer"
+
+func init() {
+	tracers.DefaultDirectory.Register(Name, newTracer, false)
+	evmtypes.RegisterTracer(Name, newLoggingTracer)
+}
+
+// precompile is a precompile known by the tracer
+type precompile struct {
+	name string
+	abi  *abi.ABI
+}
+
+// staticPrecompiles are the precompiles deployed at a fixed address
+var staticPrecompiles = map[common.Address]precompile{
+	common.HexToAddress(evmtypes.Bech32PrecompileAddress):       {"bech32", &bech32.ABI},
+	common.HexToAddress(evmtypes.StakingPrecompileAddress):      {"staking", &staking.ABI},
+	common.HexToAddress(evmtypes.DistributionPrecompileAddress): {"distribution", &distribution.ABI},
+	common.HexToAddress(evmtypes.ICS20PrecompileAddress):        {"ics20", &ics20.ABI},
+	common.HexToAddress(evmtypes.BankPrecompileAddress):         {"bank", &bank.ABI},
+	common.HexToAddress(evmtypes.GovPrecompileAddress):          {"gov", &gov.ABI},
+	common.HexToAddress(evmtypes.SlashingPrecompileAddress):     {"slashing", &slashing.ABI},
+	common.HexToAddress(evmtypes.ICS02PrecompileAddress):        {"ics02", &ics02.ABI},
+}
+
+// Argument is a decoded argument or return value
+type Argument struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Value any    `json:"value"`
+}
+
+// Call is a decoded call to a precompile
+type Call struct {
+	Type       string         `json:"type"`
+	From       common.Address `json:"from"`
+	To         common.Address `json:"to"`
+	Value      *hexutil.Big   `json:"value,omitempty"`
+	Precompile string         `json:"precompile"`
+	Method     string         `json:"method,omitempty"`
+	Signature  string         `json:"signature,omitempty"`
+	Inputs     []Argument     `json:"inputs,omitempty"`
+	Outputs    []Argument     `json:"outputs,omitempty"`
+	// Input is the raw calldata, only set when it can't be decoded
+	Input        hexutil.Bytes `json:"input,omitempty"`
+	Error        string        `json:"error,omitempty"`
+	RevertReason string        `json:"revertReason,omitempty"`
+}
+
+// frame is an open call frame, call is nil if it isn't a precompile call
+type frame struct {
+	call   *Call
+	method *abi.Method
+}
+
+// precompileTracer collects the decoded calls to the precompiles
+type precompileTracer struct {
+	env       *tracing.VMContext
+	calls     []*Call
+	stack     []frame
+	interrupt atomic.Bool
+	reason    error
+}
+
+// newTracer returns a native go tracer which decodes the calls to the
+// precompiles, and implements vm.EVMLogger.
+func newTracer(_ *tracers.Context, _ json.RawMessage, _ *params.ChainConfig) (*tracers.Tracer, error) {
+	t := &precompileTracer{}
+	return &tracers.Tracer{
+		Hooks: &tracing.Hooks{
+			OnTxStart: t.OnTxStart,
+			OnEnter:   t.OnEnter,
+			OnExit:    t.OnExit,
+		},
+		GetResult: t.GetResult,
+		Stop:      t.Stop,
+	}, nil
+}
+
+// newLoggingTracer returns the hooks of a tracer writing the decoded calls of
+// the transaction to stderr when it ends.
+func newLoggingTracer(_ core.Message, _ *params.ChainConfig, _ int64, _ uint64) *tracing.Hooks {
+	t := &precompileTracer{}
+	return &tracing.Hooks{
+		OnTxStart: t.OnTxStart,
+		OnEnter:   t.OnEnter,
+		OnExit:    t.OnExit,
+		OnTxEnd: func(_ *ethtypes.Receipt, _ error) {
+			if len(t.calls) == 0 {
+				return
+			}
+			res, err := t.GetResult()
+			if err != nil {
+				return
+			}
+			_, _ = os.Stderr.Write(append(res, '\n'))
+		},
+	}
+}
+
+func (t *precompileTracer) OnTxStart(env *tracing.VMContext, _ *ethtypes.Transaction, _ common.Address) {
+	t.env = env
+}
+
+func (t *precompileTracer) OnEnter(_ int, typ byte, from common.Address, to common.Address, input []byte, _ uint64, value *big.Int) {
+	if t.interrupt.Load() {
+		return
+	}
+
+	call, method := t.decodeInput(vm.OpCode(typ), to, input)
+	if call != nil {
+		call.Type = vm.OpCode(typ).String()
+		call.From = from
+		call.To = to
+		if value != nil && value.Sign() > 0 {
+			call.Value = (*hexutil.Big)(new(big.Int).Set(value))
+		}
+		t.calls = append(t.calls, call)
+	}
+	t.stack = append(t.stack, frame{call: call, method: method})
+}
+
+func (t *precompileTracer) OnExit(_ int, output []byte, _ uint64, err error, reverted bool) {
+	if t.interrupt.Load() || len(t.stack) == 0 {
+		return
+	}
+	f := t.stack[len(t.stack)-1]
+	t.stack = t.stack[:len(t.stack)-1]
+	call := f.call
+	if call == nil {
+		return
+	}
+
+	if err != nil {
+		call.Error = err.Error()
+		if reverted {
+			if reason, unpackErr := abi.UnpackRevert(output); unpackErr == nil {
+				call.RevertReason = reason
+			}
+		}
+		return
+	}
+	if f.method != nil {
+		call.Outputs = unpack(f.method.Outputs, output)
+	}
+}
+
+// GetResult returns the decoded calls in the order they were made.
+func (t *precompileTracer) GetResult() (json.RawMessage, error) {
+	calls := t.calls
+	if calls == nil {
+		calls = []*Call{}
+	}
+	res, err := json.Marshal(calls)
+	if err != nil {
+		return nil, err
+	}
+	return res, t.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *precompileTracer) Stop(err error) {
+	t.reason = err
+	t.interrupt.Store(true)
+}
+
+// decodeInput returns the decoded call and its method if the target is a
+// precompile, or nil otherwise.
+func (t *precompileTracer) decodeInput(op vm.OpCode, to common.Address, input []byte) (*Call, *abi.Method) {
+	if op == vm.CREATE || op == vm.CREATE2 {
+		return nil, nil
+	}
+
+	p, ok := staticPrecompiles[to]
+	if !ok {
+		p, ok = t.dynamicPrecompile(to, input)
+		if !ok {
+			return nil, nil
+		}
+	}
+
+	call := &Call{Precompile: p.name}
+	method, err := methodByInput(p.abi, input)
+	if err != nil {
+		call.Input = common.CopyBytes(input)
+		call.Error = err.Error()
+		return call, nil
+	}
+
+	call.Method = method.Name
+	call.Signature = method.Sig
+	call.Inputs = unpack(method.Inputs, input[4:])
+	if call.Inputs == nil && len(method.Inputs) > 0 {
+		call.Input = common.CopyBytes(input)
+	}
+	return call, method
+}
+
+// dynamicPrecompile returns the ERC-20 or WERC20 precompile if the target has
+// no code and the selector belongs to their ABI. The methods shared by both
+// are reported as ERC-20 calls.
+func (t *precompileTracer) dynamicPrecompile(to common.Address, input []byte) (precompile, bool) {
+	if len(input) < 4 || t.env == nil || t.env.StateDB == nil || len(t.env.StateDB.GetCode(to)) > 0 {
+		return precompile{}, false
+	}
+	if _, err := erc20.ABI.MethodById(input[:4]); err == nil {
+		return precompile{"erc20", &erc20.ABI}, true
+	}
+	if _, err := werc20.ABI.MethodById(input[:4]); err == nil {
+		return precompile{"werc20", &werc20.ABI}, true
+	}
+	return precompile{}, false
+}
+
+// methodByInput returns the method of the precompile called by the input
+func methodByInput(contractABI *abi.ABI, input []byte) (*abi.Method, error) {
+	if len(input) < 4 {
+		return nil, errors.New("missing method selector")
+	}
+	return contractABI.MethodById(input[:4])
+}
+
+// unpack decodes the values of the arguments, it returns nil if they can't be
+// decoded.
+func unpack(args abi.Arguments, data []byte) []Argument {
+	if len(args) == 0 {
+		return nil
+	}
+	values, err := args.Unpack(data)
+	if err != nil || len(values) != len(args) {
+		return nil
+	}
+	res := make([]Argument, len(args))
+	for i, arg := range args {
+		res[i] = Argument{
+			Name:  arg.Name,
+			Type:  arg.Type.String(),
+			Value: formatValue(reflect.ValueOf(values[i])),
+		}
+	}
+	return res
+}
+
+// formatValue converts a decoded ABI value into a readable JSON value: the
+// integers are encoded as decimal strings, the byte arrays and slices as hex
+// strings and the tuples as objects keyed by the component names.
+func formatValue(v reflect.Value) any {
+	if !v.IsValid() {
+		return nil
+	}
+	switch value := v.Interface().(type) {
+	case *big.Int:
+		if value == nil {
+			return nil
+		}
+		return value.String()
+	case common.Address:
+		return value
+	case common.Hash:
+		return value
+	case []byte:
+		return hexutil.Bytes(value)
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil
+		}
+		return formatValue(v.Elem())
+	case reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			bz := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(bz), v)
+			return hexutil.Bytes(bz)
+		}
+		fallthrough
+	case reflect.Slice:
+		res := make([]any, v.Len())
+		for i := range res {
+			res[i] = formatValue(v.Index(i))
+		}
+		return res
+	case reflect.Struct:
+		res := make(map[string]any, v.NumField())
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			name := field.Tag.Get("json")
+			if name == "" {
+				name = field.Name
+			}
+			res[name] = formatValue(v.Field(i))
+		}
+		return res
+	default:
+		return v.Interface()
+	}
+}
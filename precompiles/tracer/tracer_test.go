@@ -0,0 +1,193 @@
+package tracer_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/precompiles/erc20"
+	"github.com/cosmos/evm/precompiles/staking"
+	"github.com/cosmos/evm/precompiles/tracer"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+var (
+	sender    = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	contract  = common.HexToAddress("0x2000000000000000000000000000000000000002")
+	token     = common.HexToAddress("0x3000000000000000000000000000000000000003")
+	validator = "cosmosvaloper1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5a3kaax"
+)
+
+// stateDB returns the code of the contract only
+type stateDB struct {
+	tracing.StateDB
+}
+
+func (stateDB) GetCode(addr common.Address) []byte {
+	if addr == contract {
+		return []byte{0x60}
+	}
+	return nil
+}
+
+func pack(t *testing.T, contractABI abi.ABI, method string, args ...interface{}) []byte {
+	t.Helper()
+	input, err := contractABI.Pack(method, args...)
+	require.NoError(t, err)
+	return input
+}
+
+func packOutput(t *testing.T, contractABI abi.ABI, method string, args ...interface{}) []byte {
+	t.Helper()
+	output, err := contractABI.Methods[method].Outputs.Pack(args...)
+	require.NoError(t, err)
+	return output
+}
+
+func TestPrecompileTracer(t *testing.T) {
+	stakingAddr := common.HexToAddress(evmtypes.StakingPrecompileAddress)
+	amount := big.NewInt(1e18)
+
+	testCases := []struct {
+		name   string
+		run    func(hooks *tracing.Hooks)
+		expRes []tracer.Call
+	}{
+		{
+			"staking delegate",
+			func(hooks *tracing.Hooks) {
+				hooks.OnEnter(0, byte(vm.CALL), sender, stakingAddr, pack(t, staking.ABI, staking.DelegateMethod, sender, validator, amount), 0, big.NewInt(0))
+				hooks.OnExit(0, packOutput(t, staking.ABI, staking.DelegateMethod, true), 0, nil, false)
+			},
+			[]tracer.Call{
+				{
+					Type:       "CALL",
+					From:       sender,
+					To:         stakingAddr,
+					Precompile: "staking",
+					Method:     staking.DelegateMethod,
+					Signature:  "delegate(address,string,uint256)",
+					Inputs: []tracer.Argument{
+						{Name: "delegatorAddress", Type: "address", Value: sender.Hex()},
+						{Name: "validatorAddress", Type: "string", Value: validator},
+						{Name: "amount", Type: "uint256", Value: amount.String()},
+					},
+					Outputs: []tracer.Argument{
+						{Name: "success", Type: "bool", Value: true},
+					},
+				},
+			},
+		},
+		{
+			"nested ERC-20 precompile call from a contract",
+			func(hooks *tracing.Hooks) {
+				hooks.OnEnter(0, byte(vm.CALL), sender, contract, []byte{0x01, 0x02, 0x03, 0x04}, 0, big.NewInt(0))
+				hooks.OnEnter(1, byte(vm.CALL), contract, token, pack(t, erc20.ABI, erc20.TransferMethod, sender, amount), 0, big.NewInt(0))
+				hooks.OnExit(1, packOutput(t, erc20.ABI, erc20.TransferMethod, true), 0, nil, false)
+				hooks.OnExit(0, nil, 0, nil, false)
+			},
+			[]tracer.Call{
+				{
+					Type:       "CALL",
+					From:       contract,
+					To:         token,
+					Precompile: "erc20",
+					Method:     erc20.TransferMethod,
+					Signature:  "transfer(address,uint256)",
+					Inputs: []tracer.Argument{
+						{Name: "to", Type: "address", Value: sender.Hex()},
+						{Name: "amount", Type: "uint256", Value: amount.String()},
+					},
+					Outputs: []tracer.Argument{
+						{Type: "bool", Value: true},
+					},
+				},
+			},
+		},
+		{
+			"contract with an ERC-20 selector is not a precompile",
+			func(hooks *tracing.Hooks) {
+				hooks.OnEnter(0, byte(vm.CALL), sender, contract, pack(t, erc20.ABI, erc20.TransferMethod, sender, amount), 0, big.NewInt(0))
+				hooks.OnExit(0, nil, 0, nil, false)
+			},
+			[]tracer.Call{},
+		},
+		{
+			"reverted call",
+			func(hooks *tracing.Hooks) {
+				stringTy, err := abi.NewType("string", "", nil)
+				require.NoError(t, err)
+				reason, err := abi.Arguments{{Type: stringTy}}.Pack("insufficient funds")
+				require.NoError(t, err)
+				// Error(string) selector followed by the reason
+				output := append(common.FromHex("0x08c379a0"), reason...)
+
+				hooks.OnEnter(0, byte(vm.CALL), sender, stakingAddr, pack(t, staking.ABI, staking.DelegateMethod, sender, validator, amount), 0, big.NewInt(0))
+				hooks.OnExit(0, output, 0, vm.ErrExecutionReverted, true)
+			},
+			[]tracer.Call{
+				{
+					Type:       "CALL",
+					From:       sender,
+					To:         stakingAddr,
+					Precompile: "staking",
+					Method:     staking.DelegateMethod,
+					Signature:  "delegate(address,string,uint256)",
+					Inputs: []tracer.Argument{
+						{Name: "delegatorAddress", Type: "address", Value: sender.Hex()},
+						{Name: "validatorAddress", Type: "string", Value: validator},
+						{Name: "amount", Type: "uint256", Value: amount.String()},
+					},
+					Error:        vm.ErrExecutionReverted.Error(),
+					RevertReason: "insufficient funds",
+				},
+			},
+		},
+		{
+			"unknown method",
+			func(hooks *tracing.Hooks) {
+				hooks.OnEnter(0, byte(vm.STATICCALL), sender, stakingAddr, []byte{0xde, 0xad, 0xbe, 0xef}, 0, big.NewInt(0))
+				hooks.OnExit(0, nil, 0, errors.New("unknown method"), false)
+			},
+			[]tracer.Call{
+				{
+					Type:       "STATICCALL",
+					From:       sender,
+					To:         stakingAddr,
+					Precompile: "staking",
+					Input:      []byte{0xde, 0xad, 0xbe, 0xef},
+					Error:      "unknown method",
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tr, err := tracers.DefaultDirectory.New(tracer.Name, &tracers.Context{}, nil, nil)
+			require.NoError(t, err)
+
+			tr.OnTxStart(&tracing.VMContext{StateDB: stateDB{}}, nil, sender)
+			tc.run(tr.Hooks)
+
+			res, err := tr.GetResult()
+			require.NoError(t, err)
+
+			expRes, err := json.Marshal(tc.expRes)
+			require.NoError(t, err)
+			require.JSONEq(t, string(expRes), string(res))
+		})
+	}
+}
+
+func TestRegisteredTracer(t *testing.T) {
+	require.True(t, evmtypes.IsRegisteredTracer(tracer.Name))
+}
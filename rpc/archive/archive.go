@@ -0,0 +1,208 @@
+// Package archive routes the gRPC state queries of the JSON-RPC backend by
+// height, so that pruned nodes can answer the historical EVM queries.
+//
+// The queries of the latest and recent heights are served by the local node,
+// and the ones of the older heights by the archive gRPC endpoint whose height
+// range contains them. The height of a query is read from its
+// x-cosmos-block-height metadata, set by rpctypes.ContextWithHeight.
+package archive
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sync"
+	"time"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/cosmos/evm/server/config"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// latestHeightTTL is the duration the latest height of the local node is cached for
+const latestHeightTTL = time.Second
+
+// endpoint is an archive gRPC endpoint serving the heights in [minHeight, maxHeight]
+type endpoint struct {
+	minHeight int64
+	maxHeight int64
+	conn      gogogrpc.ClientConn
+}
+
+// contains returns true if the endpoint serves the height
+func (e endpoint) contains(height int64) bool {
+	return e.minHeight <= height && height <= e.maxHeight
+}
+
+// Router is a gRPC client connection routing the queries by height to the
+// local node or to the archive endpoints. A node has a single Router, shared by
+// its backends, which must be closed on shutdown.
+type Router struct {
+	local         gogogrpc.ClientConn
+	recentHeights int64
+	endpoints     []endpoint
+	latestHeight  func(context.Context) (int64, error)
+
+	mu       sync.Mutex
+	latest   int64
+	cachedAt time.Time
+}
+
+var _ gogogrpc.ClientConn = (*Router)(nil)
+
+// NewRouter returns a Router serving the recent queries with the client
+// context and the historical ones with the archive endpoints of the config.
+func NewRouter(cfg config.ArchiveConfig, clientCtx client.Context) (*Router, error) {
+	conns := make([]gogogrpc.ClientConn, 0, len(cfg.Endpoints))
+	for _, endpointCfg := range cfg.Endpoints {
+		conn, err := grpc.NewClient(
+			endpointCfg.Address,
+			grpc.WithTransportCredentials(transportCredentials(endpointCfg)),
+			grpc.WithDefaultCallOptions(
+				grpc.ForceCodec(codec.NewProtoCodec(clientCtx.InterfaceRegistry).GRPCCodec()),
+			),
+		)
+		if err != nil {
+			// the connections created so far are closed
+			closeErr := closeConns(conns)
+			return nil, errors.Join(fmt.Errorf("failed to create the client of archive endpoint %s: %w", endpointCfg.Address, err), closeErr)
+		}
+		conns = append(conns, conn)
+	}
+
+	latestHeight := func(ctx context.Context) (int64, error) {
+		status, err := clientCtx.Client.Status(ctx)
+		if err != nil {
+			return 0, err
+		}
+		return status.SyncInfo.LatestBlockHeight, nil
+	}
+	return newRouter(cfg, clientCtx, conns, latestHeight), nil
+}
+
+// transportCredentials returns the credentials of the connection to the
+// endpoint: TLS verified with the system root certificates if it is enabled,
+// or else none.
+func transportCredentials(cfg config.ArchiveEndpointConfig) credentials.TransportCredentials {
+	if cfg.TLS {
+		return credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	}
+	return insecure.NewCredentials()
+}
+
+// newRouter returns a Router with the connections of the archive endpoints of
+// the config, in the same order.
+func newRouter(
+	cfg config.ArchiveConfig,
+	local gogogrpc.ClientConn,
+	conns []gogogrpc.ClientConn,
+	latestHeight func(context.Context) (int64, error),
+) *Router {
+	endpoints := make([]endpoint, len(cfg.Endpoints))
+	for i, endpointCfg := range cfg.Endpoints {
+		maxHeight := endpointCfg.MaxHeight
+		if maxHeight == 0 {
+			maxHeight = math.MaxInt64
+		}
+		endpoints[i] = endpoint{
+			minHeight: endpointCfg.MinHeight,
+			maxHeight: maxHeight,
+			conn:      conns[i],
+		}
+	}
+	return &Router{
+		local:         local,
+		recentHeights: cfg.RecentHeights,
+		endpoints:     endpoints,
+		latestHeight:  latestHeight,
+	}
+}
+
+// Close closes the connections to the archive endpoints, the local connection
+// is owned by the client context.
+func (r *Router) Close() error {
+	conns := make([]gogogrpc.ClientConn, len(r.endpoints))
+	for i, e := range r.endpoints {
+		conns[i] = e.conn
+	}
+	return closeConns(conns)
+}
+
+// closeConns closes the connections which can be closed
+func closeConns(conns []gogogrpc.ClientConn) error {
+	var errs []error
+	for _, conn := range conns {
+		if closer, ok := conn.(io.Closer); ok {
+			errs = append(errs, closer.Close())
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Invoke implements gogogrpc.ClientConn, it sends the query to the connection
+// serving its height.
+func (r *Router) Invoke(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+	conn, err := r.connFor(ctx, client.GetHeightFromMetadata(ctx))
+	if err != nil {
+		return err
+	}
+	return conn.Invoke(ctx, method, args, reply, opts...)
+}
+
+// NewStream implements gogogrpc.ClientConn, the streams are served by the
+// local node.
+func (r *Router) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return r.local.NewStream(ctx, desc, method, opts...)
+}
+
+// connFor returns the connection serving the queries of the height. The
+// queries of the latest height (0) and of the recent heights are served by the
+// local node, and the older ones by the first archive endpoint serving them,
+// or by the local node if there is none.
+func (r *Router) connFor(ctx context.Context, height int64) (gogogrpc.ClientConn, error) {
+	if height <= 0 {
+		return r.local, nil
+	}
+
+	latest, err := r.cachedLatestHeight(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the latest height: %w", err)
+	}
+	if height > latest-r.recentHeights {
+		return r.local, nil
+	}
+
+	for _, e := range r.endpoints {
+		if e.contains(height) {
+			return e.conn, nil
+		}
+	}
+	return r.local, nil
+}
+
+// cachedLatestHeight returns the latest height of the local node, fetched at
+// most once per latestHeightTTL.
+func (r *Router) cachedLatestHeight(ctx context.Context) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.cachedAt.IsZero() && time.Since(r.cachedAt) < latestHeightTTL {
+		return r.latest, nil
+	}
+	latest, err := r.latestHeight(ctx)
+	if err != nil {
+		return 0, err
+	}
+	r.latest = latest
+	r.cachedAt = time.Now()
+	return latest, nil
+}
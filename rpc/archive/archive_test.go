@@ -0,0 +1,148 @@
+package archive
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/server/config"
+)
+
+// fakeConn records the methods of the queries it serves
+type fakeConn struct {
+	gogogrpc.ClientConn
+	methods []string
+}
+
+func (c *fakeConn) Invoke(_ context.Context, method string, _, _ interface{}, _ ...grpc.CallOption) error {
+	c.methods = append(c.methods, method)
+	return nil
+}
+
+func TestRouterInvoke(t *testing.T) {
+	cfg := config.ArchiveConfig{
+		Enable:        true,
+		RecentHeights: 100,
+		Endpoints: []config.ArchiveEndpointConfig{
+			{Address: "archive-0:9090", MinHeight: 1, MaxHeight: 500},
+			{Address: "archive-1:9090", MinHeight: 400, MaxHeight: 0},
+		},
+	}
+
+	testCases := []struct {
+		name   string
+		height int64
+		latest int64
+		expect int // -1 for the local node, else the index of the endpoint
+	}{
+		{"latest height", 0, 1000, -1},
+		{"recent height", 950, 1000, -1},
+		{"first historical height", 900, 1000, 1},
+		{"first endpoint", 10, 1000, 0},
+		{"overlapping ranges, the first endpoint wins", 450, 1000, 0},
+		{"second endpoint, unbounded", 501, 1000, 1},
+		{"recent heights cover the whole chain", 10, 90, -1},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			local := &fakeConn{}
+			conns := []*fakeConn{{}, {}}
+			router := newRouter(cfg, local, []gogogrpc.ClientConn{conns[0], conns[1]}, func(context.Context) (int64, error) {
+				return tc.latest, nil
+			})
+
+			ctx := rpctypes.NewContextWithHeight(tc.height)
+			require.NoError(t, router.Invoke(ctx, "/cosmos.evm.vm.v1.Query/Balance", nil, nil))
+
+			expected := local
+			if tc.expect >= 0 {
+				expected = conns[tc.expect]
+			}
+			require.Equal(t, []string{"/cosmos.evm.vm.v1.Query/Balance"}, expected.methods)
+			for _, conn := range append(conns, local) {
+				if conn != expected {
+					require.Empty(t, conn.methods)
+				}
+			}
+		})
+	}
+}
+
+func TestRouterNoEndpointForHeight(t *testing.T) {
+	cfg := config.ArchiveConfig{
+		Enable:        true,
+		RecentHeights: 10,
+		Endpoints:     []config.ArchiveEndpointConfig{{Address: "archive:9090", MinHeight: 100, MaxHeight: 200}},
+	}
+	local, archive := &fakeConn{}, &fakeConn{}
+	router := newRouter(cfg, local, []gogogrpc.ClientConn{archive}, func(context.Context) (int64, error) {
+		return 1000, nil
+	})
+
+	// the pruned heights without archive endpoint are still sent to the local node
+	require.NoError(t, router.Invoke(rpctypes.NewContextWithHeight(50), "/method", nil, nil))
+	require.Len(t, local.methods, 1)
+	require.Empty(t, archive.methods)
+}
+
+func TestRouterLatestHeightError(t *testing.T) {
+	cfg := config.ArchiveConfig{Enable: true, RecentHeights: 10}
+	router := newRouter(cfg, &fakeConn{}, nil, func(context.Context) (int64, error) {
+		return 0, errors.New("node unavailable")
+	})
+	err := router.Invoke(rpctypes.NewContextWithHeight(5), "/method", nil, nil)
+	require.ErrorContains(t, err, "node unavailable")
+}
+
+func TestRouterCachesLatestHeight(t *testing.T) {
+	cfg := config.ArchiveConfig{Enable: true, RecentHeights: 10}
+	calls := 0
+	router := newRouter(cfg, &fakeConn{}, nil, func(context.Context) (int64, error) {
+		calls++
+		return 100, nil
+	})
+	for i := 0; i < 3; i++ {
+		require.NoError(t, router.Invoke(rpctypes.NewContextWithHeight(5), "/method", nil, nil))
+	}
+	require.Equal(t, 1, calls)
+}
+
+// closableConn is a fakeConn recording its closing
+type closableConn struct {
+	fakeConn
+	closed bool
+}
+
+func (c *closableConn) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestRouterClose(t *testing.T) {
+	cfg := config.ArchiveConfig{
+		Enable: true,
+		Endpoints: []config.ArchiveEndpointConfig{
+			{Address: "archive-0:9090", MinHeight: 1},
+			{Address: "archive-1:9090", MinHeight: 1},
+		},
+	}
+	local := &closableConn{}
+	archives := []*closableConn{{}, {}}
+	router := newRouter(cfg, local, []gogogrpc.ClientConn{archives[0], archives[1]}, nil)
+
+	// the archive connections are closed, the local one is owned by the client context
+	require.NoError(t, router.Close())
+	require.True(t, archives[0].closed)
+	require.True(t, archives[1].closed)
+	require.False(t, local.closed)
+}
+
+func TestTransportCredentials(t *testing.T) {
+	require.Equal(t, "insecure", transportCredentials(config.ArchiveEndpointConfig{}).Info().SecurityProtocol)
+	require.Equal(t, "tls", transportCredentials(config.ArchiveEndpointConfig{TLS: true}).Info().SecurityProtocol)
+}
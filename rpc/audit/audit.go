@@ -0,0 +1,191 @@
+// Package audit logs the requests of the HTTP and WebSocket JSON-RPC servers
+// and replays the logs against a node.
+//
+// Every request of the sampled JSON-RPC messages is logged as a structured
+// record with its method, params, caller IP, the latency and the response size
+// of its message, and optionally its response. The params of the redacted
+// namespaces and methods are not logged.
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"math/rand"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cosmos/evm/rpc/middleware"
+	"github.com/cosmos/evm/server/config"
+)
+
+const (
+	// TransportHTTP is the transport of the requests of the HTTP server
+	TransportHTTP = "http"
+	// TransportWS is the transport of the requests of the WebSocket server
+	TransportWS = "ws"
+
+	// recordMessage is the log message of the audit records
+	recordMessage = "rpc request"
+)
+
+// Logger logs the JSON-RPC requests
+type Logger struct {
+	logger          *slog.Logger
+	sampleRate      float64
+	redact          []string
+	recordResponses bool
+}
+
+// New returns a Logger writing the records to the handler
+func New(cfg config.AuditLogConfig, handler slog.Handler) *Logger {
+	return &Logger{
+		logger:          slog.New(handler),
+		sampleRate:      cfg.SampleRate,
+		redact:          cfg.Redact,
+		recordResponses: cfg.RecordResponses,
+	}
+}
+
+// Sample returns true if the next JSON-RPC message should be logged
+func (l *Logger) Sample() bool {
+	//#nosec G404 -- the sampling doesn't need a secure random source
+	return l.sampleRate >= 1 || rand.Float64() < l.sampleRate
+}
+
+// isRedacted returns true if the params of the method should not be logged.
+// The entries of the redact list are namespaces or methods.
+func (l *Logger) isRedacted(method string) bool {
+	namespace, _, _ := strings.Cut(method, "_")
+	for _, entry := range l.redact {
+		if entry == method || entry == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// Log logs the requests of a JSON-RPC message. res is the response of the
+// message, nil if it wasn't recorded, and size its size in bytes.
+func (l *Logger) Log(transport, ip string, msg, res []byte, size int, latency time.Duration) {
+	reqs, batch := middleware.ParseRequests(msg)
+	if len(reqs) == 0 {
+		// the message is invalid, it is logged without method
+		reqs = []middleware.Request{{}}
+	}
+
+	var responses map[string]json.RawMessage
+	if l.recordResponses && res != nil {
+		responses = responsesByID(res, batch)
+	}
+
+	for _, req := range reqs {
+		attrs := []any{
+			"transport", transport,
+			"ip", ip,
+			"method", req.Method,
+			"id", rawOrNull(req.ID),
+			"latency", latency,
+			"size", size,
+		}
+		if batch {
+			attrs = append(attrs, "batch", len(reqs))
+		}
+
+		redacted := l.isRedacted(req.Method)
+		if redacted {
+			attrs = append(attrs, "redacted", true)
+		} else {
+			attrs = append(attrs, "params", rawOrNull(req.Params))
+		}
+
+		if responses != nil && !redacted {
+			if response, ok := responses[string(req.ID)]; ok {
+				attrs = append(attrs, "response", response)
+			}
+		}
+
+		l.logger.Info(recordMessage, attrs...)
+	}
+}
+
+// responsesByID returns the responses of a JSON-RPC message, keyed by the
+// encoding of their id.
+func responsesByID(res []byte, batch bool) map[string]json.RawMessage {
+	var responses []json.RawMessage
+	if batch {
+		if err := json.Unmarshal(res, &responses); err != nil {
+			return nil
+		}
+	} else {
+		responses = []json.RawMessage{res}
+	}
+
+	byID := make(map[string]json.RawMessage, len(responses))
+	for _, response := range responses {
+		var idRes struct {
+			ID json.RawMessage `json:"id"`
+		}
+		if err := json.Unmarshal(response, &idRes); err != nil {
+			continue
+		}
+		byID[string(idRes.ID)] = bytes.TrimSpace(response)
+	}
+	return byID
+}
+
+// rawOrNull returns the raw JSON value, or null if it is empty
+func rawOrNull(raw json.RawMessage) json.RawMessage {
+	if len(raw) == 0 {
+		return json.RawMessage("null")
+	}
+	return raw
+}
+
+// Handler returns an http.Handler logging the sampled JSON-RPC requests
+// served by next.
+func (l *Logger) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !l.Sample() {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		body, err := middleware.ReadBody(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		rw := &responseWriter{ResponseWriter: w, record: l.recordResponses}
+		start := time.Now()
+		next.ServeHTTP(rw, r)
+		l.Log(TransportHTTP, middleware.RemoteIP(r), body, rw.body.Bytes(), rw.size, time.Since(start))
+	})
+}
+
+// responseWriter counts the bytes of the response, and records them if record
+// is true.
+type responseWriter struct {
+	http.ResponseWriter
+	record bool
+	body   bytes.Buffer
+	size   int
+}
+
+func (w *responseWriter) Write(b []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(b)
+	w.size += n
+	if w.record {
+		w.body.Write(b[:n])
+	}
+	return n, err
+}
+
+// Flush implements http.Flusher
+func (w *responseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
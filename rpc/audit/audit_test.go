@@ -0,0 +1,90 @@
+package audit_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/rpc/audit"
+	"github.com/cosmos/evm/server/config"
+)
+
+func newTestLogger(recordResponses bool) (*audit.Logger, *bytes.Buffer) {
+	var buf bytes.Buffer
+	cfg := config.DefaultAuditLogConfig()
+	cfg.Enable = true
+	cfg.RecordResponses = recordResponses
+	return audit.New(cfg, slog.NewJSONHandler(&buf, nil)), &buf
+}
+
+func readLines(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+	var lines []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var decoded map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &decoded))
+		lines = append(lines, decoded)
+	}
+	return lines
+}
+
+func TestLog(t *testing.T) {
+	logger, buf := newTestLogger(true)
+
+	msg := `[
+		{"jsonrpc":"2.0","id":1,"method":"eth_getBalance","params":["0x01","latest"]},
+		{"jsonrpc":"2.0","id":"a","method":"personal_unlockAccount","params":["0x01","password"]}
+	]`
+	res := `[{"jsonrpc":"2.0","id":1,"result":"0x0"},{"jsonrpc":"2.0","id":"a","result":true}]`
+	logger.Log(audit.TransportWS, "10.0.0.1", []byte(msg), []byte(res), len(res), time.Millisecond)
+
+	lines := readLines(t, buf)
+	require.Len(t, lines, 2)
+
+	require.Equal(t, "rpc request", lines[0]["msg"])
+	require.Equal(t, "ws", lines[0]["transport"])
+	require.Equal(t, "10.0.0.1", lines[0]["ip"])
+	require.Equal(t, "eth_getBalance", lines[0]["method"])
+	require.Equal(t, []any{"0x01", "latest"}, lines[0]["params"])
+	require.Equal(t, float64(len(res)), lines[0]["size"])
+	require.Equal(t, float64(2), lines[0]["batch"])
+	require.Equal(t, map[string]any{"jsonrpc": "2.0", "id": float64(1), "result": "0x0"}, lines[0]["response"])
+
+	// the params and response of the personal namespace are redacted
+	require.Equal(t, "personal_unlockAccount", lines[1]["method"])
+	require.Equal(t, true, lines[1]["redacted"])
+	require.NotContains(t, lines[1], "params")
+	require.NotContains(t, lines[1], "response")
+	require.NotContains(t, buf.String(), "password")
+}
+
+func TestHandler(t *testing.T) {
+	logger, buf := newTestLogger(false)
+	handler := logger.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x10"}`))
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}`))
+	req.RemoteAddr = "10.0.0.2:1234"
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	require.Equal(t, `{"jsonrpc":"2.0","id":1,"result":"0x10"}`, rec.Body.String())
+
+	lines := readLines(t, buf)
+	require.Len(t, lines, 1)
+	require.Equal(t, "http", lines[0]["transport"])
+	require.Equal(t, "10.0.0.2", lines[0]["ip"])
+	require.Equal(t, "eth_blockNumber", lines[0]["method"])
+	require.Nil(t, lines[0]["params"])
+	require.Equal(t, float64(rec.Body.Len()), lines[0]["size"])
+	require.NotContains(t, lines[0], "response")
+}
@@ -0,0 +1,155 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+)
+
+// Record is a request read from an audit log
+type Record struct {
+	Method   string          `json:"method"`
+	Params   json.RawMessage `json:"params"`
+	Redacted bool            `json:"redacted"`
+	Response json.RawMessage `json:"response"`
+}
+
+// ReadRecords reads the records of an audit log written in JSON, either by the
+// audit log file or by the node logger with the json log format. The other
+// lines are skipped.
+func ReadRecords(r io.Reader) ([]Record, error) {
+	var records []Record
+	reader := bufio.NewReader(r)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) > 0 {
+			if record, ok := parseRecord(line); ok {
+				records = append(records, record)
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// parseRecord parses a line of an audit log, it returns false if the line
+// isn't an audit record.
+func parseRecord(line []byte) (Record, bool) {
+	var record struct {
+		Record
+		// Msg is the message key of slog, and Message the one of the node logger
+		Msg     string `json:"msg"`
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(line, &record); err != nil {
+		return Record{}, false
+	}
+	if record.Msg != recordMessage && record.Message != recordMessage {
+		return Record{}, false
+	}
+	return record.Record, true
+}
+
+// ReplaySummary counts the records of a replay
+type ReplaySummary struct {
+	// Replayed is the number of replayed requests
+	Replayed int
+	// Mismatched is the number of replayed requests whose responses differ
+	Mismatched int
+	// Skipped is the number of requests which can't be replayed: the redacted,
+	// subscription and invalid requests, and the requests without recorded
+	// response when there is no node to compare to.
+	Skipped int
+}
+
+// Replay sends the requests of the records to the node at url and compares the
+// responses to the ones of the node at compareURL, or to the recorded ones if
+// compareURL is empty. The mismatches are written to out.
+func Replay(ctx context.Context, records []Record, url, compareURL string, out io.Writer) (ReplaySummary, error) {
+	var summary ReplaySummary
+	for _, record := range records {
+		if !isReplayable(record) || (compareURL == "" && len(record.Response) == 0) {
+			summary.Skipped++
+			continue
+		}
+
+		msg, err := json.Marshal(map[string]any{
+			"jsonrpc": "2.0",
+			"id":      1,
+			"method":  record.Method,
+			"params":  record.Params,
+		})
+		if err != nil {
+			return summary, err
+		}
+
+		res, err := post(ctx, url, msg)
+		if err != nil {
+			return summary, err
+		}
+		expected := []byte(record.Response)
+		if compareURL != "" {
+			if expected, err = post(ctx, compareURL, msg); err != nil {
+				return summary, err
+			}
+		}
+
+		summary.Replayed++
+		if !reflect.DeepEqual(normalizeResponse(expected), normalizeResponse(res)) {
+			summary.Mismatched++
+			_, _ = fmt.Fprintf(out, "mismatch %s %s\n- %s\n+ %s\n", record.Method, record.Params, bytes.TrimSpace(expected), bytes.TrimSpace(res))
+		}
+	}
+	return summary, nil
+}
+
+// isReplayable returns true if the request of the record can be replayed
+func isReplayable(record Record) bool {
+	switch {
+	case record.Redacted, record.Method == "":
+		return false
+	case record.Method == "eth_subscribe", record.Method == "eth_unsubscribe":
+		// the subscriptions are only served by the WebSocket server
+		return false
+	}
+	return true
+}
+
+// post sends a JSON-RPC message to the node at url and returns the response
+func post(ctx context.Context, url string, msg []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(msg))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send the request to %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+	return io.ReadAll(resp.Body)
+}
+
+// normalizeResponse decodes the result or error of a JSON-RPC response, the
+// responses which aren't JSON objects are compared as strings.
+func normalizeResponse(res []byte) any {
+	var decoded map[string]any
+	if err := json.Unmarshal(res, &decoded); err != nil {
+		return string(bytes.TrimSpace(res))
+	}
+	// the ids of the replayed requests differ from the recorded ones
+	delete(decoded, "id")
+	delete(decoded, "jsonrpc")
+	return decoded
+}
@@ -0,0 +1,83 @@
+package audit_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/rpc/audit"
+)
+
+func TestReadRecords(t *testing.T) {
+	log := strings.Join([]string{
+		`{"time":"2025-01-01T00:00:00Z","level":"INFO","msg":"rpc request","method":"eth_chainId","params":null}`,
+		`{"level":"info","module":"rpc-audit","method":"eth_getBalance","params":["0x01","latest"],"message":"rpc request"}`,
+		`{"level":"info","module":"server","message":"starting node"}`,
+		`not json`,
+		`{"msg":"rpc request","method":"personal_sign","redacted":true}`,
+	}, "\n")
+
+	records, err := audit.ReadRecords(strings.NewReader(log))
+	require.NoError(t, err)
+	require.Equal(t, []audit.Record{
+		{Method: "eth_chainId", Params: json.RawMessage("null")},
+		{Method: "eth_getBalance", Params: json.RawMessage(`["0x01","latest"]`)},
+		{Method: "personal_sign", Redacted: true},
+	}, records)
+}
+
+// newTestNode returns a JSON-RPC server returning the given results by method
+func newTestNode(t *testing.T, results map[string]string) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"result":` + results[req.Method] + `}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestReplay(t *testing.T) {
+	node := newTestNode(t, map[string]string{"eth_chainId": `"0x1"`, "eth_blockNumber": `"0x10"`})
+
+	// the recorded responses are compared, ignoring their ids
+	logger, buf := newTestLogger(true)
+	for _, exchange := range []struct{ msg, res string }{
+		{`{"jsonrpc":"2.0","id":7,"method":"eth_chainId"}`, `{"jsonrpc":"2.0","id":7,"result":"0x1"}`},
+		{`{"jsonrpc":"2.0","id":8,"method":"eth_blockNumber"}`, `{"jsonrpc":"2.0","id":8,"result":"0x5"}`},
+		{`{"jsonrpc":"2.0","id":9,"method":"personal_listAccounts"}`, `{"jsonrpc":"2.0","id":9,"result":[]}`},
+		{`{"jsonrpc":"2.0","id":10,"method":"eth_subscribe","params":["newHeads"]}`, `{"jsonrpc":"2.0","id":10,"result":"0x2"}`},
+	} {
+		logger.Log(audit.TransportHTTP, "127.0.0.1", []byte(exchange.msg), []byte(exchange.res), len(exchange.res), time.Millisecond)
+	}
+	records, err := audit.ReadRecords(buf)
+	require.NoError(t, err)
+	require.Len(t, records, 4)
+
+	var out bytes.Buffer
+	summary, err := audit.Replay(context.Background(), records, node.URL, "", &out)
+	require.NoError(t, err)
+	require.Equal(t, audit.ReplaySummary{Replayed: 2, Mismatched: 1, Skipped: 2}, summary)
+	require.Contains(t, out.String(), "mismatch eth_blockNumber null")
+	require.Contains(t, out.String(), `"result":"0x5"`)
+	require.Contains(t, out.String(), `"result":"0x10"`)
+
+	// or the responses of another node
+	other := newTestNode(t, map[string]string{"eth_chainId": `"0x2"`, "eth_blockNumber": `"0x10"`})
+	out.Reset()
+	summary, err = audit.Replay(context.Background(), records, node.URL, other.URL, &out)
+	require.NoError(t, err)
+	require.Equal(t, audit.ReplaySummary{Replayed: 2, Mismatched: 1, Skipped: 2}, summary)
+	require.Contains(t, out.String(), "mismatch eth_chainId null")
+}
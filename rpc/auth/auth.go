@@ -0,0 +1,253 @@
+// Package auth authenticates the clients of the HTTP and WebSocket JSON-RPC
+// servers and authorizes the namespaces and methods they call.
+//
+// The clients authenticate with an "Authorization: Bearer <token>" header,
+// where the token is either a static API key or a JWT signed with the HS256
+// secret, like the engine API authentication of go-ethereum. Every API key,
+// the JWTs and the clients without credentials are allowed to call a list of
+// namespaces and methods. The calls to the other methods return the JSON-RPC
+// error code -32001.
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/cosmos/evm/rpc/middleware"
+	"github.com/cosmos/evm/server/config"
+)
+
+const (
+	// ErrCodeUnauthorized is the JSON-RPC error code of the calls to the
+	// methods which aren't allowed
+	ErrCodeUnauthorized = -32001
+
+	// jwtExpiryTimeout is the maximum difference between the issuance time of a
+	// JWT and the current time, as in the go-ethereum engine API.
+	jwtExpiryTimeout = 60 * time.Second
+	// allowAll is the allow list entry allowing all the methods
+	allowAll = "*"
+)
+
+// Permissions are the namespaces and methods a client is allowed to call
+type Permissions struct {
+	all        bool
+	namespaces map[string]bool
+	methods    map[string]bool
+}
+
+// NewPermissions returns the permissions of an allow list, whose entries are
+// namespaces, methods or "*" for all the methods.
+func NewPermissions(allow []string) Permissions {
+	p := Permissions{
+		namespaces: make(map[string]bool),
+		methods:    make(map[string]bool),
+	}
+	for _, entry := range allow {
+		switch {
+		case entry == allowAll:
+			p.all = true
+		case strings.Contains(entry, "_"):
+			p.methods[entry] = true
+		default:
+			p.namespaces[entry] = true
+		}
+	}
+	return p
+}
+
+// Allows returns true if the method is allowed. The methods are named
+// "<namespace>_<method>", like the go-ethereum server does.
+func (p Permissions) Allows(method string) bool {
+	if p.all || p.methods[method] {
+		return true
+	}
+	namespace, _, ok := strings.Cut(method, "_")
+	return ok && p.namespaces[namespace]
+}
+
+// AllowsNamespace returns true if all the methods of the namespace are allowed
+func (p Permissions) AllowsNamespace(namespace string) bool {
+	return p.all || p.namespaces[namespace]
+}
+
+// Check returns true if all the methods called by the JSON-RPC message are
+// allowed. Otherwise, it returns false and the error response of the message.
+func (p Permissions) Check(msg []byte) ([]byte, bool) {
+	reqs, _ := middleware.ParseRequests(msg)
+
+	var forbidden string
+	for _, req := range reqs {
+		// the requests without method are rejected by the server
+		if req.Method != "" && !p.Allows(req.Method) {
+			forbidden = req.Method
+			break
+		}
+	}
+	if forbidden == "" {
+		return nil, true
+	}
+
+	return middleware.ErrorResponse(msg, func(req middleware.Request) middleware.Error {
+		if req.Method != "" && !p.Allows(req.Method) {
+			return middleware.Error{Code: ErrCodeUnauthorized, Message: fmt.Sprintf("method %s is not allowed", req.Method)}
+		}
+		return middleware.Error{Code: ErrCodeUnauthorized, Message: fmt.Sprintf("the batch calls the method %s, which is not allowed", forbidden)}
+	}), false
+}
+
+// Authenticator authenticates the JSON-RPC clients and returns their
+// permissions.
+type Authenticator struct {
+	public Permissions
+	// jwtSecret is the HS256 secret of the JWTs, nil if they are disabled
+	jwtSecret []byte
+	jwt       Permissions
+	// apiKeys are the permissions of the API keys, keyed by the hash of the key
+	apiKeys map[[32]byte]Permissions
+}
+
+// New returns an Authenticator enforcing the given configuration. It reads the
+// JWT secret from its file.
+func New(cfg config.AuthConfig) (*Authenticator, error) {
+	a := &Authenticator{
+		public:  NewPermissions(cfg.PublicAllow),
+		jwt:     NewPermissions(cfg.JWTAllow),
+		apiKeys: make(map[[32]byte]Permissions, len(cfg.APIKeys)),
+	}
+
+	if cfg.JWTSecret != "" {
+		secret, err := readJWTSecret(cfg.JWTSecret)
+		if err != nil {
+			return nil, err
+		}
+		a.jwtSecret = secret
+	}
+
+	for _, apiKey := range cfg.APIKeys {
+		a.apiKeys[sha256.Sum256([]byte(apiKey.Key))] = NewPermissions(apiKey.Allow)
+	}
+	return a, nil
+}
+
+// readJWTSecret reads the hex encoded 32 bytes secret of the file
+func readJWTSecret(path string) ([]byte, error) {
+	data, err := os.ReadFile(path) //#nosec G304 -- the path is set by the node operator
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the JWT secret %s: %w", path, err)
+	}
+	secret := common.FromHex(strings.TrimSpace(string(data)))
+	if len(secret) != 32 {
+		return nil, fmt.Errorf("invalid JWT secret %s: expected 32 hex encoded bytes", path)
+	}
+	return secret, nil
+}
+
+// Authorize returns the permissions and the identity of the client of the
+// request, or an error if its credentials are invalid. The clients without
+// credentials have the public permissions and an empty identity. The API keys
+// are identified by a prefix of their hash, and the JWTs by their subject.
+func (a *Authenticator) Authorize(r *http.Request) (Permissions, string, error) {
+	header := r.Header.Get("Authorization")
+	if header == "" {
+		return a.public, "", nil
+	}
+	token, ok := strings.CutPrefix(header, "Bearer ")
+	if !ok {
+		return Permissions{}, "", errors.New("invalid authorization header, expected a bearer token")
+	}
+
+	// the keys are looked up by hash so the lookup doesn't leak their prefix
+	hash := sha256.Sum256([]byte(token))
+	if perms, ok := a.apiKeys[hash]; ok {
+		return perms, "key:" + hex.EncodeToString(hash[:8]), nil
+	}
+	if a.jwtSecret == nil {
+		return Permissions{}, "", errors.New("invalid API key")
+	}
+	subject, err := a.verifyJWT(token)
+	if err != nil {
+		return Permissions{}, "", fmt.Errorf("invalid API key or JWT: %w", err)
+	}
+	return a.jwt, "jwt:" + subject, nil
+}
+
+// verifyJWT returns the subject of the token, or an error if it isn't signed
+// with the secret or if it wasn't issued within the expiry timeout.
+func (a *Authenticator) verifyJWT(token string) (string, error) {
+	var claims jwt.RegisteredClaims
+	parsed, err := jwt.ParseWithClaims(token, &claims, func(*jwt.Token) (interface{}, error) {
+		return a.jwtSecret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+
+	switch {
+	case err != nil:
+		return "", err
+	case !parsed.Valid:
+		return "", errors.New("invalid token")
+	case claims.IssuedAt == nil:
+		return "", errors.New("missing token issuance time")
+	case time.Since(claims.IssuedAt.Time) > jwtExpiryTimeout:
+		return "", errors.New("stale token")
+	case time.Until(claims.IssuedAt.Time) > jwtExpiryTimeout:
+		return "", errors.New("future token")
+	}
+	return claims.Subject, nil
+}
+
+// Handler returns an http.Handler authorizing the JSON-RPC requests before
+// passing them to next. The requests with invalid credentials are rejected
+// with the 401 status, and the ones calling methods which aren't allowed with
+// the 403 status and the -32001 JSON-RPC error code. The identity of the
+// client is passed to next in the request context.
+func (a *Authenticator) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		perms, client, err := a.Authorize(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		body, err := middleware.ReadBody(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if res, ok := perms.Check(body); !ok {
+			middleware.WriteError(w, http.StatusForbidden, res)
+			return
+		}
+		next.ServeHTTP(w, middleware.WithClient(r, client))
+	})
+}
+
+// NamespaceHandler returns an http.Handler authorizing the requests which
+// aren't JSON-RPC messages, like the GraphQL queries, before passing them to
+// next. The clients must be allowed all the methods of the namespace. The
+// requests with invalid credentials are rejected with the 401 status, and the
+// other unauthorized ones with the 403 status. The identity of the client is
+// passed to next in the request context.
+func (a *Authenticator) NamespaceHandler(namespace string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		perms, client, err := a.Authorize(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		if !perms.AllowsNamespace(namespace) {
+			http.Error(w, fmt.Sprintf("namespace %s is not allowed", namespace), http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, middleware.WithClient(r, client))
+	})
+}
@@ -0,0 +1,200 @@
+package auth_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/rpc/auth"
+	"github.com/cosmos/evm/rpc/middleware"
+	"github.com/cosmos/evm/server/config"
+)
+
+var secret = []byte("0123456789abcdef0123456789abcdef")
+
+func newTestAuthenticator(t *testing.T) *auth.Authenticator {
+	t.Helper()
+	secretPath := filepath.Join(t.TempDir(), "jwt.hex")
+	require.NoError(t, os.WriteFile(secretPath, []byte(hexutil.Encode(secret)+"\n"), 0o600))
+
+	cfg := config.DefaultAuthConfig()
+	cfg.Enable = true
+	cfg.PublicAllow = []string{"eth", "net"}
+	cfg.JWTSecret = secretPath
+	cfg.JWTAllow = []string{"*"}
+	cfg.APIKeys = []config.APIKeyConfig{
+		{Key: "tooling", Allow: []string{"eth", "debug", "txpool_content"}},
+	}
+	a, err := auth.New(cfg)
+	require.NoError(t, err)
+	return a
+}
+
+func signJWT(t *testing.T, key []byte, iat time.Time) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		IssuedAt: jwt.NewNumericDate(iat),
+	}).SignedString(key)
+	require.NoError(t, err)
+	return token
+}
+
+func TestPermissions(t *testing.T) {
+	perms := auth.NewPermissions([]string{"eth", "debug_traceTransaction"})
+	require.True(t, perms.Allows("eth_call"))
+	require.True(t, perms.Allows("debug_traceTransaction"))
+	require.False(t, perms.Allows("debug_traceCall"))
+	require.False(t, perms.Allows("ethereum_call"))
+	require.False(t, perms.Allows("eth"))
+
+	require.True(t, perms.AllowsNamespace("eth"))
+	require.False(t, perms.AllowsNamespace("debug"))
+
+	require.True(t, auth.NewPermissions([]string{"*"}).Allows("personal_unlockAccount"))
+	require.True(t, auth.NewPermissions([]string{"*"}).AllowsNamespace("eth"))
+	require.False(t, auth.NewPermissions(nil).Allows("eth_call"))
+}
+
+func TestAuthorize(t *testing.T) {
+	a := newTestAuthenticator(t)
+
+	testCases := []struct {
+		name       string
+		auth       string
+		expErr     bool
+		expClient  string
+		allowed    []string
+		notAllowed []string
+	}{
+		{"no credentials", "", false, "", []string{"eth_call", "net_version"}, []string{"debug_traceTransaction", "txpool_content"}},
+		{"api key", "Bearer tooling", false, "key:5a3461b5be8a5098", []string{"eth_call", "debug_traceTransaction", "txpool_content"}, []string{"net_version", "txpool_status"}},
+		{"jwt", "Bearer " + signJWT(t, secret, time.Now()), false, "jwt:", []string{"personal_listAccounts", "debug_traceTransaction"}, nil},
+		{"stale jwt", "Bearer " + signJWT(t, secret, time.Now().Add(-2*time.Minute)), true, "", nil, nil},
+		{"future jwt", "Bearer " + signJWT(t, secret, time.Now().Add(2*time.Minute)), true, "", nil, nil},
+		{"jwt signed with another secret", "Bearer " + signJWT(t, []byte("another secret of thirty-two b."), time.Now()), true, "", nil, nil},
+		{"unknown api key", "Bearer unknown", true, "", nil, nil},
+		{"not a bearer token", "Basic dXNlcjpwYXNz", true, "", nil, nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			if tc.auth != "" {
+				req.Header.Set("Authorization", tc.auth)
+			}
+			perms, client, err := a.Authorize(req)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expClient, client)
+			for _, method := range tc.allowed {
+				require.True(t, perms.Allows(method), method)
+			}
+			for _, method := range tc.notAllowed {
+				require.False(t, perms.Allows(method), method)
+			}
+		})
+	}
+}
+
+func TestNewInvalidSecret(t *testing.T) {
+	secretPath := filepath.Join(t.TempDir(), "jwt.hex")
+	require.NoError(t, os.WriteFile(secretPath, []byte("0x1234"), 0o600))
+
+	cfg := config.DefaultAuthConfig()
+	cfg.JWTSecret = secretPath
+	_, err := auth.New(cfg)
+	require.ErrorContains(t, err, "expected 32 hex encoded bytes")
+
+	cfg.JWTSecret = filepath.Join(t.TempDir(), "missing.hex")
+	_, err = auth.New(cfg)
+	require.Error(t, err)
+}
+
+func TestHandler(t *testing.T) {
+	a := newTestAuthenticator(t)
+
+	served := 0
+	var clients []string
+	handler := a.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served++
+		clients = append(clients, middleware.Client(r))
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	post := func(body, authorization string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := post(`{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}`, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec = post(`{"jsonrpc":"2.0","id":1,"method":"debug_traceTransaction","params":[]}`, "")
+	require.Equal(t, http.StatusForbidden, rec.Code)
+	require.JSONEq(t, `{"jsonrpc":"2.0","id":1,"error":{"code":-32001,"message":"method debug_traceTransaction is not allowed"}}`, rec.Body.String())
+
+	rec = post(`{"jsonrpc":"2.0","id":1,"method":"debug_traceTransaction","params":[]}`, "Bearer tooling")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec = post(`[{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"},{"jsonrpc":"2.0","id":2,"method":"personal_listAccounts"}]`, "Bearer tooling")
+	require.Equal(t, http.StatusForbidden, rec.Code)
+	require.JSONEq(t, `[
+		{"jsonrpc":"2.0","id":1,"error":{"code":-32001,"message":"the batch calls the method personal_listAccounts, which is not allowed"}},
+		{"jsonrpc":"2.0","id":2,"error":{"code":-32001,"message":"method personal_listAccounts is not allowed"}}
+	]`, rec.Body.String())
+
+	// a malformed batch element doesn't hide the other methods
+	rec = post(`[{"jsonrpc":"2.0","id":1,"method":5},{"jsonrpc":"2.0","id":2,"method":"debug_traceTransaction"}]`, "")
+	require.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = post(`{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}`, "Bearer unknown")
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	require.Equal(t, 2, served)
+	// the identity of the client is passed to the next handler
+	require.Equal(t, []string{"", "key:5a3461b5be8a5098"}, clients)
+}
+
+func TestNamespaceHandler(t *testing.T) {
+	a := newTestAuthenticator(t)
+
+	served := 0
+	handler := a.NamespaceHandler("debug", http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		served++
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	post := func(authorization string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query":"{ chainID }"}`))
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := post("")
+	require.Equal(t, http.StatusForbidden, rec.Code)
+	require.Equal(t, "namespace debug is not allowed\n", rec.Body.String())
+
+	require.Equal(t, http.StatusOK, post("Bearer tooling").Code)
+	require.Equal(t, http.StatusOK, post("Bearer "+signJWT(t, secret, time.Now())).Code)
+	require.Equal(t, http.StatusUnauthorized, post("Bearer unknown").Code)
+	require.Equal(t, 2, served)
+}
@@ -12,8 +12,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
-	"github.com/cometbft/cometbft/libs/bytes"
-
+	evmproof "github.com/cosmos/evm/rpc/proof"
 	rpctypes "github.com/cosmos/evm/rpc/types"
 	evmtrace "github.com/cosmos/evm/trace"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
@@ -49,7 +48,8 @@ func (b *Backend) GetCode(ctx context.Context, address common.Address, blockNrOr
 	return res.Code, nil
 }
 
-// GetProof returns an account object with proof and any storage proofs
+// GetProof returns an account object with proof and any storage proofs. The
+// proofs are ICS23 proofs anchored to the app hash, see the rpc/proof package.
 func (b *Backend) GetProof(ctx context.Context, address common.Address, storageKeys []string, blockNrOrHash rpctypes.BlockNumberOrHash) (result *rpctypes.AccountResult, err error) {
 	ctx, span := tracer.Start(ctx, "GetProof", trace.WithAttributes(attribute.String("address", address.String()), attribute.StringSlice("storageKeys", storageKeys), attribute.String("blockNorHash", unwrapBlockNOrHash(blockNrOrHash))))
 	defer func() { evmtrace.EndSpanErr(span, err) }()
@@ -112,11 +112,25 @@ func (b *Backend) GetProof(ctx context.Context, address common.Address, storageK
 	}
 
 	// query account proofs
-	accountKey := bytes.HexBytes(append(authtypes.AddressStoreKeyPrefix, address.Bytes()...))
-	_, proof, err := b.QueryClient.GetProof(clientCtx, authtypes.StoreKey, accountKey)
+	_, proof, err := b.QueryClient.GetProof(clientCtx, authtypes.StoreKey, evmproof.AccountKey(address))
+	if err != nil {
+		return nil, err
+	}
+
+	// query code hash proofs, the root of the evm store is used as storage hash
+	_, codeHashProof, err := b.QueryClient.GetProof(clientCtx, evmtypes.StoreKey, evmproof.CodeHashKey(address))
 	if err != nil {
 		return nil, err
 	}
+	codeHashProofs := GetHexProofs(codeHashProof)
+	var storageHash common.Hash
+	if codeHashProof != nil {
+		root, err := evmproof.StoreRoot(codeHashProofs)
+		if err != nil {
+			return nil, err
+		}
+		storageHash = common.BytesToHash(root)
+	}
 
 	balance, ok := sdkmath.NewIntFromString(res.Balance)
 	if !ok {
@@ -124,13 +138,14 @@ func (b *Backend) GetProof(ctx context.Context, address common.Address, storageK
 	}
 
 	return &rpctypes.AccountResult{
-		Address:      address,
-		AccountProof: GetHexProofs(proof),
-		Balance:      (*hexutil.Big)(balance.BigInt()),
-		CodeHash:     common.HexToHash(res.CodeHash),
-		Nonce:        hexutil.Uint64(res.Nonce),
-		StorageHash:  common.Hash{}, // NOTE: Cosmos EVM doesn't have a storage hash. TODO: implement?
-		StorageProof: storageProofs,
+		Address:       address,
+		AccountProof:  GetHexProofs(proof),
+		Balance:       (*hexutil.Big)(balance.BigInt()),
+		CodeHash:      common.HexToHash(res.CodeHash),
+		CodeHashProof: codeHashProofs,
+		Nonce:         hexutil.Uint64(res.Nonce),
+		StorageHash:   storageHash,
+		StorageProof:  storageProofs,
 	}, nil
 }
 
@@ -34,7 +34,7 @@ func TestNewBackend_ReturnsErrorOnBadClient(t *testing.T) {
 	// clientCtx with NO Client set (nil) — will fail SignClient assertion
 	clientCtx := client.Context{}
 
-	_, err := NewBackend(ctx, ctx.Logger, clientCtx, false, nil, nil)
+	_, err := NewBackend(ctx, ctx.Logger, clientCtx, false, nil, nil, nil)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "invalid rpc client")
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/lru"
 	"github.com/ethereum/go-ethereum/common/math"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
@@ -20,6 +21,7 @@ import (
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 
 	evmmempool "github.com/cosmos/evm/mempool"
+	"github.com/cosmos/evm/rpc/archive"
 	"github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/server/config"
 	servertypes "github.com/cosmos/evm/server/types"
@@ -53,9 +55,10 @@ type EVMBackend interface {
 	ListAccounts() ([]common.Address, error)
 	NewMnemonic(uid string, language keyring.Language, hdPath, bip39Passphrase string, algo keyring.SignatureAlgo) (*keyring.Record, error)
 	UnprotectedAllowed() bool
-	RPCGasCap() uint64            // global gas cap for eth_call over rpc: DoS protection
-	RPCEVMTimeout() time.Duration // global timeout for eth_call over rpc: DoS protection
-	RPCTxFeeCap() float64         // RPCTxFeeCap is the global transaction fee(price * gaslimit) cap for send-transaction variants. The unit is ether.
+	RPCGasCap() uint64               // global gas cap for eth_call over rpc: DoS protection
+	RPCEVMTimeout() time.Duration    // global timeout for eth_call over rpc: DoS protection
+	RPCTxSyncTimeout() time.Duration // maximum time eth_sendRawTransactionSync waits for the receipt
+	RPCTxFeeCap() float64            // RPCTxFeeCap is the global transaction fee(price * gaslimit) cap for send-transaction variants. The unit is ether.
 	RPCMinGasPrice() *big.Int
 
 	// Sign Tx
@@ -76,6 +79,7 @@ type EVMBackend interface {
 	BlockNumberFromComet(ctx context.Context, blockNrOrHash types.BlockNumberOrHash) (types.BlockNumber, error)
 	BlockNumberFromCometByHash(ctx context.Context, blockHash common.Hash) (*big.Int, error)
 	EthMsgsFromCometBlock(ctx context.Context, block *tmrpctypes.ResultBlock, blockRes *tmrpctypes.ResultBlockResults) []*evmtypes.MsgEthereumTx
+	CosmosTxsFromCometBlock(ctx context.Context, block *tmrpctypes.ResultBlock, blockRes *tmrpctypes.ResultBlockResults) []*types.CosmosTx
 	BlockBloomFromCometBlock(ctx context.Context, blockRes *tmrpctypes.ResultBlockResults) (ethtypes.Bloom, error)
 	HeaderByNumber(ctx context.Context, blockNum types.BlockNumber) (*ethtypes.Header, error)
 	HeaderByHash(ctx context.Context, blockHash common.Hash) (*ethtypes.Header, error)
@@ -108,9 +112,13 @@ type EVMBackend interface {
 	GetTxByTxIndex(ctx context.Context, height int64, txIndex uint) (*servertypes.TxResult, error)
 	GetTransactionByBlockAndIndex(ctx context.Context, block *tmrpctypes.ResultBlock, idx hexutil.Uint) (*types.RPCTransaction, error)
 	GetTransactionReceipt(ctx context.Context, hash common.Hash) (map[string]interface{}, error)
+	ReceiptFromTxResult(ctx context.Context, hash common.Hash, res *servertypes.TxResult) (map[string]interface{}, error)
 	GetTransactionLogs(ctx context.Context, hash common.Hash) ([]*ethtypes.Log, error)
 	GetTransactionByBlockHashAndIndex(ctx context.Context, hash common.Hash, idx hexutil.Uint) (*types.RPCTransaction, error)
 	GetTransactionByBlockNumberAndIndex(ctx context.Context, blockNum types.BlockNumber, idx hexutil.Uint) (*types.RPCTransaction, error)
+	GetCosmosTransactionByHash(ctx context.Context, hash common.Hash) (*types.RPCCosmosTransaction, error)
+	GetCosmosTransactionByBlockAndIndex(ctx context.Context, blockNrOrHash types.BlockNumberOrHash, idx hexutil.Uint) (*types.RPCCosmosTransaction, error)
+	GetTransactionsByAddress(ctx context.Context, address common.Address, args types.TransactionsByAddressArgs) (*types.TransactionsByAddressResult, error)
 	CreateAccessList(ctx context.Context, args evmtypes.TransactionArgs, blockNrOrHash types.BlockNumberOrHash, overrides *json.RawMessage) (*types.AccessListResult, error)
 
 	// Send Transaction
@@ -119,11 +127,14 @@ type EVMBackend interface {
 	SetTxDefaults(ctx context.Context, args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error)
 	EstimateGas(ctx context.Context, args evmtypes.TransactionArgs, blockNrOrHash *types.BlockNumberOrHash, overrides *json.RawMessage) (hexutil.Uint64, error)
 	DoCall(ctx context.Context, args evmtypes.TransactionArgs, blockNr types.BlockNumber, overrides *json.RawMessage) (*evmtypes.MsgEthereumTxResponse, error)
+	SimulateV1(ctx context.Context, opts types.SimOpts, blockNrOrHash *types.BlockNumberOrHash) ([]types.SimBlockResult, error)
 	GasPrice(ctx context.Context) (*hexutil.Big, error)
 
 	// Filter API
 	GetLogs(ctx context.Context, hash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(ctx context.Context, height *int64) ([][]*ethtypes.Log, error)
+	SyntheticLogs(ctx context.Context, blockRes *tmrpctypes.ResultBlockResults) ([][]*ethtypes.Log, error)
+	SyntheticLogsCanMatch(ctx context.Context, height int64, addresses []common.Address, topics [][]common.Hash) (bool, error)
 	BloomStatus() (uint64, uint64)
 
 	// TxPool API
@@ -136,6 +147,11 @@ type EVMBackend interface {
 	TraceTransaction(ctx context.Context, hash common.Hash, config *types.TraceConfig) (interface{}, error)
 	TraceBlock(ctx context.Context, height types.BlockNumber, config *types.TraceConfig, block *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error)
 	TraceCall(ctx context.Context, args evmtypes.TransactionArgs, blockNrOrHash types.BlockNumberOrHash, config *types.TraceConfig) (interface{}, error)
+
+	// State Inspection
+	StorageRangeAt(ctx context.Context, blockNrOrHash types.BlockNumberOrHash, txIndex int, address common.Address, keyStart hexutil.Bytes, maxResult int) (*types.StorageRangeResult, error)
+	AccountRange(ctx context.Context, blockNrOrHash types.BlockNumberOrHash, start hexutil.Bytes, maxResults int, noCode, noStorage bool) (*types.AccountRangeResult, error)
+	GetModifiedAccountsByNumber(ctx context.Context, startNum uint64, endNum *uint64) ([]common.Address, error)
 }
 
 var (
@@ -179,13 +195,53 @@ type Backend struct {
 	Indexer             servertypes.EVMTxIndexer
 	ProcessBlocker      ProcessBlocker
 	Mempool             *evmmempool.ExperimentalEVMMempool
+	// Cache caches the responses of the queries for committed heights, nil if disabled
+	Cache *ResponseCache
+
+	// syntheticCache caches the emitters of the synthetic logs by height
+	syntheticCache *lru.Cache[int64, *types.SyntheticLogs]
 }
 
 func (b *Backend) GetConfig() config.Config {
 	return b.Cfg
 }
 
-// NewBackend creates a new Backend instance for cosmos and ethereum namespaces
+// Shared holds the resources shared by all the backends of a node. A nil
+// Shared shares nothing.
+type Shared struct {
+	// Cache caches the responses of the queries for committed heights, nil if disabled
+	Cache *ResponseCache
+	// Archive routes the state queries to the archive endpoints by height, nil if disabled
+	Archive *archive.Router
+}
+
+// NewShared creates the resources shared by the backends of a node from the
+// app config. They must be closed on shutdown.
+func NewShared(appConf config.Config, clientCtx client.Context) (*Shared, error) {
+	shared := &Shared{}
+	if appConf.JSONRPC.Cache.Enable {
+		shared.Cache = NewResponseCache(appConf.JSONRPC.Cache)
+	}
+	if appConf.JSONRPC.Archive.Enable {
+		router, err := archive.NewRouter(appConf.JSONRPC.Archive, clientCtx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create the archive router: %w", err)
+		}
+		shared.Archive = router
+	}
+	return shared, nil
+}
+
+// Close closes the connections of the shared resources
+func (s *Shared) Close() error {
+	if s == nil || s.Archive == nil {
+		return nil
+	}
+	return s.Archive.Close()
+}
+
+// NewBackend creates a new Backend instance for cosmos and ethereum namespaces.
+// The backends of a node use the same shared resources.
 func NewBackend(
 	ctx *server.Context,
 	logger log.Logger,
@@ -193,6 +249,7 @@ func NewBackend(
 	allowUnprotectedTxs bool,
 	indexer servertypes.EVMTxIndexer,
 	mempool *evmmempool.ExperimentalEVMMempool,
+	shared *Shared,
 ) (*Backend, error) {
 	appConf, err := config.GetConfig(ctx.Viper)
 	if err != nil {
@@ -214,7 +271,14 @@ func NewBackend(
 		AllowUnprotectedTxs: allowUnprotectedTxs,
 		Indexer:             indexer,
 		Mempool:             mempool,
+		syntheticCache:      lru.NewCache[int64, *types.SyntheticLogs](syntheticLogsCacheSize),
 	}
 	b.ProcessBlocker = b.ProcessBlock
+	if shared != nil {
+		b.Cache = shared.Cache
+		if shared.Archive != nil {
+			b.QueryClient = types.NewQueryClientFromConn(shared.Archive)
+		}
+	}
 	return b, nil
 }
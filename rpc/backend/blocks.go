@@ -57,6 +57,13 @@ func (b *Backend) GetBlockByNumber(ctx context.Context, blockNum types.BlockNumb
 	ctx, span := tracer.Start(ctx, "GetBlockByNumber", trace.WithAttributes(attribute.Int64("blockNum", blockNum.Int64()), attribute.Bool("fullTx", fullTx)))
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 
+	// only the committed heights are cached
+	if blockNum > 0 {
+		if block, ok := b.Cache.Block(blockNum.Int64(), fullTx); ok {
+			return block, nil
+		}
+	}
+
 	resBlock, err := b.CometBlockByNumber(ctx, blockNum)
 	if err != nil {
 		return nil, nil
@@ -98,6 +105,10 @@ func (b *Backend) GetBlockByHash(ctx context.Context, hash common.Hash, fullTx b
 		return nil, nil
 	}
 
+	if block, ok := b.Cache.Block(resBlock.Block.Height, fullTx); ok {
+		return block, nil
+	}
+
 	blockRes, err := b.RPCClient.BlockResults(ctx, &resBlock.Block.Height)
 	if err != nil {
 		b.Logger.Debug("failed to fetch block result from CometBFT", "block-hash", hash.String(), "error", err.Error())
@@ -152,7 +163,7 @@ func (b *Backend) GetBlockTransactionCountByNumber(ctx context.Context, blockNum
 }
 
 // getBlockTransactionCount returns the number of Ethereum transactions in a
-// given block.
+// given block, and of its Cosmos pseudo-transactions if they are enabled.
 func (b *Backend) getBlockTransactionCount(ctx context.Context, block *cmtrpctypes.ResultBlock) *hexutil.Uint {
 	ctx, span := tracer.Start(ctx, "getBlockTransactionCount")
 	defer span.End()
@@ -163,6 +174,9 @@ func (b *Backend) getBlockTransactionCount(ctx context.Context, block *cmtrpctyp
 
 	ethMsgs := b.EthMsgsFromCometBlock(ctx, block, blockRes)
 	n := hexutil.Uint(len(ethMsgs))
+	if b.Cfg.JSONRPC.EnableCosmosTxs {
+		n += hexutil.Uint(len(b.CosmosTxsFromCometBlock(ctx, block, blockRes)))
+	}
 	return &n
 }
 
@@ -247,5 +261,53 @@ func (b *Backend) GetBlockReceipts(
 			return nil, fmt.Errorf("failed to marshal receipt")
 		}
 	}
+	if b.Cfg.JSONRPC.EnableCosmosTxs {
+		cosmosReceipts, err := b.cosmosReceipts(ctx, resBlock, blockRes, receipts)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, cosmosReceipts...)
+	}
+	return result, nil
+}
+
+// cosmosReceipts returns the receipts of the pseudo-transactions of the Cosmos
+// transactions of a block, which follow the receipts of its Ethereum
+// transactions. Their logs are the synthetic logs of the transactions, if they
+// are enabled.
+func (b *Backend) cosmosReceipts(
+	ctx context.Context,
+	resBlock *cmtrpctypes.ResultBlock,
+	blockRes *cmtrpctypes.ResultBlockResults,
+	ethReceipts []*ethtypes.Receipt,
+) ([]map[string]interface{}, error) {
+	cosmosTxs := b.CosmosTxsFromCometBlock(ctx, resBlock, blockRes)
+	if len(cosmosTxs) == 0 {
+		return nil, nil
+	}
+
+	synthetic, err := b.SyntheticLogs(ctx, blockRes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the synthetic logs of block %d: %w", blockRes.Height, err)
+	}
+	txLogs := make(map[uint][]*ethtypes.Log, len(synthetic))
+	for _, logs := range synthetic {
+		txLogs[logs[0].TxIndex] = logs
+	}
+
+	var cumulativeGasUsed uint64
+	if len(ethReceipts) > 0 {
+		cumulativeGasUsed = ethReceipts[len(ethReceipts)-1].CumulativeGasUsed
+	}
+	blockHash := common.BytesToHash(resBlock.BlockID.Hash)
+	height := uint64(resBlock.Block.Height) //#nosec G115 -- the block height is non-negative
+	result := make([]map[string]interface{}, len(cosmosTxs))
+	for i, cosmosTx := range cosmosTxs {
+		index := uint64(len(ethReceipts) + i) //#nosec G115 -- the index is non-negative
+		cumulativeGasUsed += cosmosTx.GasUsed
+
+		// the synthetic logs are indexed by the pseudo-transaction index
+		result[i] = types.RPCMarshalCosmosReceipt(cosmosTx, blockHash, height, index, cumulativeGasUsed, txLogs[uint(index)])
+	}
 	return result, nil
 }
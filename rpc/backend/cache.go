@@ -0,0 +1,331 @@
+package backend
+
+import (
+	"encoding/json"
+	"math/big"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/lru"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/metrics"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/server/config"
+)
+
+// cachedQuery is an LRU cache of the responses of a query, reporting its hits
+// and misses in the rpc/cache/<query>/hit and rpc/cache/<query>/miss metrics.
+type cachedQuery[K comparable, V any] struct {
+	lru    *lru.Cache[K, V]
+	hits   *metrics.Counter
+	misses *metrics.Counter
+}
+
+func newCachedQuery[K comparable, V any](query string, size int) *cachedQuery[K, V] {
+	return &cachedQuery[K, V]{
+		lru:    lru.NewCache[K, V](size),
+		hits:   metrics.GetOrRegisterCounter("rpc/cache/"+query+"/hit", nil),
+		misses: metrics.GetOrRegisterCounter("rpc/cache/"+query+"/miss", nil),
+	}
+}
+
+func (c *cachedQuery[K, V]) get(key K) (V, bool) {
+	value, ok := c.lru.Get(key)
+	if ok {
+		c.hits.Inc(1)
+	} else {
+		c.misses.Inc(1)
+	}
+	return value, ok
+}
+
+func (c *cachedQuery[K, V]) add(key K, value V) {
+	c.lru.Add(key, value)
+}
+
+// blockCacheKey identifies a cached JSON-RPC block
+type blockCacheKey struct {
+	height int64
+	fullTx bool
+}
+
+// traceCacheKey identifies a cached transaction trace
+type traceCacheKey struct {
+	hash   common.Hash
+	config string
+}
+
+// ResponseCache caches the JSON-RPC responses which never change: the blocks,
+// receipts, logs and traces of the committed heights. A nil ResponseCache
+// caches nothing.
+//
+// The cached values are deep copied when they are added and returned, as the
+// callers may modify them.
+type ResponseCache struct {
+	blocks   *cachedQuery[blockCacheKey, map[string]interface{}]
+	receipts *cachedQuery[common.Hash, map[string]interface{}]
+	logs     *cachedQuery[int64, [][]*ethtypes.Log]
+	traces   *cachedQuery[traceCacheKey, json.RawMessage]
+}
+
+// NewResponseCache returns a ResponseCache with the given sizes.
+func NewResponseCache(cfg config.CacheConfig) *ResponseCache {
+	return &ResponseCache{
+		blocks:   newCachedQuery[blockCacheKey, map[string]interface{}]("block", cfg.Size),
+		receipts: newCachedQuery[common.Hash, map[string]interface{}]("receipt", cfg.Size),
+		logs:     newCachedQuery[int64, [][]*ethtypes.Log]("logs", cfg.Size),
+		traces:   newCachedQuery[traceCacheKey, json.RawMessage]("trace", cfg.TraceSize),
+	}
+}
+
+// Block returns the cached JSON-RPC block of the height.
+func (c *ResponseCache) Block(height int64, fullTx bool) (map[string]interface{}, bool) {
+	if c == nil {
+		return nil, false
+	}
+	block, ok := c.blocks.get(blockCacheKey{height, fullTx})
+	return copyFields(block), ok
+}
+
+// AddBlock caches the JSON-RPC block of a committed height.
+func (c *ResponseCache) AddBlock(height int64, fullTx bool, block map[string]interface{}) {
+	if c == nil || block == nil {
+		return
+	}
+	c.blocks.add(blockCacheKey{height, fullTx}, copyFields(block))
+}
+
+// Receipt returns the cached JSON-RPC receipt of the transaction.
+func (c *ResponseCache) Receipt(hash common.Hash) (map[string]interface{}, bool) {
+	if c == nil {
+		return nil, false
+	}
+	receipt, ok := c.receipts.get(hash)
+	return copyFields(receipt), ok
+}
+
+// AddReceipt caches the JSON-RPC receipt of a transaction of a committed height.
+func (c *ResponseCache) AddReceipt(hash common.Hash, receipt map[string]interface{}) {
+	if c == nil || receipt == nil {
+		return
+	}
+	c.receipts.add(hash, copyFields(receipt))
+}
+
+// Logs returns the cached logs of the height, grouped by transaction.
+func (c *ResponseCache) Logs(height int64) ([][]*ethtypes.Log, bool) {
+	if c == nil {
+		return nil, false
+	}
+	logs, ok := c.logs.get(height)
+	if !ok {
+		return nil, false
+	}
+	return copyLogs(logs), true
+}
+
+// AddLogs caches the logs of a committed height.
+func (c *ResponseCache) AddLogs(height int64, logs [][]*ethtypes.Log) {
+	if c == nil {
+		return
+	}
+	c.logs.add(height, copyLogs(logs))
+}
+
+// Trace returns the cached result of the transaction trace with the given
+// config, it is decoded by the caller.
+func (c *ResponseCache) Trace(hash common.Hash, cfg *rpctypes.TraceConfig) (json.RawMessage, bool) {
+	if c == nil {
+		return nil, false
+	}
+	key, err := newTraceCacheKey(hash, cfg)
+	if err != nil {
+		return nil, false
+	}
+	return c.traces.get(key)
+}
+
+// AddTrace caches the result of the trace of a transaction of a committed
+// height with the given config.
+func (c *ResponseCache) AddTrace(hash common.Hash, cfg *rpctypes.TraceConfig, result json.RawMessage) {
+	if c == nil {
+		return
+	}
+	key, err := newTraceCacheKey(hash, cfg)
+	if err != nil {
+		return
+	}
+	c.traces.add(key, result)
+}
+
+// newTraceCacheKey returns the key of a trace, the timeout is ignored as it
+// doesn't change the successful results.
+func newTraceCacheKey(hash common.Hash, cfg *rpctypes.TraceConfig) (traceCacheKey, error) {
+	if cfg == nil {
+		return traceCacheKey{hash: hash}, nil
+	}
+	keyCfg := *cfg
+	keyCfg.Timeout = ""
+	bz, err := json.Marshal(keyCfg)
+	if err != nil {
+		return traceCacheKey{}, err
+	}
+	return traceCacheKey{hash: hash, config: string(bz)}, nil
+}
+
+// copyLogs deep copies the logs of the transactions of a block
+func copyLogs(logs [][]*ethtypes.Log) [][]*ethtypes.Log {
+	if logs == nil {
+		return nil
+	}
+	res := make([][]*ethtypes.Log, len(logs))
+	for i, txLogs := range logs {
+		res[i] = copyTxLogs(txLogs)
+	}
+	return res
+}
+
+func copyTxLogs(logs []*ethtypes.Log) []*ethtypes.Log {
+	if logs == nil {
+		return nil
+	}
+	res := make([]*ethtypes.Log, len(logs))
+	for i, log := range logs {
+		if log != nil {
+			cpy := *log
+			cpy.Topics = slices.Clone(log.Topics)
+			cpy.Data = slices.Clone(log.Data)
+			res[i] = &cpy
+		}
+	}
+	return res
+}
+
+// copyFields deep copies the fields of a JSON-RPC block or receipt
+func copyFields(fields map[string]interface{}) map[string]interface{} {
+	if fields == nil {
+		return nil
+	}
+	res := make(map[string]interface{}, len(fields))
+	for key, value := range fields {
+		res[key] = copyValue(value)
+	}
+	return res
+}
+
+// copyValue deep copies a value of the fields of a JSON-RPC block or receipt.
+// The values of the other types, like hexutil.Uint64 or common.Hash, have no
+// references and are returned as is.
+func copyValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return copyFields(v)
+	case []interface{}:
+		if v == nil {
+			return v
+		}
+		res := make([]interface{}, len(v))
+		for i, elem := range v {
+			res[i] = copyValue(elem)
+		}
+		return res
+	case *hexutil.Big:
+		return copyBig(v)
+	case *hexutil.Uint64:
+		return copyPtr(v)
+	case *common.Hash:
+		return copyPtr(v)
+	case *common.Address:
+		return copyPtr(v)
+	case hexutil.Bytes:
+		return slices.Clone(v)
+	case []common.Hash:
+		return slices.Clone(v)
+	case []*ethtypes.Log:
+		return copyTxLogs(v)
+	case ethtypes.Withdrawals:
+		if v == nil {
+			return v
+		}
+		res := make(ethtypes.Withdrawals, len(v))
+		for i, withdrawal := range v {
+			res[i] = copyPtr(withdrawal)
+		}
+		return res
+	case *rpctypes.RPCTransaction:
+		return copyRPCTransaction(v)
+	case *rpctypes.RPCCosmosTransaction:
+		return copyRPCCosmosTransaction(v)
+	default:
+		return value
+	}
+}
+
+func copyRPCTransaction(tx *rpctypes.RPCTransaction) *rpctypes.RPCTransaction {
+	if tx == nil {
+		return nil
+	}
+	cpy := *tx
+	cpy.BlockHash = copyPtr(tx.BlockHash)
+	cpy.BlockNumber = copyBig(tx.BlockNumber)
+	cpy.GasPrice = copyBig(tx.GasPrice)
+	cpy.GasFeeCap = copyBig(tx.GasFeeCap)
+	cpy.GasTipCap = copyBig(tx.GasTipCap)
+	cpy.MaxFeePerBlobGas = copyBig(tx.MaxFeePerBlobGas)
+	cpy.Input = slices.Clone(tx.Input)
+	cpy.To = copyPtr(tx.To)
+	cpy.TransactionIndex = copyPtr(tx.TransactionIndex)
+	cpy.Value = copyBig(tx.Value)
+	if tx.Accesses != nil {
+		accesses := make(ethtypes.AccessList, len(*tx.Accesses))
+		for i, tuple := range *tx.Accesses {
+			accesses[i] = ethtypes.AccessTuple{Address: tuple.Address, StorageKeys: slices.Clone(tuple.StorageKeys)}
+		}
+		cpy.Accesses = &accesses
+	}
+	cpy.ChainID = copyBig(tx.ChainID)
+	cpy.BlobVersionedHashes = slices.Clone(tx.BlobVersionedHashes)
+	cpy.AuthorizationList = slices.Clone(tx.AuthorizationList)
+	cpy.V = copyBig(tx.V)
+	cpy.R = copyBig(tx.R)
+	cpy.S = copyBig(tx.S)
+	cpy.YParity = copyPtr(tx.YParity)
+	return &cpy
+}
+
+func copyRPCCosmosTransaction(tx *rpctypes.RPCCosmosTransaction) *rpctypes.RPCCosmosTransaction {
+	if tx == nil {
+		return nil
+	}
+	cpy := *tx
+	cpy.BlockHash = copyPtr(tx.BlockHash)
+	cpy.BlockNumber = copyBig(tx.BlockNumber)
+	cpy.GasPrice = copyBig(tx.GasPrice)
+	cpy.Input = slices.Clone(tx.Input)
+	cpy.To = copyPtr(tx.To)
+	cpy.TransactionIndex = copyPtr(tx.TransactionIndex)
+	cpy.Value = copyBig(tx.Value)
+	cpy.V = copyBig(tx.V)
+	cpy.R = copyBig(tx.R)
+	cpy.S = copyBig(tx.S)
+	cpy.Fee = copyBig(tx.Fee)
+	cpy.Messages = slices.Clone(tx.Messages)
+	return &cpy
+}
+
+func copyBig(v *hexutil.Big) *hexutil.Big {
+	if v == nil {
+		return nil
+	}
+	return (*hexutil.Big)(new(big.Int).Set(v.ToInt()))
+}
+
+func copyPtr[T any](v *T) *T {
+	if v == nil {
+		return nil
+	}
+	cpy := *v
+	return &cpy
+}
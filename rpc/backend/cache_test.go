@@ -0,0 +1,197 @@
+package backend
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/cosmos/evm/rpc/backend/mocks"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/server/config"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+func TestResponseCache(t *testing.T) {
+	cache := NewResponseCache(config.DefaultCacheConfig())
+
+	_, ok := cache.Block(1, true)
+	require.False(t, ok)
+	cache.AddBlock(1, true, map[string]interface{}{"number": "0x1"})
+	block, ok := cache.Block(1, true)
+	require.True(t, ok)
+	require.Equal(t, "0x1", block["number"])
+	_, ok = cache.Block(1, false)
+	require.False(t, ok, "the blocks with and without the full txs are cached separately")
+
+	// the callers may modify the returned maps
+	block["number"] = "0x2"
+	block, _ = cache.Block(1, true)
+	require.Equal(t, "0x1", block["number"])
+
+	hash := common.HexToHash("0x01")
+	cache.AddReceipt(hash, map[string]interface{}{"status": "0x1"})
+	receipt, ok := cache.Receipt(hash)
+	require.True(t, ok)
+	receipt["logs"] = nil
+	receipt, _ = cache.Receipt(hash)
+	require.NotContains(t, receipt, "logs")
+
+	logs := [][]*ethtypes.Log{{{Index: 0}}, {}}
+	cache.AddLogs(1, logs)
+	cached, ok := cache.Logs(1)
+	require.True(t, ok)
+	require.Equal(t, logs, cached)
+	cached[0] = nil
+	cached, _ = cache.Logs(1)
+	require.Len(t, cached[0], 1)
+}
+
+func TestResponseCacheDeepCopy(t *testing.T) {
+	cache := NewResponseCache(config.DefaultCacheConfig())
+
+	to := common.HexToAddress("0x02")
+	cache.AddBlock(1, true, map[string]interface{}{
+		"number": (*hexutil.Big)(big.NewInt(1)),
+		"transactions": []interface{}{
+			&rpctypes.RPCTransaction{To: &to, Value: (*hexutil.Big)(big.NewInt(10)), Input: hexutil.Bytes{0x1}},
+			&rpctypes.RPCCosmosTransaction{Messages: []string{"/cosmos.bank.v1beta1.MsgSend"}},
+		},
+	})
+
+	// the nested values of the returned block are copies
+	block, _ := cache.Block(1, true)
+	block["number"].(*hexutil.Big).ToInt().SetInt64(2)
+	txs := block["transactions"].([]interface{})
+	tx := txs[0].(*rpctypes.RPCTransaction)
+	tx.To.SetBytes([]byte{0x3})
+	tx.Value.ToInt().SetInt64(20)
+	tx.Input[0] = 0x2
+	txs[1].(*rpctypes.RPCCosmosTransaction).Messages[0] = ""
+	txs[0] = nil
+
+	block, _ = cache.Block(1, true)
+	require.Equal(t, int64(1), block["number"].(*hexutil.Big).ToInt().Int64())
+	txs = block["transactions"].([]interface{})
+	tx = txs[0].(*rpctypes.RPCTransaction)
+	require.Equal(t, to, *tx.To)
+	require.Equal(t, int64(10), tx.Value.ToInt().Int64())
+	require.Equal(t, hexutil.Bytes{0x1}, tx.Input)
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", txs[1].(*rpctypes.RPCCosmosTransaction).Messages[0])
+
+	hash := common.HexToHash("0x01")
+	cache.AddReceipt(hash, map[string]interface{}{"logs": []*ethtypes.Log{{Index: 1, Topics: []common.Hash{{0x1}}}}})
+	receipt, _ := cache.Receipt(hash)
+	receiptLogs := receipt["logs"].([]*ethtypes.Log)
+	receiptLogs[0].Index = 2
+	receiptLogs[0].Topics[0] = common.Hash{0x2}
+	receipt, _ = cache.Receipt(hash)
+	require.Equal(t, []*ethtypes.Log{{Index: 1, Topics: []common.Hash{{0x1}}}}, receipt["logs"])
+
+	// the logs added to the cache are copied too
+	logs := [][]*ethtypes.Log{{{Index: 1, Data: []byte{0x1}}}}
+	cache.AddLogs(1, logs)
+	logs[0][0].Index = 2
+	logs[0][0].Data[0] = 0x2
+	cached, _ := cache.Logs(1)
+	require.Equal(t, [][]*ethtypes.Log{{{Index: 1, Data: []byte{0x1}}}}, cached)
+}
+
+func TestNewShared(t *testing.T) {
+	appConf := config.DefaultConfig()
+	shared, err := NewShared(*appConf, client.Context{})
+	require.NoError(t, err)
+	require.Nil(t, shared.Cache)
+	require.Nil(t, shared.Archive)
+
+	appConf.JSONRPC.Cache.Enable = true
+	appConf.JSONRPC.Archive.Enable = true
+	appConf.JSONRPC.Archive.Endpoints = []config.ArchiveEndpointConfig{{Address: "archive-0:9090", TLS: true}}
+	shared, err = NewShared(*appConf, client.Context{})
+	require.NoError(t, err)
+	require.NotNil(t, shared.Cache)
+	require.NotNil(t, shared.Archive)
+	require.NoError(t, shared.Close())
+}
+
+func TestResponseCacheTrace(t *testing.T) {
+	cache := NewResponseCache(config.DefaultCacheConfig())
+	hash := common.HexToHash("0x01")
+	tracer := &rpctypes.TraceConfig{TraceConfig: evmtypes.TraceConfig{Tracer: "callTracer", Timeout: "5s"}}
+
+	cache.AddTrace(hash, tracer, json.RawMessage(`{"type":"CALL"}`))
+	res, ok := cache.Trace(hash, &rpctypes.TraceConfig{TraceConfig: evmtypes.TraceConfig{Tracer: "callTracer", Timeout: "10s"}})
+	require.True(t, ok, "the timeout is ignored")
+	require.JSONEq(t, `{"type":"CALL"}`, string(res))
+
+	_, ok = cache.Trace(hash, &rpctypes.TraceConfig{TraceConfig: evmtypes.TraceConfig{Tracer: "prestateTracer"}})
+	require.False(t, ok)
+	_, ok = cache.Trace(hash, nil)
+	require.False(t, ok)
+
+	cache.AddTrace(hash, nil, json.RawMessage(`{"gas":21000}`))
+	res, ok = cache.Trace(hash, nil)
+	require.True(t, ok)
+	require.JSONEq(t, `{"gas":21000}`, string(res))
+}
+
+func TestResponseCacheMetrics(t *testing.T) {
+	cache := NewResponseCache(config.DefaultCacheConfig())
+	hits, misses := cache.receipts.hits.Snapshot().Count(), cache.receipts.misses.Snapshot().Count()
+
+	hash := common.HexToHash("0x02")
+	cache.Receipt(hash)
+	cache.AddReceipt(hash, map[string]interface{}{})
+	cache.Receipt(hash)
+	cache.Receipt(hash)
+
+	require.Equal(t, hits+2, cache.receipts.hits.Snapshot().Count())
+	require.Equal(t, misses+1, cache.receipts.misses.Snapshot().Count())
+}
+
+func TestNilResponseCache(t *testing.T) {
+	var cache *ResponseCache
+	cache.AddBlock(1, true, map[string]interface{}{})
+	cache.AddReceipt(common.Hash{}, map[string]interface{}{})
+	cache.AddLogs(1, nil)
+	cache.AddTrace(common.Hash{}, nil, json.RawMessage(`{}`))
+
+	_, ok := cache.Block(1, true)
+	require.False(t, ok)
+	_, ok = cache.Receipt(common.Hash{})
+	require.False(t, ok)
+	_, ok = cache.Logs(1)
+	require.False(t, ok)
+	_, ok = cache.Trace(common.Hash{}, nil)
+	require.False(t, ok)
+}
+
+func TestGetLogsByHeightCache(t *testing.T) {
+	backend := setupMockBackend(t)
+	backend.Cache = NewResponseCache(config.DefaultCacheConfig())
+
+	height := int64(5)
+	mockClient := backend.ClientCtx.Client.(*mocks.Client)
+	mockClient.On("BlockResults", mock.Anything, &height).Return(&tmrpctypes.ResultBlockResults{
+		Height:     height,
+		TxsResults: []*abcitypes.ExecTxResult{},
+	}, nil).Once()
+
+	logs, err := backend.GetLogsByHeight(context.Background(), &height)
+	require.NoError(t, err)
+	cached, err := backend.GetLogsByHeight(context.Background(), &height)
+	require.NoError(t, err)
+	require.Equal(t, logs, cached)
+	mockClient.AssertNumberOfCalls(t, "BlockResults", 1)
+}
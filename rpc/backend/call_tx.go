@@ -466,6 +466,74 @@ func (b *Backend) DoCall(
 	return res, nil
 }
 
+// SimulateV1 simulates a series of blocks of calls on top of the given block,
+// with optional block and state overrides. It returns the simulated blocks in
+// the eth_simulateV1 format.
+func (b *Backend) SimulateV1(
+	ctx context.Context,
+	opts rpctypes.SimOpts,
+	blockNrOrHash *rpctypes.BlockNumberOrHash,
+) (result []rpctypes.SimBlockResult, err error) {
+	ctx, span := tracer.Start(ctx, "SimulateV1", trace.WithAttributes(attribute.Int("blocks", len(opts.BlockStateCalls))))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	if len(opts.BlockStateCalls) > rpctypes.MaxSimulateBlocks {
+		return nil, &rpctypes.SimError{Code: rpctypes.SimErrCodeClientLimitExceeded, Message: "too many blocks"}
+	}
+
+	blockNr := rpctypes.EthLatestBlockNumber
+	if blockNrOrHash != nil {
+		blockNr, err = b.BlockNumberFromComet(ctx, *blockNrOrHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	bz, err := json.Marshal(&opts)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := b.CometHeaderByNumber(ctx, blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	req := evmtypes.SimulateV1Request{
+		Opts:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Header.ProposerAddress),
+		ChainId:         b.EvmChainID.Int64(),
+	}
+
+	ctx = rpctypes.ContextWithHeight(ctx, blockNr.Int64())
+	timeout := b.RPCEVMTimeout()
+
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	res, err := b.QueryClient.SimulateV1(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Error != "" {
+		return nil, &rpctypes.SimError{Code: int(res.ErrorCode), Message: res.Error}
+	}
+
+	if err := json.Unmarshal(res.Data, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 // GasPrice returns the current gas price based on Cosmos EVM' gas price oracle.
 func (b *Backend) GasPrice(ctx context.Context) (result *hexutil.Big, err error) {
 	ctx, span := tracer.Start(ctx, "GasPrice")
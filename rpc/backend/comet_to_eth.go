@@ -52,13 +52,49 @@ func (b *Backend) RPCBlockFromCometBlock(
 	ctx, span := tracer.Start(ctx, "RPCBlockFromCometBlock")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 
+	if block, ok := b.Cache.Block(resBlock.Block.Height, fullTx); ok {
+		return block, nil
+	}
+
 	msgs := b.EthMsgsFromCometBlock(ctx, resBlock, blockRes)
 	ethBlock, err := b.EthBlockFromCometBlock(ctx, resBlock, blockRes)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get rpc block from comet block: %w", err)
 	}
 
-	return rpctypes.RPCMarshalBlock(ethBlock, resBlock, msgs, true, fullTx, b.ChainConfig())
+	result, err = rpctypes.RPCMarshalBlock(ethBlock, resBlock, msgs, true, fullTx, b.ChainConfig())
+	if err != nil {
+		return nil, err
+	}
+	if b.Cfg.JSONRPC.EnableCosmosTxs {
+		result["transactions"] = b.appendCosmosTxs(ctx, result["transactions"].([]interface{}), resBlock, blockRes, len(msgs), fullTx)
+	}
+	// the blocks returned by CometBFT are committed and never change
+	b.Cache.AddBlock(resBlock.Block.Height, fullTx, result)
+	return result, nil
+}
+
+// appendCosmosTxs appends the pseudo-transactions of the Cosmos transactions
+// of a block to its transactions, after its numEthTxs Ethereum transactions.
+func (b *Backend) appendCosmosTxs(
+	ctx context.Context,
+	txs []interface{},
+	resBlock *cmtrpctypes.ResultBlock,
+	blockRes *cmtrpctypes.ResultBlockResults,
+	numEthTxs int,
+	fullTx bool,
+) []interface{} {
+	blockHash := common.BytesToHash(resBlock.BlockID.Hash)
+	height := uint64(resBlock.Block.Height) //#nosec G115 -- the block height is non-negative
+	for i, cosmosTx := range b.CosmosTxsFromCometBlock(ctx, resBlock, blockRes) {
+		if !fullTx {
+			txs = append(txs, cosmosTx.Hash)
+			continue
+		}
+		index := uint64(numEthTxs + i) //#nosec G115 -- the index is non-negative
+		txs = append(txs, rpctypes.NewRPCCosmosTransaction(cosmosTx, blockHash, height, index))
+	}
+	return txs
 }
 
 // BlockNumberFromComet returns the BlockNumber from BlockNumberOrHash
@@ -109,38 +145,85 @@ func (b *Backend) EthMsgsFromCometBlock(
 ) []*evmtypes.MsgEthereumTx {
 	_, span := tracer.Start(ctx, "EthMsgsFromCometBlock")
 	defer span.End()
-	var result []*evmtypes.MsgEthereumTx
+	result, _ := b.txsFromCometBlock(resBlock, blockRes, false)
+	return result
+}
+
+// CosmosTxsFromCometBlock returns the Cosmos transactions of a CometBFT block,
+// the ones without MsgEthereumTx, including the failed ones. They are exposed
+// as pseudo-transactions following the Ethereum transactions of the block
+// when EnableCosmosTxs is set, see rpctypes.CosmosTx.
+func (b *Backend) CosmosTxsFromCometBlock(
+	ctx context.Context,
+	resBlock *cmtrpctypes.ResultBlock,
+	blockRes *cmtrpctypes.ResultBlockResults,
+) []*rpctypes.CosmosTx {
+	_, span := tracer.Start(ctx, "CosmosTxsFromCometBlock")
+	defer span.End()
+	_, result := b.txsFromCometBlock(resBlock, blockRes, true)
+	return result
+}
+
+// txsFromCometBlock returns the MsgEthereumTxs of a CometBFT block and, if
+// inclCosmosTxs is set, its Cosmos transactions.
+func (b *Backend) txsFromCometBlock(
+	resBlock *cmtrpctypes.ResultBlock,
+	blockRes *cmtrpctypes.ResultBlockResults,
+	inclCosmosTxs bool,
+) ([]*evmtypes.MsgEthereumTx, []*rpctypes.CosmosTx) {
+	var (
+		ethMsgs   []*evmtypes.MsgEthereumTx
+		cosmosTxs []*rpctypes.CosmosTx
+	)
 	block := resBlock.Block
 
 	txResults := blockRes.TxsResults
 
-	for i, tx := range block.Txs {
+	for i, txBz := range block.Txs {
 		// Check if tx exists on EVM by cross checking with blockResults:
 		//  - Include unsuccessful tx that exceeds block gas limit
 		//  - Include unsuccessful tx that failed when committing changes to stateDB
 		//  - Exclude unsuccessful tx with any other error but ExceedBlockGasLimit
-		if !rpctypes.TxSucessOrExpectedFailure(txResults[i]) {
-			b.Logger.Debug("invalid tx result code", "cosmos-hash", hexutil.Encode(tx.Hash()))
+		// The failed Cosmos txs are included too, they are part of the block.
+		evmTxIncluded := rpctypes.TxSucessOrExpectedFailure(txResults[i])
+		if !evmTxIncluded && !inclCosmosTxs {
+			b.Logger.Debug("invalid tx result code", "cosmos-hash", hexutil.Encode(txBz.Hash()))
 			continue
 		}
 
-		tx, err := b.ClientCtx.TxConfig.TxDecoder()(tx)
+		tx, err := b.ClientCtx.TxConfig.TxDecoder()(txBz)
 		if err != nil {
 			b.Logger.Debug("failed to decode transaction in block", "height", block.Height, "error", err.Error())
 			continue
 		}
 
+		if inclCosmosTxs {
+			cosmosTx, err := rpctypes.NewCosmosTx(txBz, tx, txResults[i], uint(i)) //#nosec G115 -- the index is non-negative
+			if err != nil {
+				b.Logger.Debug("invalid cosmos tx", "cosmos-hash", hexutil.Encode(txBz.Hash()), "error", err.Error())
+				continue
+			}
+			if cosmosTx != nil {
+				cosmosTxs = append(cosmosTxs, cosmosTx)
+				continue
+			}
+			if !evmTxIncluded {
+				b.Logger.Debug("invalid tx result code", "cosmos-hash", hexutil.Encode(txBz.Hash()))
+				continue
+			}
+		}
+
 		for _, msg := range tx.GetMsgs() {
 			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
 			if !ok {
 				continue
 			}
 
-			result = append(result, ethMsg)
+			ethMsgs = append(ethMsgs, ethMsg)
 		}
 	}
 
-	return result
+	return ethMsgs, cosmosTxs
 }
 
 // RPCBlockFromCometBlock returns a JSON-RPC compatible Ethereum block from a
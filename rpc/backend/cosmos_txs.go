@@ -0,0 +1,137 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtrace "github.com/cosmos/evm/trace"
+)
+
+// GetCosmosTransactionByHash returns the pseudo-transaction of the Cosmos
+// transaction identified by its CometBFT hash, or nil if the pseudo-transactions
+// are disabled or if the hash isn't the one of a committed Cosmos transaction.
+func (b *Backend) GetCosmosTransactionByHash(ctx context.Context, hash common.Hash) (result *rpctypes.RPCCosmosTransaction, err error) {
+	if !b.Cfg.JSONRPC.EnableCosmosTxs {
+		return nil, nil
+	}
+	ctx, span := tracer.Start(ctx, "GetCosmosTransactionByHash", trace.WithAttributes(attribute.String("hash", hash.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	resBlock, blockRes, index, err := b.cosmosTxByHash(ctx, hash)
+	if err != nil || resBlock == nil {
+		return nil, err
+	}
+	return b.cosmosTxByIndex(ctx, resBlock, blockRes, index), nil
+}
+
+// GetCosmosTransactionByBlockAndIndex returns the pseudo-transaction at the
+// given index of the transactions of a block, or nil if the pseudo-transactions
+// are disabled or if there is none at this index.
+func (b *Backend) GetCosmosTransactionByBlockAndIndex(ctx context.Context, blockNrOrHash rpctypes.BlockNumberOrHash, idx hexutil.Uint) (result *rpctypes.RPCCosmosTransaction, err error) {
+	if !b.Cfg.JSONRPC.EnableCosmosTxs {
+		return nil, nil
+	}
+	//nolint:gosec // unlikely
+	ctx, span := tracer.Start(ctx, "GetCosmosTransactionByBlockAndIndex", trace.WithAttributes(attribute.String("blockNrOrHash", unwrapBlockNOrHash(blockNrOrHash)), attribute.Int64("idx", int64(idx))))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	blockNum, err := b.BlockNumberFromComet(ctx, blockNrOrHash)
+	if err != nil {
+		b.Logger.Debug("block not found", "blockNrOrHash", unwrapBlockNOrHash(blockNrOrHash), "error", err.Error())
+		return nil, nil
+	}
+	resBlock, err := b.CometBlockByNumber(ctx, blockNum)
+	if err != nil || resBlock == nil {
+		b.Logger.Debug("block not found", "height", blockNum.Int64())
+		return nil, nil
+	}
+	blockRes, err := b.CometBlockResultByNumber(ctx, &resBlock.Block.Height)
+	if err != nil {
+		return nil, nil
+	}
+	return b.cosmosTxByIndex(ctx, resBlock, blockRes, int(idx)), nil
+}
+
+// getCosmosTransactionReceipt returns the receipt of the pseudo-transaction of
+// the Cosmos transaction identified by its CometBFT hash, or nil if the hash
+// isn't the one of a committed Cosmos transaction.
+func (b *Backend) getCosmosTransactionReceipt(ctx context.Context, hash common.Hash) (map[string]interface{}, error) {
+	resBlock, _, index, err := b.cosmosTxByHash(ctx, hash)
+	if err != nil || resBlock == nil {
+		return nil, err
+	}
+
+	// the cumulative gas used of the receipt depends on the receipts before it
+	height := rpctypes.BlockNumber(resBlock.Block.Height)
+	receipts, err := b.GetBlockReceipts(ctx, rpctypes.BlockNumberOrHash{BlockNumber: &height})
+	if err != nil {
+		return nil, err
+	}
+	if index >= len(receipts) {
+		return nil, fmt.Errorf("receipt of pseudo-transaction %d not found in block %d", index, height)
+	}
+	return receipts[index], nil
+}
+
+// cosmosTxByHash returns the block of the Cosmos transaction identified by its
+// CometBFT hash and the index of its pseudo-transaction, or a nil block if the
+// hash isn't the one of a committed Cosmos transaction.
+func (b *Backend) cosmosTxByHash(ctx context.Context, hash common.Hash) (*cmtrpctypes.ResultBlock, *cmtrpctypes.ResultBlockResults, int, error) {
+	res, err := b.RPCClient.Tx(ctx, hash.Bytes(), false)
+	if err != nil {
+		b.Logger.Debug("cosmos tx not found", "hash", hash.Hex(), "error", err.Error())
+		return nil, nil, 0, nil
+	}
+
+	resBlock, err := b.CometBlockByNumber(ctx, rpctypes.BlockNumber(res.Height))
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("block not found at height %d: %w", res.Height, err)
+	}
+	if resBlock == nil {
+		return nil, nil, 0, nil
+	}
+	blockRes, err := b.CometBlockResultByNumber(ctx, &res.Height)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	ethMsgs, cosmosTxs := b.txsFromCometBlock(resBlock, blockRes, true)
+	for i, cosmosTx := range cosmosTxs {
+		if cosmosTx.BlockTxIndex == uint(res.Index) {
+			return resBlock, blockRes, len(ethMsgs) + i, nil
+		}
+	}
+	// an Ethereum transaction, looked up by its Ethereum hash
+	return nil, nil, 0, nil
+}
+
+// cosmosTxByIndex returns the pseudo-transaction at the given index of the
+// transactions of a block, or nil if there is none at this index.
+func (b *Backend) cosmosTxByIndex(
+	ctx context.Context,
+	resBlock *cmtrpctypes.ResultBlock,
+	blockRes *cmtrpctypes.ResultBlockResults,
+	index int,
+) *rpctypes.RPCCosmosTransaction {
+	_, span := tracer.Start(ctx, "cosmosTxByIndex")
+	defer span.End()
+
+	ethMsgs, cosmosTxs := b.txsFromCometBlock(resBlock, blockRes, true)
+	i := index - len(ethMsgs)
+	if i < 0 || i >= len(cosmosTxs) {
+		b.Logger.Debug("pseudo-transaction index out of bound", "height", resBlock.Block.Height, "index", index)
+		return nil
+	}
+	blockHash := common.BytesToHash(resBlock.BlockID.Hash)
+	height := uint64(resBlock.Block.Height) //#nosec G115 -- the block height is non-negative
+	txIndex := uint64(index)                //#nosec G115 -- checked to be non-negative
+	return rpctypes.NewRPCCosmosTransaction(cosmosTxs[i], blockHash, height, txIndex)
+}
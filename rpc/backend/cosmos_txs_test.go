@@ -0,0 +1,130 @@
+package backend
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/encoding"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/testutil/constants"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestCosmosTxsFromCometBlock(t *testing.T) {
+	encodingConfig := encoding.MakeConfig(constants.ExampleChainID.EVMChainID)
+	evmtypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	banktypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	txConfig := encodingConfig.TxConfig
+	denom := evmtypes.GetEVMCoinDenom()
+
+	privKey, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	signer := common.BytesToAddress(privKey.PubKey().Address())
+
+	// a bank send signed by the signer
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(banktypes.NewMsgSend(
+		signer.Bytes(), utiltx.GenerateAddress().Bytes(), sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(1))),
+	)))
+	builder.SetGasLimit(200_000)
+	builder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(400_000))))
+	require.NoError(t, builder.SetSignatures(signing.SignatureV2{
+		PubKey:   privKey.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+		Sequence: 7,
+	}))
+	bankTx, err := txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+
+	// an Ethereum tx
+	ethMsg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:  new(big.Int).SetUint64(constants.ExampleChainID.EVMChainID),
+		Nonce:    1,
+		GasLimit: 21_000,
+		GasPrice: big.NewInt(1),
+		To:       &common.Address{1},
+	})
+	ethMsg.From = signer.Bytes()
+	sdkTx, err := ethMsg.BuildTx(txConfig.NewTxBuilder(), denom)
+	require.NoError(t, err)
+	ethTx, err := txConfig.TxEncoder()(sdkTx)
+	require.NoError(t, err)
+
+	resBlock := &tmrpctypes.ResultBlock{
+		BlockID: tmtypes.BlockID{Hash: common.Hash{0xb}.Bytes()},
+		Block:   &tmtypes.Block{Header: tmtypes.Header{Height: 5}, Data: tmtypes.Data{Txs: []tmtypes.Tx{ethTx, bankTx, bankTx}}},
+	}
+	blockRes := &tmrpctypes.ResultBlockResults{
+		Height: 5,
+		TxsResults: []*abcitypes.ExecTxResult{
+			{GasUsed: 21_000},
+			{GasUsed: 50_000},
+			{Code: 5, GasUsed: 40_000},
+		},
+	}
+
+	backend := &Backend{ClientCtx: client.Context{}.WithTxConfig(txConfig), Logger: log.NewNopLogger()}
+
+	ctx := context.Background()
+	require.Len(t, backend.EthMsgsFromCometBlock(ctx, resBlock, blockRes), 1)
+
+	cosmosTxs := backend.CosmosTxsFromCometBlock(ctx, resBlock, blockRes)
+	require.Len(t, cosmosTxs, 2)
+	expected := &rpctypes.CosmosTx{
+		Hash:         common.BytesToHash(tmtypes.Tx(bankTx).Hash()),
+		From:         signer,
+		Nonce:        7,
+		Gas:          200_000,
+		GasUsed:      50_000,
+		Fee:          evmtypes.ConvertAmountTo18DecimalsBigInt(big.NewInt(400_000)),
+		Messages:     []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+		BlockTxIndex: 1,
+	}
+	require.Equal(t, expected, cosmosTxs[0])
+	require.True(t, cosmosTxs[1].Failed, "the failed Cosmos txs are part of the block")
+	require.Equal(t, uint(2), cosmosTxs[1].BlockTxIndex)
+
+	// the pseudo-transactions follow the Ethereum ones
+	txs := backend.appendCosmosTxs(ctx, []interface{}{ethMsg.Hash()}, resBlock, blockRes, 1, true)
+	require.Len(t, txs, 3)
+	pseudoTx := txs[1].(*rpctypes.RPCCosmosTransaction)
+	require.Equal(t, hexutil.Uint64(1), *pseudoTx.TransactionIndex)
+	require.Equal(t, hexutil.Uint64(rpctypes.CosmosTxType), pseudoTx.Type)
+	require.Equal(t, signer, pseudoTx.From)
+	require.Equal(t, expected.GasPrice(), pseudoTx.GasPrice.ToInt())
+
+	// the pseudo-transactions are looked up by their index in the block
+	require.Equal(t, pseudoTx, backend.cosmosTxByIndex(ctx, resBlock, blockRes, 1))
+	require.Nil(t, backend.cosmosTxByIndex(ctx, resBlock, blockRes, 0), "the Ethereum tx isn't a pseudo-transaction")
+	require.Nil(t, backend.cosmosTxByIndex(ctx, resBlock, blockRes, 3))
+
+	txs = backend.appendCosmosTxs(ctx, nil, resBlock, blockRes, 0, false)
+	require.Equal(t, []interface{}{expected.Hash, expected.Hash}, txs)
+
+	receipts, err := backend.cosmosReceipts(ctx, resBlock, blockRes, nil)
+	require.NoError(t, err)
+	require.Len(t, receipts, 2)
+	require.Equal(t, hexutil.Uint64(50_000), receipts[0]["cumulativeGasUsed"])
+	require.Equal(t, hexutil.Uint64(90_000), receipts[1]["cumulativeGasUsed"])
+	require.Equal(t, hexutil.Uint(0), receipts[1]["status"])
+	require.Equal(t, hexutil.Uint64(1), receipts[1]["transactionIndex"])
+}
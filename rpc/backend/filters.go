@@ -9,9 +9,17 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
+	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/cosmos/evm/rpc/types"
 	evmtrace "github.com/cosmos/evm/trace"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 )
 
+// syntheticLogsCacheSize is the number of heights whose emitters of the
+// synthetic logs are cached
+const syntheticLogsCacheSize = 256
+
 // GetLogs returns all the logs from all the ethereum transactions in a block.
 func (b *Backend) GetLogs(ctx context.Context, hash common.Hash) (result [][]*ethtypes.Log, err error) {
 	ctx, span := tracer.Start(ctx, "GetLogs", trace.WithAttributes(attribute.String("hash", hash.Hex())))
@@ -27,7 +35,8 @@ func (b *Backend) GetLogs(ctx context.Context, hash common.Hash) (result [][]*et
 	return b.GetLogsByHeight(ctx, &resBlock.Block.Height)
 }
 
-// GetLogsByHeight returns all the logs from all the ethereum transactions in a block.
+// GetLogsByHeight returns all the logs from all the ethereum transactions in a block,
+// followed by the synthetic logs of its Cosmos transactions if they are enabled.
 func (b *Backend) GetLogsByHeight(ctx context.Context, height *int64) (result [][]*ethtypes.Log, err error) {
 	var heightAttr int64
 	if height != nil {
@@ -36,13 +45,99 @@ func (b *Backend) GetLogsByHeight(ctx context.Context, height *int64) (result []
 	ctx, span := tracer.Start(ctx, "GetLogsByHeight", trace.WithAttributes(attribute.Int64("height", heightAttr)))
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 
+	if height != nil {
+		if logs, ok := b.Cache.Logs(*height); ok {
+			return logs, nil
+		}
+	}
+
 	// NOTE: we query the state in case the tx result logs are not persisted after an upgrade.
 	blockRes, err := b.RPCClient.BlockResults(ctx, height)
 	if err != nil {
 		return nil, err
 	}
 
-	return GetLogsFromBlockResults(blockRes)
+	result, err = GetLogsFromBlockResults(blockRes)
+	if err != nil {
+		return nil, err
+	}
+	synthetic, err := b.SyntheticLogs(ctx, blockRes)
+	if err != nil {
+		return nil, err
+	}
+	result = append(result, synthetic...)
+	b.Cache.AddLogs(blockRes.Height, result)
+	return result, nil
+}
+
+// SyntheticLogs returns the synthetic Transfer logs of the bank transfers of
+// the Cosmos transactions of a block, one slice per transaction with logs, or
+// nil if they are disabled. Their TxIndex is the index of the
+// pseudo-transaction of their Cosmos transaction. See types.SyntheticLogs.
+func (b *Backend) SyntheticLogs(ctx context.Context, blockRes *cmtrpctypes.ResultBlockResults) (result [][]*ethtypes.Log, err error) {
+	if !b.Cfg.JSONRPC.EnableSyntheticLogs {
+		return nil, nil
+	}
+	ctx, span := tracer.Start(ctx, "SyntheticLogs", trace.WithAttributes(attribute.Int64("height", blockRes.Height)))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	synthetic, err := b.syntheticLogs(ctx, blockRes.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	resBlock, err := b.CometBlockByNumber(ctx, types.BlockNumber(blockRes.Height))
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil {
+		return nil, errors.Errorf("block not found for height %d", blockRes.Height)
+	}
+
+	// the pseudo-transactions of the Cosmos transactions follow the Ethereum
+	// transactions of the block
+	ethMsgs, cosmosTxs := b.txsFromCometBlock(resBlock, blockRes, true)
+	txIndexes := make(map[int]uint, len(cosmosTxs))
+	for i, cosmosTx := range cosmosTxs {
+		txIndexes[int(cosmosTx.BlockTxIndex)] = uint(len(ethMsgs) + i) //#nosec G115 -- the indexes are bounded by the block size
+	}
+	return synthetic.BlockLogs(blockRes.Height, common.BytesToHash(resBlock.BlockID.Hash), resBlock.Block.Txs, blockRes.TxsResults, txIndexes)
+}
+
+// SyntheticLogsCanMatch returns false if the synthetic logs are disabled or
+// if none of the synthetic logs of the height can match the addresses and
+// topics of a filter, so that the filters can skip them.
+func (b *Backend) SyntheticLogsCanMatch(ctx context.Context, height int64, addresses []common.Address, topics [][]common.Hash) (bool, error) {
+	if !b.Cfg.JSONRPC.EnableSyntheticLogs {
+		return false, nil
+	}
+	synthetic, err := b.syntheticLogs(ctx, height)
+	if err != nil {
+		return false, err
+	}
+	return synthetic.CanMatch(addresses, topics), nil
+}
+
+// syntheticLogs returns the emitters of the synthetic logs of a block: the EVM
+// denom and the token pairs registered at its height, so that the pairs
+// registered or toggled afterwards don't change its logs. They are cached by
+// height, and queried on every call if the backend has no cache.
+func (b *Backend) syntheticLogs(ctx context.Context, height int64) (*types.SyntheticLogs, error) {
+	if b.syntheticCache != nil {
+		if synthetic, ok := b.syntheticCache.Get(height); ok {
+			return synthetic, nil
+		}
+	}
+
+	pairs, err := types.QueryTokenPairs(types.ContextWithHeight(ctx, height), b.QueryClient.Erc20)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query the token pairs at height %d", height)
+	}
+	synthetic := types.NewSyntheticLogs(evmtypes.GetEVMCoinDenom(), pairs)
+	if b.syntheticCache != nil {
+		b.syntheticCache.Add(height, synthetic)
+	}
+	return synthetic, nil
 }
 
 // BloomStatus returns the BloomBitsBlocks and the number of processed sections maintained
@@ -0,0 +1,80 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/lru"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/server/config"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+)
+
+// tokenPairsClient is an erc20 query client returning the token pairs registered at the queried
+// height
+type tokenPairsClient struct {
+	erc20types.QueryClient
+	pairs        map[int64]erc20types.TokenPair
+	queryHeights []int64
+}
+
+func (c *tokenPairsClient) TokenPairs(ctx context.Context, _ *erc20types.QueryTokenPairsRequest, _ ...grpc.CallOption) (*erc20types.QueryTokenPairsResponse, error) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	heights := md.Get(grpctypes.GRPCBlockHeightHeader)
+	if len(heights) != 1 {
+		return nil, errors.New("the token pairs must be queried at a height")
+	}
+	height, err := strconv.ParseInt(heights[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	c.queryHeights = append(c.queryHeights, height)
+
+	res := &erc20types.QueryTokenPairsResponse{}
+	for registeredAt, pair := range c.pairs {
+		if registeredAt <= height {
+			res.TokenPairs = append(res.TokenPairs, pair)
+		}
+	}
+	return res, nil
+}
+
+func TestSyntheticLogsAtHeight(t *testing.T) {
+	contract := common.Address{0xc}
+	client := &tokenPairsClient{pairs: map[int64]erc20types.TokenPair{
+		10: {Erc20Address: contract.Hex(), Denom: "ibc/token", Enabled: true, ContractOwner: erc20types.OWNER_MODULE},
+	}}
+	cfg := config.DefaultConfig()
+	cfg.JSONRPC.EnableSyntheticLogs = true
+	b := &Backend{
+		Cfg:            *cfg,
+		QueryClient:    &rpctypes.QueryClient{Erc20: client},
+		syntheticCache: lru.NewCache[int64, *rpctypes.SyntheticLogs](syntheticLogsCacheSize),
+	}
+	ctx := context.Background()
+	addresses := []common.Address{contract}
+
+	// the pair registered after the block doesn't emit synthetic logs in it
+	canMatch, err := b.SyntheticLogsCanMatch(ctx, 5, addresses, nil)
+	require.NoError(t, err)
+	require.False(t, canMatch)
+
+	canMatch, err = b.SyntheticLogsCanMatch(ctx, 10, addresses, nil)
+	require.NoError(t, err)
+	require.True(t, canMatch)
+
+	// the emitters are cached by height
+	canMatch, err = b.SyntheticLogsCanMatch(ctx, 5, addresses, nil)
+	require.NoError(t, err)
+	require.False(t, canMatch)
+	require.Equal(t, []int64{5, 10}, client.queryHeights)
+}
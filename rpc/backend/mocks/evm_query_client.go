@@ -99,6 +99,80 @@ func (_c *EVMQueryClient_Account_Call) RunAndReturn(run func(context.Context, *t
 	return _c
 }
 
+// AccountRange provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) AccountRange(ctx context.Context, in *types.QueryAccountRangeRequest, opts ...grpc.CallOption) (*types.QueryAccountRangeResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AccountRange")
+	}
+
+	var r0 *types.QueryAccountRangeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryAccountRangeRequest, ...grpc.CallOption) (*types.QueryAccountRangeResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryAccountRangeRequest, ...grpc.CallOption) *types.QueryAccountRangeResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryAccountRangeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryAccountRangeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EVMQueryClient_AccountRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AccountRange'
+type EVMQueryClient_AccountRange_Call struct {
+	*mock.Call
+}
+
+// AccountRange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.QueryAccountRangeRequest
+//   - opts ...grpc.CallOption
+func (_e *EVMQueryClient_Expecter) AccountRange(ctx interface{}, in interface{}, opts ...interface{}) *EVMQueryClient_AccountRange_Call {
+	return &EVMQueryClient_AccountRange_Call{Call: _e.mock.On("AccountRange",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *EVMQueryClient_AccountRange_Call) Run(run func(ctx context.Context, in *types.QueryAccountRangeRequest, opts ...grpc.CallOption)) *EVMQueryClient_AccountRange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.QueryAccountRangeRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *EVMQueryClient_AccountRange_Call) Return(_a0 *types.QueryAccountRangeResponse, _a1 error) *EVMQueryClient_AccountRange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EVMQueryClient_AccountRange_Call) RunAndReturn(run func(context.Context, *types.QueryAccountRangeRequest, ...grpc.CallOption) (*types.QueryAccountRangeResponse, error)) *EVMQueryClient_AccountRange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Balance provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) Balance(ctx context.Context, in *types.QueryBalanceRequest, opts ...grpc.CallOption) (*types.QueryBalanceResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -247,6 +321,80 @@ func (_c *EVMQueryClient_BaseFee_Call) RunAndReturn(run func(context.Context, *t
 	return _c
 }
 
+// CheckPermission provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) CheckPermission(ctx context.Context, in *types.QueryCheckPermissionRequest, opts ...grpc.CallOption) (*types.QueryCheckPermissionResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckPermission")
+	}
+
+	var r0 *types.QueryCheckPermissionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryCheckPermissionRequest, ...grpc.CallOption) (*types.QueryCheckPermissionResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryCheckPermissionRequest, ...grpc.CallOption) *types.QueryCheckPermissionResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryCheckPermissionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryCheckPermissionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EVMQueryClient_CheckPermission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckPermission'
+type EVMQueryClient_CheckPermission_Call struct {
+	*mock.Call
+}
+
+// CheckPermission is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.QueryCheckPermissionRequest
+//   - opts ...grpc.CallOption
+func (_e *EVMQueryClient_Expecter) CheckPermission(ctx interface{}, in interface{}, opts ...interface{}) *EVMQueryClient_CheckPermission_Call {
+	return &EVMQueryClient_CheckPermission_Call{Call: _e.mock.On("CheckPermission",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *EVMQueryClient_CheckPermission_Call) Run(run func(ctx context.Context, in *types.QueryCheckPermissionRequest, opts ...grpc.CallOption)) *EVMQueryClient_CheckPermission_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.QueryCheckPermissionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *EVMQueryClient_CheckPermission_Call) Return(_a0 *types.QueryCheckPermissionResponse, _a1 error) *EVMQueryClient_CheckPermission_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EVMQueryClient_CheckPermission_Call) RunAndReturn(run func(context.Context, *types.QueryCheckPermissionRequest, ...grpc.CallOption) (*types.QueryCheckPermissionResponse, error)) *EVMQueryClient_CheckPermission_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Code provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) Code(ctx context.Context, in *types.QueryCodeRequest, opts ...grpc.CallOption) (*types.QueryCodeResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -765,6 +913,228 @@ func (_c *EVMQueryClient_Params_Call) RunAndReturn(run func(context.Context, *ty
 	return _c
 }
 
+// PendingForks provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) PendingForks(ctx context.Context, in *types.QueryPendingForksRequest, opts ...grpc.CallOption) (*types.QueryPendingForksResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PendingForks")
+	}
+
+	var r0 *types.QueryPendingForksResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryPendingForksRequest, ...grpc.CallOption) (*types.QueryPendingForksResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryPendingForksRequest, ...grpc.CallOption) *types.QueryPendingForksResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryPendingForksResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryPendingForksRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EVMQueryClient_PendingForks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PendingForks'
+type EVMQueryClient_PendingForks_Call struct {
+	*mock.Call
+}
+
+// PendingForks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.QueryPendingForksRequest
+//   - opts ...grpc.CallOption
+func (_e *EVMQueryClient_Expecter) PendingForks(ctx interface{}, in interface{}, opts ...interface{}) *EVMQueryClient_PendingForks_Call {
+	return &EVMQueryClient_PendingForks_Call{Call: _e.mock.On("PendingForks",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *EVMQueryClient_PendingForks_Call) Run(run func(ctx context.Context, in *types.QueryPendingForksRequest, opts ...grpc.CallOption)) *EVMQueryClient_PendingForks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.QueryPendingForksRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *EVMQueryClient_PendingForks_Call) Return(_a0 *types.QueryPendingForksResponse, _a1 error) *EVMQueryClient_PendingForks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EVMQueryClient_PendingForks_Call) RunAndReturn(run func(context.Context, *types.QueryPendingForksRequest, ...grpc.CallOption) (*types.QueryPendingForksResponse, error)) *EVMQueryClient_PendingForks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SimulateV1 provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) SimulateV1(ctx context.Context, in *types.SimulateV1Request, opts ...grpc.CallOption) (*types.SimulateV1Response, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulateV1")
+	}
+
+	var r0 *types.SimulateV1Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.SimulateV1Request, ...grpc.CallOption) (*types.SimulateV1Response, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.SimulateV1Request, ...grpc.CallOption) *types.SimulateV1Response); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.SimulateV1Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.SimulateV1Request, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EVMQueryClient_SimulateV1_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SimulateV1'
+type EVMQueryClient_SimulateV1_Call struct {
+	*mock.Call
+}
+
+// SimulateV1 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.SimulateV1Request
+//   - opts ...grpc.CallOption
+func (_e *EVMQueryClient_Expecter) SimulateV1(ctx interface{}, in interface{}, opts ...interface{}) *EVMQueryClient_SimulateV1_Call {
+	return &EVMQueryClient_SimulateV1_Call{Call: _e.mock.On("SimulateV1",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *EVMQueryClient_SimulateV1_Call) Run(run func(ctx context.Context, in *types.SimulateV1Request, opts ...grpc.CallOption)) *EVMQueryClient_SimulateV1_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.SimulateV1Request), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *EVMQueryClient_SimulateV1_Call) Return(_a0 *types.SimulateV1Response, _a1 error) *EVMQueryClient_SimulateV1_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EVMQueryClient_SimulateV1_Call) RunAndReturn(run func(context.Context, *types.SimulateV1Request, ...grpc.CallOption) (*types.SimulateV1Response, error)) *EVMQueryClient_SimulateV1_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Sponsorship provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) Sponsorship(ctx context.Context, in *types.QuerySponsorshipRequest, opts ...grpc.CallOption) (*types.QuerySponsorshipResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Sponsorship")
+	}
+
+	var r0 *types.QuerySponsorshipResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QuerySponsorshipRequest, ...grpc.CallOption) (*types.QuerySponsorshipResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QuerySponsorshipRequest, ...grpc.CallOption) *types.QuerySponsorshipResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QuerySponsorshipResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QuerySponsorshipRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EVMQueryClient_Sponsorship_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Sponsorship'
+type EVMQueryClient_Sponsorship_Call struct {
+	*mock.Call
+}
+
+// Sponsorship is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.QuerySponsorshipRequest
+//   - opts ...grpc.CallOption
+func (_e *EVMQueryClient_Expecter) Sponsorship(ctx interface{}, in interface{}, opts ...interface{}) *EVMQueryClient_Sponsorship_Call {
+	return &EVMQueryClient_Sponsorship_Call{Call: _e.mock.On("Sponsorship",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *EVMQueryClient_Sponsorship_Call) Run(run func(ctx context.Context, in *types.QuerySponsorshipRequest, opts ...grpc.CallOption)) *EVMQueryClient_Sponsorship_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.QuerySponsorshipRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *EVMQueryClient_Sponsorship_Call) Return(_a0 *types.QuerySponsorshipResponse, _a1 error) *EVMQueryClient_Sponsorship_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EVMQueryClient_Sponsorship_Call) RunAndReturn(run func(context.Context, *types.QuerySponsorshipRequest, ...grpc.CallOption) (*types.QuerySponsorshipResponse, error)) *EVMQueryClient_Sponsorship_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Storage provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) Storage(ctx context.Context, in *types.QueryStorageRequest, opts ...grpc.CallOption) (*types.QueryStorageResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -839,6 +1209,80 @@ func (_c *EVMQueryClient_Storage_Call) RunAndReturn(run func(context.Context, *t
 	return _c
 }
 
+// StorageRangeAt provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) StorageRangeAt(ctx context.Context, in *types.QueryStorageRangeAtRequest, opts ...grpc.CallOption) (*types.QueryStorageRangeAtResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StorageRangeAt")
+	}
+
+	var r0 *types.QueryStorageRangeAtResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryStorageRangeAtRequest, ...grpc.CallOption) (*types.QueryStorageRangeAtResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryStorageRangeAtRequest, ...grpc.CallOption) *types.QueryStorageRangeAtResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryStorageRangeAtResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryStorageRangeAtRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EVMQueryClient_StorageRangeAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StorageRangeAt'
+type EVMQueryClient_StorageRangeAt_Call struct {
+	*mock.Call
+}
+
+// StorageRangeAt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.QueryStorageRangeAtRequest
+//   - opts ...grpc.CallOption
+func (_e *EVMQueryClient_Expecter) StorageRangeAt(ctx interface{}, in interface{}, opts ...interface{}) *EVMQueryClient_StorageRangeAt_Call {
+	return &EVMQueryClient_StorageRangeAt_Call{Call: _e.mock.On("StorageRangeAt",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *EVMQueryClient_StorageRangeAt_Call) Run(run func(ctx context.Context, in *types.QueryStorageRangeAtRequest, opts ...grpc.CallOption)) *EVMQueryClient_StorageRangeAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.QueryStorageRangeAtRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *EVMQueryClient_StorageRangeAt_Call) Return(_a0 *types.QueryStorageRangeAtResponse, _a1 error) *EVMQueryClient_StorageRangeAt_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EVMQueryClient_StorageRangeAt_Call) RunAndReturn(run func(context.Context, *types.QueryStorageRangeAtRequest, ...grpc.CallOption) (*types.QueryStorageRangeAtResponse, error)) *EVMQueryClient_StorageRangeAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TraceBlock provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) TraceBlock(ctx context.Context, in *types.QueryTraceBlockRequest, opts ...grpc.CallOption) (*types.QueryTraceBlockResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -319,6 +319,12 @@ func (b *Backend) RPCEVMTimeout() time.Duration {
 	return b.Cfg.JSONRPC.EVMTimeout
 }
 
+// RPCTxSyncTimeout is the maximum time eth_sendRawTransactionSync waits for
+// the receipt of the transaction.
+func (b *Backend) RPCTxSyncTimeout() time.Duration {
+	return b.Cfg.JSONRPC.TxSyncTimeout
+}
+
 // RPCGasCap is the global gas cap for eth-call variants.
 func (b *Backend) RPCTxFeeCap() float64 {
 	return b.Cfg.JSONRPC.TxFeeCap
@@ -0,0 +1,276 @@
+package backend
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtrace "github.com/cosmos/evm/trace"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// maxModifiedAccountsRange is the maximum number of blocks traced by
+// GetModifiedAccountsByNumber.
+const maxModifiedAccountsRange = 1000
+
+// StorageRangeAt returns the storage of the contract before the execution of
+// the transaction at txIndex in the block, starting from keyStart.
+func (b *Backend) StorageRangeAt(
+	ctx context.Context,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	txIndex int,
+	address common.Address,
+	keyStart hexutil.Bytes,
+	maxResult int,
+) (result *rpctypes.StorageRangeResult, err error) {
+	ctx, span := tracer.Start(ctx, "StorageRangeAt", trace.WithAttributes(attribute.String("address", address.String()), attribute.Int("txIndex", txIndex), attribute.String("blockNrOrHash", unwrapBlockNOrHash(blockNrOrHash))))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	if txIndex < 0 {
+		return nil, fmt.Errorf("invalid transaction index %d", txIndex)
+	}
+	if maxResult < 0 {
+		return nil, fmt.Errorf("invalid max result %d", maxResult)
+	}
+
+	blockNum, err := b.BlockNumberFromComet(ctx, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	blk, err := b.CometBlockByNumber(ctx, blockNum)
+	if err != nil {
+		return nil, err
+	}
+	if blk == nil {
+		return nil, errors.New("block not found")
+	}
+	if blk.Block.Height == 0 {
+		return nil, errors.New("genesis state is not available")
+	}
+
+	blockRes, err := b.CometBlockResultByNumber(ctx, &blk.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+	msgs := b.EthMsgsFromCometBlock(ctx, blk, blockRes)
+	if txIndex > len(msgs) {
+		return nil, fmt.Errorf("transaction index %d out of range for block %d", txIndex, blk.Block.Height)
+	}
+
+	nc, ok := b.ClientCtx.Client.(tmrpcclient.NetworkClient)
+	if !ok {
+		return nil, errors.New("invalid rpc client")
+	}
+	cp, err := nc.ConsensusParams(ctx, &blk.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &evmtypes.QueryStorageRangeAtRequest{
+		Address:         address.Hex(),
+		StartKey:        common.BytesToHash(keyStart).Hex(),
+		MaxResult:       uint64(maxResult),
+		Predecessors:    msgs[:txIndex],
+		BlockNumber:     blk.Block.Height,
+		BlockTime:       blk.Block.Time,
+		BlockHash:       common.Bytes2Hex(blk.BlockID.Hash),
+		ProposerAddress: sdk.ConsAddress(blk.Block.ProposerAddress),
+		ChainId:         b.EvmChainID.Int64(),
+		BlockMaxGas:     cp.ConsensusParams.Block.MaxGas,
+	}
+
+	// minus one to get the context of block beginning
+	contextHeight := max(blk.Block.Height-1, 1)
+	res, err := b.QueryClient.StorageRangeAt(rpctypes.ContextWithHeight(ctx, contextHeight), req)
+	if err != nil {
+		return nil, err
+	}
+
+	result = &rpctypes.StorageRangeResult{
+		Storage: make(rpctypes.StorageMap, len(res.Storage)),
+	}
+	for _, state := range res.Storage {
+		key := common.HexToHash(state.Key)
+		result.Storage[crypto.Keccak256Hash(key.Bytes())] = rpctypes.StorageEntry{
+			Key:   &key,
+			Value: common.HexToHash(state.Value),
+		}
+	}
+	if res.NextKey != "" {
+		next := common.HexToHash(res.NextKey)
+		result.NextKey = &next
+	}
+	return result, nil
+}
+
+// AccountRange returns the contract accounts at the end of the block,
+// starting from the start address.
+func (b *Backend) AccountRange(
+	ctx context.Context,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	start hexutil.Bytes,
+	maxResults int,
+	noCode, noStorage bool,
+) (result *rpctypes.AccountRangeResult, err error) {
+	ctx, span := tracer.Start(ctx, "AccountRange", trace.WithAttributes(attribute.String("start", start.String()), attribute.Int("maxResults", maxResults), attribute.String("blockNrOrHash", unwrapBlockNOrHash(blockNrOrHash))))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	if maxResults < 0 {
+		return nil, fmt.Errorf("invalid max results %d", maxResults)
+	}
+	if len(start) > common.AddressLength {
+		return nil, fmt.Errorf("invalid start address %s", start)
+	}
+
+	blockNum, err := b.BlockNumberFromComet(ctx, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	header, err := b.CometHeaderByNumber(ctx, blockNum)
+	if err != nil {
+		return nil, err
+	}
+	if header == nil || header.Header == nil {
+		return nil, errors.New("header not found")
+	}
+
+	req := &evmtypes.QueryAccountRangeRequest{
+		MaxResult: uint64(maxResults),
+		NoCode:    noCode,
+		NoStorage: noStorage,
+	}
+	if len(start) > 0 {
+		req.Start = common.BytesToAddress(start).Hex()
+	}
+	res, err := b.QueryClient.AccountRange(rpctypes.ContextWithHeight(ctx, header.Header.Height), req)
+	if err != nil {
+		return nil, err
+	}
+
+	result = &rpctypes.AccountRangeResult{
+		Root:     common.BytesToHash(header.Header.AppHash),
+		Accounts: make(map[common.Address]rpctypes.DumpAccount, len(res.Accounts)),
+	}
+	for _, acc := range res.Accounts {
+		address := common.HexToAddress(acc.Address)
+		account := rpctypes.DumpAccount{
+			Balance:  acc.Balance,
+			Nonce:    acc.Nonce,
+			CodeHash: common.HexToHash(acc.CodeHash).Bytes(),
+			Address:  &address,
+		}
+		if acc.Code != "" {
+			if account.Code, err = hexutil.Decode(acc.Code); err != nil {
+				return nil, err
+			}
+		}
+		if len(acc.Storage) > 0 {
+			account.Storage = make(map[common.Hash]string, len(acc.Storage))
+			for _, state := range acc.Storage {
+				account.Storage[common.HexToHash(state.Key)] = state.Value
+			}
+		}
+		result.Accounts[address] = account
+	}
+	if res.Next != "" {
+		result.Next = common.HexToAddress(res.Next).Bytes()
+	}
+	return result, nil
+}
+
+// GetModifiedAccountsByNumber returns the accounts modified by the EVM
+// execution of the blocks in (startNum, endNum]. If endNum is nil, it returns
+// the accounts modified by the block startNum.
+func (b *Backend) GetModifiedAccountsByNumber(ctx context.Context, startNum uint64, endNum *uint64) (result []common.Address, err error) {
+	ctx, span := tracer.Start(ctx, "GetModifiedAccountsByNumber", trace.WithAttributes(attribute.Int64("startNum", int64(startNum)))) //#nosec G115 -- int overflow is not a concern here
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	var first, last uint64
+	if endNum == nil {
+		if startNum == 0 {
+			return nil, errors.New("genesis is not traceable")
+		}
+		first, last = startNum, startNum
+	} else {
+		if startNum >= *endNum {
+			return nil, fmt.Errorf("start block (%d) must be less than end block (%d)", startNum, *endNum)
+		}
+		first, last = startNum+1, *endNum
+	}
+	if last-first+1 > maxModifiedAccountsRange {
+		return nil, fmt.Errorf("block range %d exceeds the limit of %d blocks", last-first+1, maxModifiedAccountsRange)
+	}
+
+	config := &rpctypes.TraceConfig{
+		TraceConfig:  evmtypes.TraceConfig{Tracer: "prestateTracer"},
+		TracerConfig: json.RawMessage(`{"diffMode":true}`),
+	}
+	modified := make(map[common.Address]struct{})
+	for height := first; height <= last; height++ {
+		blk, err := b.CometBlockByNumber(ctx, rpctypes.BlockNumber(height)) //#nosec G115 -- int overflow is not a concern here
+		if err != nil {
+			return nil, err
+		}
+		if blk == nil {
+			return nil, fmt.Errorf("block %d not found", height)
+		}
+		results, err := b.TraceBlock(ctx, rpctypes.BlockNumber(height), config, blk) //#nosec G115 -- int overflow is not a concern here
+		if err != nil {
+			return nil, err
+		}
+		for _, res := range results {
+			if res == nil {
+				continue
+			}
+			if res.Error != "" {
+				return nil, fmt.Errorf("failed to trace block %d: %s", height, res.Error)
+			}
+			if err := collectModifiedAccounts(res.Result, modified); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	result = make([]common.Address, 0, len(modified))
+	for address := range modified {
+		result = append(result, address)
+	}
+	sort.Slice(result, func(i, j int) bool { return bytes.Compare(result[i].Bytes(), result[j].Bytes()) < 0 })
+	return result, nil
+}
+
+// collectModifiedAccounts adds the accounts of a prestateTracer diff to the set.
+func collectModifiedAccounts(diff any, modified map[common.Address]struct{}) error {
+	bz, err := json.Marshal(diff)
+	if err != nil {
+		return err
+	}
+	var accounts struct {
+		Pre  map[common.Address]json.RawMessage `json:"pre"`
+		Post map[common.Address]json.RawMessage `json:"post"`
+	}
+	if err := json.Unmarshal(bz, &accounts); err != nil {
+		return err
+	}
+	for address := range accounts.Pre {
+		modified[address] = struct{}{}
+	}
+	for address := range accounts.Post {
+		modified[address] = struct{}{}
+	}
+	return nil
+}
@@ -28,6 +28,10 @@ func (b *Backend) TraceTransaction(ctx context.Context, hash common.Hash, config
 	ctx, span := tracer.Start(ctx, "TraceTransaction", trace.WithAttributes(attribute.String("hash", hash.Hex())))
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 
+	if data, ok := b.Cache.Trace(hash, config); ok {
+		return decodeTraceResult(data)
+	}
+
 	// Get transaction by hash
 	transaction, err := b.GetTxByEthHash(ctx, hash)
 	if err != nil {
@@ -132,14 +136,22 @@ func (b *Backend) TraceTransaction(ctx context.Context, hash common.Hash, config
 		return nil, err
 	}
 
+	decodedResult, err := decodeTraceResult(traceResult.Data)
+	if err != nil {
+		return nil, err
+	}
+	b.Cache.AddTrace(hash, config, traceResult.Data)
+	return decodedResult, nil
+}
+
+// decodeTraceResult decodes the result of a transaction trace
+func decodeTraceResult(data []byte) (interface{}, error) {
 	// Response format is unknown due to custom tracer config param
 	// More information can be found here https://geth.ethereum.org/docs/dapp/tracing-filtered
 	var decodedResult interface{}
-	err = json.Unmarshal(traceResult.Data, &decodedResult)
-	if err != nil {
+	if err := json.Unmarshal(data, &decodedResult); err != nil {
 		return nil, err
 	}
-
 	return decodedResult, nil
 }
 
@@ -33,6 +33,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+const (
+	// defaultTxsByAddressPageSize is the default page size of eth_getTransactionsByAddress.
+	defaultTxsByAddressPageSize = 100
+	// maxTxsByAddressPageSize is the max page size of eth_getTransactionsByAddress.
+	maxTxsByAddressPageSize = 1000
+)
+
 // GetTransactionByHash returns the Ethereum format transaction identified by Ethereum transaction hash
 func (b *Backend) GetTransactionByHash(ctx context.Context, txHash common.Hash) (result *rpctypes.RPCTransaction, err error) {
 	ctx, span := tracer.Start(ctx, "GetTransactionByHash", trace.WithAttributes(attribute.String("txHash", txHash.Hex())))
@@ -154,6 +161,23 @@ func (b *Backend) GetTransactionReceipt(ctx context.Context, hash common.Hash) (
 	hexTx := hash.Hex()
 	b.Logger.Debug("eth_getTransactionReceipt", "hash", hexTx)
 
+	if receipt, ok := b.Cache.Receipt(hash); ok {
+		return receipt, nil
+	}
+
+	// the pseudo-transactions of the Cosmos transactions are identified by their
+	// CometBFT hash and are only looked up once committed
+	if b.Cfg.JSONRPC.EnableCosmosTxs {
+		receipt, err := b.getCosmosTransactionReceipt(ctx, hash)
+		if err != nil {
+			return nil, err
+		}
+		if receipt != nil {
+			b.Cache.AddReceipt(hash, receipt)
+			return receipt, nil
+		}
+	}
+
 	// Retry logic for transaction lookup with exponential backoff
 	maxRetries := 10
 	baseDelay := 50 * time.Millisecond
@@ -190,6 +214,13 @@ func (b *Backend) GetTransactionReceipt(ctx context.Context, hash common.Hash) (
 		return nil, nil
 	}
 
+	return b.ReceiptFromTxResult(ctx, hash, res)
+}
+
+// ReceiptFromTxResult returns the receipt of the indexed transaction identified
+// by hash. Unlike GetTransactionReceipt, it doesn't wait for the transaction to
+// be indexed.
+func (b *Backend) ReceiptFromTxResult(ctx context.Context, hash common.Hash, res *servertypes.TxResult) (result map[string]interface{}, err error) {
 	resBlock, err := b.CometBlockByNumber(ctx, rpctypes.BlockNumber(res.Height))
 	if err != nil {
 		b.Logger.Debug("block not found", "height", res.Height, "error", err.Error())
@@ -226,7 +257,12 @@ func (b *Backend) GetTransactionReceipt(ctx context.Context, hash common.Hash) (
 		return nil, fmt.Errorf("failed to get sender: %w", err)
 	}
 
-	return rpctypes.RPCMarshalReceipt(receipts[0], ethTx, from)
+	result, err = rpctypes.RPCMarshalReceipt(receipts[0], ethTx, from)
+	if err != nil {
+		return nil, err
+	}
+	b.Cache.AddReceipt(hash, result)
+	return result, nil
 }
 
 // GetTransactionLogs returns the transaction logs identified by hash.
@@ -340,6 +376,111 @@ func (b *Backend) GetTxByEthHash(ctx context.Context, hash common.Hash) (result
 	return txResult, nil
 }
 
+// AddressIndexer returns the custom indexer if its address indexes are
+// enabled, nil otherwise.
+func (b *Backend) AddressIndexer() servertypes.EVMTxIndexer {
+	if b.Indexer == nil || !b.Indexer.AddressIndexEnabled() {
+		return nil
+	}
+	return b.Indexer
+}
+
+// GetTransactionsByAddress returns a page of the txs in which the address
+// appears with one of the given roles, using the address indexes.
+func (b *Backend) GetTransactionsByAddress(ctx context.Context, address common.Address, args rpctypes.TransactionsByAddressArgs) (result *rpctypes.TransactionsByAddressResult, err error) {
+	ctx, span := tracer.Start(ctx, "GetTransactionsByAddress", trace.WithAttributes(attribute.String("address", address.Hex()), attribute.Bool("reverse", args.Reverse)))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	idxer := b.AddressIndexer()
+	if idxer == nil {
+		return nil, errors.New("address indexes are disabled, enable json-rpc.enable-indexer and json-rpc.enable-address-index")
+	}
+
+	roles, err := parseAddressRoles(args.Roles)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := uint64(defaultTxsByAddressPageSize)
+	if args.PageSize != nil {
+		pageSize = uint64(*args.PageSize)
+	}
+	if pageSize == 0 || pageSize > maxTxsByAddressPageSize {
+		return nil, fmt.Errorf("page size must be between 1 and %d", maxTxsByAddressPageSize)
+	}
+
+	start := servertypes.TxPosition{Height: 1}
+	if args.Reverse {
+		start = servertypes.TxPosition{Height: math.MaxInt64, EthTxIndex: math.MaxInt32}
+	}
+	if args.Cursor != nil {
+		if uint64(args.Cursor.BlockNumber) > math.MaxInt64 || uint64(args.Cursor.TransactionIndex) > math.MaxInt32 {
+			return nil, errors.New("cursor out of range")
+		}
+		start = servertypes.TxPosition{
+			Height:     int64(args.Cursor.BlockNumber),      //#nosec G115 -- checked for int overflow already
+			EthTxIndex: int32(args.Cursor.TransactionIndex), //#nosec G115 -- checked for int overflow already
+		}
+	}
+
+	res, next, err := idxer.GetByAddress(address, roles, start, args.Reverse, int(pageSize)) //#nosec G115 -- bounded by max page size
+	if err != nil {
+		return nil, err
+	}
+
+	result = &rpctypes.TransactionsByAddressResult{Transactions: make([]*rpctypes.RPCTransaction, 0, len(res))}
+	for _, r := range res {
+		tx, err := b.GetTransactionByHash(ctx, r.TxHash)
+		if err != nil {
+			return nil, err
+		}
+		if tx == nil {
+			return nil, fmt.Errorf("indexed tx %s not found", r.TxHash.Hex())
+		}
+		result.Transactions = append(result.Transactions, tx)
+	}
+	if next != nil {
+		result.NextCursor = &rpctypes.TxCursor{
+			BlockNumber:      hexutil.Uint64(next.Height),     //#nosec G115 -- heights are positive
+			TransactionIndex: hexutil.Uint64(next.EthTxIndex), //#nosec G115 -- indexes are positive
+		}
+	}
+	return result, nil
+}
+
+// parseAddressRoles converts the roles of eth_getTransactionsByAddress to the
+// indexer roles, all roles if empty.
+func parseAddressRoles(roles []string) (servertypes.AddressRole, error) {
+	if len(roles) == 0 {
+		return servertypes.AddressRoleAll, nil
+	}
+	var res servertypes.AddressRole
+	for _, role := range roles {
+		switch role {
+		case rpctypes.AddressRoleSender:
+			res |= servertypes.AddressRoleSender
+		case rpctypes.AddressRoleRecipient:
+			res |= servertypes.AddressRoleRecipient
+		case rpctypes.AddressRoleContractCreated:
+			res |= servertypes.AddressRoleContractCreated
+		case rpctypes.AddressRoleLogEmitter:
+			res |= servertypes.AddressRoleLogEmitter
+		default:
+			return 0, fmt.Errorf("invalid address role %q", role)
+		}
+	}
+	return res, nil
+}
+
+// LogIndexer returns the custom indexer if its log index is enabled, nil
+// otherwise.
+func (b *Backend) LogIndexer() servertypes.EVMTxIndexer {
+	if b.Indexer == nil || !b.Indexer.LogIndexEnabled() {
+		return nil
+	}
+	return b.Indexer
+}
+
 // GetTxByTxIndex uses `/tx_query` to find transaction by tx index of valid ethereum txs
 func (b *Backend) GetTxByTxIndex(ctx context.Context, height int64, index uint) (result *servertypes.TxResult, err error) {
 	//nolint:gosec // unlikely
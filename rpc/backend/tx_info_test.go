@@ -88,7 +88,7 @@ func setupMockBackend(t *testing.T) *Backend {
 	allowUnprotectedTxs := false
 	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), ctx.Logger, clientCtx)
 
-	backend, err := NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, idxer, nil)
+	backend, err := NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, idxer, nil, nil)
 	require.NoError(t, err)
 	backend.Cfg.JSONRPC.GasCap = 25000000
 	backend.Cfg.JSONRPC.EVMTimeout = 0
@@ -466,6 +466,34 @@ func (m *MockIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*ser
 	return nil, nil
 }
 
+func (m *MockIndexer) AddressIndexEnabled() bool {
+	return false
+}
+
+func (m *MockIndexer) GetByAddress(address common.Address, roles servertypes.AddressRole, start servertypes.TxPosition, reverse bool, limit int) ([]*servertypes.AddressTxResult, *servertypes.TxPosition, error) {
+	return nil, nil, nil
+}
+
+func (m *MockIndexer) GetTxHashBySenderAndNonce(sender common.Address, nonce uint64) (*common.Hash, error) {
+	return nil, nil
+}
+
+func (m *MockIndexer) LogIndexEnabled() bool {
+	return false
+}
+
+func (m *MockIndexer) LogIndexedRange() (int64, int64, error) {
+	return -1, -1, nil
+}
+
+func (m *MockIndexer) LogIndexedRangeAt(_ int64) (int64, int64, error) {
+	return -1, -1, nil
+}
+
+func (m *MockIndexer) GetLogBlocks(addresses []common.Address, topics [][]common.Hash, from, to int64) ([]int64, error) {
+	return nil, nil
+}
+
 // Note: A3 (EthTxIndex=-1 in GetTransactionByHash) is already guarded at tx_info.go:82
 // and covered by TestReceiptsFromCometBlock_SentinelEthTxIndex as a regression test.
 
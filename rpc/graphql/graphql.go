@@ -0,0 +1,1477 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package graphql serves the EIP-1767 GraphQL schema of go-ethereum on top of
+// the JSON-RPC backend.
+//
+// The resolvers are adapted from the go-ethereum graphql package. The blocks,
+// receipts and logs are converted from the CometBFT blocks by the JSON-RPC
+// backend, and the block hashes are the CometBFT ones, as in the JSON-RPC API.
+// The chain has no ommers and no blobs, so these fields are always empty.
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethfilters "github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+)
+
+var (
+	errBlockNotFound     = errors.New("block not found")
+	errInvalidBlockRange = errors.New("invalid from and to block combination: from > to")
+)
+
+// Backend is the backend of the GraphQL resolvers
+type Backend interface {
+	backend.EVMBackend
+	filters.Backend
+
+	ReceiptsFromCometBlock(
+		ctx context.Context,
+		resBlock *cmtrpctypes.ResultBlock,
+		blockRes *cmtrpctypes.ResultBlockResults,
+		msgs []*evmtypes.MsgEthereumTx,
+	) ([]*ethtypes.Receipt, error)
+}
+
+type Long int64
+
+// ImplementsGraphQLType returns true if Long implements the provided GraphQL type.
+func (b Long) ImplementsGraphQLType(name string) bool { return name == "Long" }
+
+// UnmarshalGraphQL unmarshals the provided GraphQL query data.
+func (b *Long) UnmarshalGraphQL(input interface{}) error {
+	var err error
+	switch input := input.(type) {
+	case string:
+		// apply leniency and support hex representations of longs.
+		if strings.HasPrefix(input, "0x") {
+			value, err := hexutil.DecodeUint64(input)
+			*b = Long(value) // #nosec G115 -- the block numbers fit in an int64
+			return err
+		}
+		value, err := strconv.ParseInt(input, 10, 64)
+		*b = Long(value)
+		return err
+	case int32:
+		*b = Long(input)
+	case int64:
+		*b = Long(input)
+	case float64:
+		*b = Long(input)
+	default:
+		err = fmt.Errorf("unexpected type %T for Long", input)
+	}
+	return err
+}
+
+// BlockNumberArgs are the arguments of the fields taking an optional block
+// number.
+type BlockNumberArgs struct {
+	// TODO: Ideally we could use input unions to allow the query to specify the
+	// block parameter by hash, block number, or tag but input unions aren't part of the
+	// standard GraphQL schema SDL yet, see: https://github.com/graphql/graphql-spec/issues/488
+	Block *Long
+}
+
+// NumberOr returns the provided block number argument, or the "current" block number or hash if none
+// was provided.
+func (a BlockNumberArgs) NumberOr(current rpctypes.BlockNumberOrHash) rpctypes.BlockNumberOrHash {
+	if a.Block != nil {
+		return blockNumberOrHashWithNumber(rpctypes.BlockNumber(*a.Block))
+	}
+	return current
+}
+
+// NumberOrLatest returns the provided block number argument, or the "latest" block number if none
+// was provided.
+func (a BlockNumberArgs) NumberOrLatest() rpctypes.BlockNumberOrHash {
+	return a.NumberOr(blockNumberOrHashWithNumber(rpctypes.EthLatestBlockNumber))
+}
+
+func blockNumberOrHashWithNumber(number rpctypes.BlockNumber) rpctypes.BlockNumberOrHash {
+	return rpctypes.BlockNumberOrHash{BlockNumber: &number}
+}
+
+func blockNumberOrHashWithHash(hash common.Hash) rpctypes.BlockNumberOrHash {
+	return rpctypes.BlockNumberOrHash{BlockHash: &hash}
+}
+
+// Account represents an Ethereum account at a particular block.
+type Account struct {
+	r             *Resolver
+	address       common.Address
+	blockNrOrHash rpctypes.BlockNumberOrHash
+}
+
+func (a *Account) Address(ctx context.Context) (common.Address, error) {
+	return a.address, nil
+}
+
+func (a *Account) Balance(ctx context.Context) (hexutil.Big, error) {
+	balance, err := a.r.backend.GetBalance(ctx, a.address, a.blockNrOrHash)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return *balance, nil
+}
+
+func (a *Account) TransactionCount(ctx context.Context) (hexutil.Uint64, error) {
+	blockNum, err := a.r.backend.BlockNumberFromComet(ctx, a.blockNrOrHash)
+	if err != nil {
+		return 0, err
+	}
+	nonce, err := a.r.backend.GetTransactionCount(ctx, a.address, blockNum)
+	if err != nil {
+		return 0, err
+	}
+	return *nonce, nil
+}
+
+func (a *Account) Code(ctx context.Context) (hexutil.Bytes, error) {
+	return a.r.backend.GetCode(ctx, a.address, a.blockNrOrHash)
+}
+
+func (a *Account) Storage(ctx context.Context, args struct{ Slot common.Hash }) (common.Hash, error) {
+	value, err := a.r.backend.GetStorageAt(ctx, a.address, args.Slot.Hex(), a.blockNrOrHash)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(value), nil
+}
+
+// Log represents an individual log message. All arguments are mandatory.
+type Log struct {
+	r           *Resolver
+	transaction *Transaction
+	log         *ethtypes.Log
+}
+
+func (l *Log) Transaction(ctx context.Context) *Transaction {
+	return l.transaction
+}
+
+func (l *Log) Account(ctx context.Context, args BlockNumberArgs) *Account {
+	return &Account{
+		r:             l.r,
+		address:       l.log.Address,
+		blockNrOrHash: args.NumberOrLatest(),
+	}
+}
+
+func (l *Log) Index(ctx context.Context) hexutil.Uint64 {
+	return hexutil.Uint64(l.log.Index)
+}
+
+func (l *Log) Topics(ctx context.Context) []common.Hash {
+	return l.log.Topics
+}
+
+func (l *Log) Data(ctx context.Context) hexutil.Bytes {
+	return l.log.Data
+}
+
+// AccessTuple represents EIP-2930
+type AccessTuple struct {
+	address     common.Address
+	storageKeys []common.Hash
+}
+
+func (at *AccessTuple) Address(ctx context.Context) common.Address {
+	return at.address
+}
+
+func (at *AccessTuple) StorageKeys(ctx context.Context) []common.Hash {
+	return at.storageKeys
+}
+
+// Withdrawal represents a withdrawal of value from the beacon chain
+// by a validator. For details see EIP-4895.
+type Withdrawal struct {
+	index     uint64
+	validator uint64
+	address   common.Address
+	amount    uint64
+}
+
+func (w *Withdrawal) Index(ctx context.Context) hexutil.Uint64 {
+	return hexutil.Uint64(w.index)
+}
+
+func (w *Withdrawal) Validator(ctx context.Context) hexutil.Uint64 {
+	return hexutil.Uint64(w.validator)
+}
+
+func (w *Withdrawal) Address(ctx context.Context) common.Address {
+	return w.address
+}
+
+func (w *Withdrawal) Amount(ctx context.Context) hexutil.Uint64 {
+	return hexutil.Uint64(w.amount)
+}
+
+// Transaction represents an Ethereum transaction.
+// backend and hash are mandatory; all others will be fetched when required.
+type Transaction struct {
+	r    *Resolver
+	hash common.Hash // Must be present after initialization
+	mu   sync.Mutex
+	// mu protects following resources
+	msg   *evmtypes.MsgEthereumTx
+	block *Block
+	index uint64
+}
+
+// resolve returns the transaction message, fetching it if needed. It also
+// returns the block the tx belongs to, unless it is a pending tx. The message
+// is nil if the transaction doesn't exist.
+func (t *Transaction) resolve(ctx context.Context) (*evmtypes.MsgEthereumTx, *Block, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.msg != nil {
+		return t.msg, t.block, nil
+	}
+	// Try to return an already committed transaction
+	if res, err := t.r.backend.GetTxByEthHash(ctx, t.hash); err == nil {
+		block := t.r.newBlock(blockNumberOrHashWithNumber(rpctypes.BlockNumber(res.Height)))
+		msgs, err := block.resolveMsgs(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		for i, msg := range msgs {
+			if msg.Hash() == t.hash {
+				t.msg, t.block, t.index = msg, block, uint64(i)
+				return t.msg, t.block, nil
+			}
+		}
+	}
+	// No committed transaction, try to retrieve it from the mempool
+	msgs, err := t.r.pendingMsgs(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, msg := range msgs {
+		if msg.Hash() == t.hash {
+			t.msg = msg
+			break
+		}
+	}
+	return t.msg, nil, nil
+}
+
+// resolveTx returns the Ethereum transaction, nil if it doesn't exist.
+func (t *Transaction) resolveTx(ctx context.Context) (*ethtypes.Transaction, *Block, error) {
+	msg, block, err := t.resolve(ctx)
+	if err != nil || msg == nil {
+		return nil, nil, err
+	}
+	return msg.AsTransaction(), block, nil
+}
+
+func (t *Transaction) Hash(ctx context.Context) common.Hash {
+	return t.hash
+}
+
+func (t *Transaction) InputData(ctx context.Context) (hexutil.Bytes, error) {
+	tx, _, err := t.resolveTx(ctx)
+	if err != nil || tx == nil {
+		return hexutil.Bytes{}, err
+	}
+	return tx.Data(), nil
+}
+
+func (t *Transaction) Gas(ctx context.Context) (hexutil.Uint64, error) {
+	tx, _, err := t.resolveTx(ctx)
+	if err != nil || tx == nil {
+		return 0, err
+	}
+	return hexutil.Uint64(tx.Gas()), nil
+}
+
+func (t *Transaction) GasPrice(ctx context.Context) (hexutil.Big, error) {
+	tx, block, err := t.resolveTx(ctx)
+	if err != nil || tx == nil {
+		return hexutil.Big{}, err
+	}
+	switch tx.Type() {
+	case ethtypes.DynamicFeeTxType:
+		if block != nil {
+			if baseFee, _ := block.BaseFeePerGas(ctx); baseFee != nil {
+				return hexutil.Big(*rpctypes.EffectiveGasPrice(tx, baseFee.ToInt())), nil
+			}
+		}
+		return hexutil.Big(*tx.GasPrice()), nil
+	default:
+		return hexutil.Big(*tx.GasPrice()), nil
+	}
+}
+
+func (t *Transaction) EffectiveGasPrice(ctx context.Context) (*hexutil.Big, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(receipt.EffectiveGasPrice), nil
+}
+
+func (t *Transaction) MaxFeePerGas(ctx context.Context) (*hexutil.Big, error) {
+	tx, _, err := t.resolveTx(ctx)
+	if err != nil || tx == nil {
+		return nil, err
+	}
+	switch tx.Type() {
+	case ethtypes.DynamicFeeTxType, ethtypes.BlobTxType, ethtypes.SetCodeTxType:
+		return (*hexutil.Big)(tx.GasFeeCap()), nil
+	default:
+		return nil, nil
+	}
+}
+
+func (t *Transaction) MaxPriorityFeePerGas(ctx context.Context) (*hexutil.Big, error) {
+	tx, _, err := t.resolveTx(ctx)
+	if err != nil || tx == nil {
+		return nil, err
+	}
+	switch tx.Type() {
+	case ethtypes.DynamicFeeTxType, ethtypes.BlobTxType, ethtypes.SetCodeTxType:
+		return (*hexutil.Big)(tx.GasTipCap()), nil
+	default:
+		return nil, nil
+	}
+}
+
+func (t *Transaction) MaxFeePerBlobGas(ctx context.Context) (*hexutil.Big, error) {
+	tx, _, err := t.resolveTx(ctx)
+	if err != nil || tx == nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(tx.BlobGasFeeCap()), nil
+}
+
+func (t *Transaction) BlobVersionedHashes(ctx context.Context) (*[]common.Hash, error) {
+	tx, _, err := t.resolveTx(ctx)
+	if err != nil || tx == nil || tx.Type() != ethtypes.BlobTxType {
+		return nil, err
+	}
+	blobHashes := tx.BlobHashes()
+	return &blobHashes, nil
+}
+
+func (t *Transaction) EffectiveTip(ctx context.Context) (*hexutil.Big, error) {
+	tx, block, err := t.resolveTx(ctx)
+	// Pending tx
+	if err != nil || tx == nil || block == nil {
+		return nil, err
+	}
+	header, err := block.resolveHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if header.BaseFee == nil {
+		return (*hexutil.Big)(tx.GasPrice()), nil
+	}
+
+	tip, err := tx.EffectiveGasTip(header.BaseFee)
+	if err != nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(tip), nil
+}
+
+func (t *Transaction) Value(ctx context.Context) (hexutil.Big, error) {
+	tx, _, err := t.resolveTx(ctx)
+	if err != nil || tx == nil {
+		return hexutil.Big{}, err
+	}
+	if tx.Value() == nil {
+		return hexutil.Big{}, fmt.Errorf("invalid transaction value %x", t.hash)
+	}
+	return hexutil.Big(*tx.Value()), nil
+}
+
+func (t *Transaction) Nonce(ctx context.Context) (hexutil.Uint64, error) {
+	tx, _, err := t.resolveTx(ctx)
+	if err != nil || tx == nil {
+		return 0, err
+	}
+	return hexutil.Uint64(tx.Nonce()), nil
+}
+
+func (t *Transaction) To(ctx context.Context, args BlockNumberArgs) (*Account, error) {
+	tx, _, err := t.resolveTx(ctx)
+	if err != nil || tx == nil || tx.To() == nil {
+		return nil, err
+	}
+	return &Account{
+		r:             t.r,
+		address:       *tx.To(),
+		blockNrOrHash: args.NumberOrLatest(),
+	}, nil
+}
+
+func (t *Transaction) From(ctx context.Context, args BlockNumberArgs) (*Account, error) {
+	msg, _, err := t.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var from common.Address
+	if msg != nil {
+		from = msg.GetSender()
+	}
+	return &Account{
+		r:             t.r,
+		address:       from,
+		blockNrOrHash: args.NumberOrLatest(),
+	}, nil
+}
+
+func (t *Transaction) Block(ctx context.Context) (*Block, error) {
+	_, block, err := t.resolve(ctx)
+	return block, err
+}
+
+func (t *Transaction) Index(ctx context.Context) (*hexutil.Uint64, error) {
+	_, block, err := t.resolve(ctx)
+	// Pending tx
+	if err != nil || block == nil {
+		return nil, err
+	}
+	index := hexutil.Uint64(t.index)
+	return &index, nil
+}
+
+// getReceipt returns the receipt associated with this transaction, if any.
+func (t *Transaction) getReceipt(ctx context.Context) (*ethtypes.Receipt, error) {
+	_, block, err := t.resolve(ctx)
+	// Pending tx
+	if err != nil || block == nil {
+		return nil, err
+	}
+	receipts, err := block.resolveReceipts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return receipts[t.index], nil
+}
+
+func (t *Transaction) Status(ctx context.Context) (*hexutil.Uint64, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	ret := hexutil.Uint64(receipt.Status)
+	return &ret, nil
+}
+
+func (t *Transaction) GasUsed(ctx context.Context) (*hexutil.Uint64, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	ret := hexutil.Uint64(receipt.GasUsed)
+	return &ret, nil
+}
+
+func (t *Transaction) CumulativeGasUsed(ctx context.Context) (*hexutil.Uint64, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	ret := hexutil.Uint64(receipt.CumulativeGasUsed)
+	return &ret, nil
+}
+
+func (t *Transaction) BlobGasUsed(ctx context.Context) (*hexutil.Uint64, error) {
+	tx, _, err := t.resolveTx(ctx)
+	if err != nil || tx == nil || tx.Type() != ethtypes.BlobTxType {
+		return nil, err
+	}
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	ret := hexutil.Uint64(receipt.BlobGasUsed)
+	return &ret, nil
+}
+
+func (t *Transaction) BlobGasPrice(ctx context.Context) (*hexutil.Big, error) {
+	tx, _, err := t.resolveTx(ctx)
+	if err != nil || tx == nil || tx.Type() != ethtypes.BlobTxType {
+		return nil, err
+	}
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(receipt.BlobGasPrice), nil
+}
+
+func (t *Transaction) CreatedContract(ctx context.Context, args BlockNumberArgs) (*Account, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil || receipt.ContractAddress == (common.Address{}) {
+		return nil, err
+	}
+	return &Account{
+		r:             t.r,
+		address:       receipt.ContractAddress,
+		blockNrOrHash: args.NumberOrLatest(),
+	}, nil
+}
+
+func (t *Transaction) Logs(ctx context.Context) (*[]*Log, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	ret := make([]*Log, 0, len(receipt.Logs))
+	for _, log := range receipt.Logs {
+		ret = append(ret, &Log{
+			r:           t.r,
+			transaction: t,
+			log:         log,
+		})
+	}
+	return &ret, nil
+}
+
+func (t *Transaction) Type(ctx context.Context) (*hexutil.Uint64, error) {
+	tx, _, err := t.resolveTx(ctx)
+	if err != nil || tx == nil {
+		return nil, err
+	}
+	txType := hexutil.Uint64(tx.Type())
+	return &txType, nil
+}
+
+func (t *Transaction) AccessList(ctx context.Context) (*[]*AccessTuple, error) {
+	tx, _, err := t.resolveTx(ctx)
+	if err != nil || tx == nil {
+		return nil, err
+	}
+	accessList := tx.AccessList()
+	ret := make([]*AccessTuple, 0, len(accessList))
+	for _, al := range accessList {
+		ret = append(ret, &AccessTuple{
+			address:     al.Address,
+			storageKeys: al.StorageKeys,
+		})
+	}
+	return &ret, nil
+}
+
+func (t *Transaction) R(ctx context.Context) (hexutil.Big, error) {
+	tx, _, err := t.resolveTx(ctx)
+	if err != nil || tx == nil {
+		return hexutil.Big{}, err
+	}
+	_, r, _ := tx.RawSignatureValues()
+	return hexutil.Big(*r), nil
+}
+
+func (t *Transaction) S(ctx context.Context) (hexutil.Big, error) {
+	tx, _, err := t.resolveTx(ctx)
+	if err != nil || tx == nil {
+		return hexutil.Big{}, err
+	}
+	_, _, s := tx.RawSignatureValues()
+	return hexutil.Big(*s), nil
+}
+
+func (t *Transaction) V(ctx context.Context) (hexutil.Big, error) {
+	tx, _, err := t.resolveTx(ctx)
+	if err != nil || tx == nil {
+		return hexutil.Big{}, err
+	}
+	v, _, _ := tx.RawSignatureValues()
+	return hexutil.Big(*v), nil
+}
+
+func (t *Transaction) YParity(ctx context.Context) (*hexutil.Big, error) {
+	tx, _, err := t.resolveTx(ctx)
+	if err != nil || tx == nil || tx.Type() == ethtypes.LegacyTxType {
+		return nil, err
+	}
+	v, _, _ := tx.RawSignatureValues()
+	ret := hexutil.Big(*v)
+	return &ret, nil
+}
+
+func (t *Transaction) Raw(ctx context.Context) (hexutil.Bytes, error) {
+	tx, _, err := t.resolveTx(ctx)
+	if err != nil || tx == nil {
+		return hexutil.Bytes{}, err
+	}
+	return tx.MarshalBinary()
+}
+
+func (t *Transaction) RawReceipt(ctx context.Context) (hexutil.Bytes, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return hexutil.Bytes{}, err
+	}
+	return receipt.MarshalBinary()
+}
+
+// Block represents an Ethereum block. The CometBFT block and its results are
+// fetched when required, and converted by the JSON-RPC backend.
+type Block struct {
+	r            *Resolver
+	numberOrHash rpctypes.BlockNumberOrHash // Field resolvers assume numberOrHash is always present
+	mu           sync.Mutex
+	// mu protects following resources
+	resBlock *cmtrpctypes.ResultBlock
+	blockRes *cmtrpctypes.ResultBlockResults
+	block    *ethtypes.Block
+	msgs     []*evmtypes.MsgEthereumTx
+	receipts []*ethtypes.Receipt
+}
+
+// resolveCometLocked fetches the CometBFT block and its results if needed, it
+// returns errBlockNotFound if the block doesn't exist. b.mu must be held.
+func (b *Block) resolveCometLocked(ctx context.Context) error {
+	if b.resBlock != nil {
+		return nil
+	}
+	var (
+		resBlock *cmtrpctypes.ResultBlock
+		err      error
+	)
+	if b.numberOrHash.BlockHash != nil {
+		resBlock, err = b.r.backend.CometBlockByHash(ctx, *b.numberOrHash.BlockHash)
+	} else {
+		resBlock, err = b.r.backend.CometBlockByNumber(ctx, *b.numberOrHash.BlockNumber)
+	}
+	if err != nil {
+		return err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return errBlockNotFound
+	}
+	blockRes, err := b.r.backend.CometBlockResultByNumber(ctx, &resBlock.Block.Height)
+	if err != nil {
+		return err
+	}
+	b.resBlock, b.blockRes = resBlock, blockRes
+	return nil
+}
+
+// resolveComet returns the CometBFT block, fetching it if needed.
+func (b *Block) resolveComet(ctx context.Context) (*cmtrpctypes.ResultBlock, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err := b.resolveCometLocked(ctx); err != nil {
+		return nil, err
+	}
+	return b.resBlock, nil
+}
+
+// resolve returns the Ethereum block, converting it from the CometBFT block if
+// needed.
+func (b *Block) resolve(ctx context.Context) (*ethtypes.Block, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.block != nil {
+		return b.block, nil
+	}
+	if err := b.resolveCometLocked(ctx); err != nil {
+		return nil, err
+	}
+	block, err := b.r.backend.EthBlockFromCometBlock(ctx, b.resBlock, b.blockRes)
+	if err != nil {
+		return nil, err
+	}
+	b.block = block
+	return b.block, nil
+}
+
+// resolveHeader returns the header of the Ethereum block.
+func (b *Block) resolveHeader(ctx context.Context) (*ethtypes.Header, error) {
+	block, err := b.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return block.Header(), nil
+}
+
+// resolveMsgsLocked returns the Ethereum transaction messages of the block,
+// fetching them if needed. b.mu must be held.
+func (b *Block) resolveMsgsLocked(ctx context.Context) ([]*evmtypes.MsgEthereumTx, error) {
+	if b.msgs != nil {
+		return b.msgs, nil
+	}
+	if err := b.resolveCometLocked(ctx); err != nil {
+		return nil, err
+	}
+	b.msgs = b.r.backend.EthMsgsFromCometBlock(ctx, b.resBlock, b.blockRes)
+	return b.msgs, nil
+}
+
+// resolveMsgs returns the Ethereum transaction messages of the block.
+func (b *Block) resolveMsgs(ctx context.Context) ([]*evmtypes.MsgEthereumTx, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.resolveMsgsLocked(ctx)
+}
+
+// resolveReceipts returns the list of receipts for this block, fetching them
+// if needed.
+func (b *Block) resolveReceipts(ctx context.Context) ([]*ethtypes.Receipt, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.receipts != nil {
+		return b.receipts, nil
+	}
+	msgs, err := b.resolveMsgsLocked(ctx)
+	if err != nil {
+		return nil, err
+	}
+	receipts, err := b.r.backend.ReceiptsFromCometBlock(ctx, b.resBlock, b.blockRes, msgs)
+	if err != nil {
+		return nil, err
+	}
+	b.receipts = receipts
+	return receipts, nil
+}
+
+// blockNumberOrHash returns the number of the block, the accounts of the block
+// are queried at this height.
+func (b *Block) blockNumberOrHash(ctx context.Context) (rpctypes.BlockNumberOrHash, error) {
+	resBlock, err := b.resolveComet(ctx)
+	if err != nil {
+		return rpctypes.BlockNumberOrHash{}, err
+	}
+	return blockNumberOrHashWithNumber(rpctypes.BlockNumber(resBlock.Block.Height)), nil
+}
+
+func (b *Block) Number(ctx context.Context) (hexutil.Uint64, error) {
+	resBlock, err := b.resolveComet(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(resBlock.Block.Height), nil //nolint:gosec // G115 // the heights are positive
+}
+
+func (b *Block) Hash(ctx context.Context) (common.Hash, error) {
+	resBlock, err := b.resolveComet(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(resBlock.BlockID.Hash), nil
+}
+
+func (b *Block) GasLimit(ctx context.Context) (hexutil.Uint64, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(header.GasLimit), nil
+}
+
+func (b *Block) GasUsed(ctx context.Context) (hexutil.Uint64, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(header.GasUsed), nil
+}
+
+func (b *Block) BaseFeePerGas(ctx context.Context) (*hexutil.Big, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if header.BaseFee == nil {
+		return nil, nil
+	}
+	return (*hexutil.Big)(header.BaseFee), nil
+}
+
+// NextBaseFeePerGas returns the base fee of the next block, or null if it
+// isn't committed yet: the base fee is set by the fee market module at the
+// beginning of each block.
+func (b *Block) NextBaseFeePerGas(ctx context.Context) (*hexutil.Big, error) {
+	resBlock, err := b.resolveComet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := b.r.backend.BlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	next := resBlock.Block.Height + 1
+	if next > int64(latest) { //nolint:gosec // G115 // the heights are positive
+		return nil, nil
+	}
+	return b.r.newBlock(blockNumberOrHashWithNumber(rpctypes.BlockNumber(next))).BaseFeePerGas(ctx)
+}
+
+func (b *Block) Parent(ctx context.Context) (*Block, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil || header.ParentHash == (common.Hash{}) {
+		return nil, err
+	}
+	return b.r.newBlock(blockNumberOrHashWithHash(header.ParentHash)), nil
+}
+
+func (b *Block) Difficulty(ctx context.Context) (hexutil.Big, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return hexutil.Big(*header.Difficulty), nil
+}
+
+func (b *Block) Timestamp(ctx context.Context) (hexutil.Uint64, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(header.Time), nil
+}
+
+func (b *Block) Nonce(ctx context.Context) (hexutil.Bytes, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return hexutil.Bytes{}, err
+	}
+	return header.Nonce[:], nil
+}
+
+func (b *Block) MixHash(ctx context.Context) (common.Hash, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return header.MixDigest, nil
+}
+
+func (b *Block) TransactionsRoot(ctx context.Context) (common.Hash, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return header.TxHash, nil
+}
+
+func (b *Block) StateRoot(ctx context.Context) (common.Hash, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return header.Root, nil
+}
+
+func (b *Block) ReceiptsRoot(ctx context.Context) (common.Hash, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return header.ReceiptHash, nil
+}
+
+func (b *Block) OmmerHash(ctx context.Context) (common.Hash, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return header.UncleHash, nil
+}
+
+func (b *Block) OmmerCount(ctx context.Context) *hexutil.Uint64 {
+	count := hexutil.Uint64(0)
+	return &count
+}
+
+func (b *Block) Ommers(ctx context.Context) *[]*Block {
+	return &[]*Block{}
+}
+
+func (b *Block) OmmerAt(ctx context.Context, args struct{ Index Long }) *Block {
+	return nil
+}
+
+func (b *Block) ExtraData(ctx context.Context) (hexutil.Bytes, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return hexutil.Bytes{}, err
+	}
+	return header.Extra, nil
+}
+
+func (b *Block) LogsBloom(ctx context.Context) (hexutil.Bytes, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return hexutil.Bytes{}, err
+	}
+	return header.Bloom.Bytes(), nil
+}
+
+func (b *Block) RawHeader(ctx context.Context) (hexutil.Bytes, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return hexutil.Bytes{}, err
+	}
+	return rlp.EncodeToBytes(header)
+}
+
+func (b *Block) Raw(ctx context.Context) (hexutil.Bytes, error) {
+	block, err := b.resolve(ctx)
+	if err != nil {
+		return hexutil.Bytes{}, err
+	}
+	return rlp.EncodeToBytes(block)
+}
+
+func (b *Block) Miner(ctx context.Context, args BlockNumberArgs) (*Account, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Account{
+		r:             b.r,
+		address:       header.Coinbase,
+		blockNrOrHash: args.NumberOrLatest(),
+	}, nil
+}
+
+func (b *Block) TransactionCount(ctx context.Context) (*hexutil.Uint64, error) {
+	msgs, err := b.resolveMsgs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	count := hexutil.Uint64(len(msgs))
+	return &count, nil
+}
+
+func (b *Block) Transactions(ctx context.Context) (*[]*Transaction, error) {
+	msgs, err := b.resolveMsgs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*Transaction, 0, len(msgs))
+	for i, msg := range msgs {
+		ret = append(ret, &Transaction{
+			r:     b.r,
+			hash:  msg.Hash(),
+			msg:   msg,
+			block: b,
+			index: uint64(i),
+		})
+	}
+	return &ret, nil
+}
+
+func (b *Block) TransactionAt(ctx context.Context, args struct{ Index Long }) (*Transaction, error) {
+	msgs, err := b.resolveMsgs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if args.Index < 0 || int(args.Index) >= len(msgs) {
+		return nil, nil
+	}
+	msg := msgs[args.Index]
+	return &Transaction{
+		r:     b.r,
+		hash:  msg.Hash(),
+		msg:   msg,
+		block: b,
+		index: uint64(args.Index),
+	}, nil
+}
+
+func (b *Block) WithdrawalsRoot(ctx context.Context) (*common.Hash, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return header.WithdrawalsHash, nil
+}
+
+func (b *Block) Withdrawals(ctx context.Context) (*[]*Withdrawal, error) {
+	block, err := b.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*Withdrawal, 0, len(block.Withdrawals()))
+	for _, w := range block.Withdrawals() {
+		ret = append(ret, &Withdrawal{
+			index:     w.Index,
+			validator: w.Validator,
+			address:   w.Address,
+			amount:    w.Amount,
+		})
+	}
+	return &ret, nil
+}
+
+func (b *Block) BlobGasUsed(ctx context.Context) (*hexutil.Uint64, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil || header.BlobGasUsed == nil {
+		return nil, err
+	}
+	ret := hexutil.Uint64(*header.BlobGasUsed)
+	return &ret, nil
+}
+
+func (b *Block) ExcessBlobGas(ctx context.Context) (*hexutil.Uint64, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil || header.ExcessBlobGas == nil {
+		return nil, err
+	}
+	ret := hexutil.Uint64(*header.ExcessBlobGas)
+	return &ret, nil
+}
+
+// BlockFilterCriteria encapsulates criteria passed to a `logs` accessor inside
+// a block.
+type BlockFilterCriteria struct {
+	Addresses *[]common.Address // restricts matches to events created by specific contracts
+
+	// The Topic list restricts matches to particular event topics. Each event has a list
+	// of topics. Topics matches a prefix of that list. An empty element slice matches any
+	// topic. Non-empty elements represent an alternative that matches any of the
+	// contained topics.
+	//
+	// Examples:
+	// {} or nil          matches any topic list
+	// {{A}}              matches topic A in first position
+	// {{}, {B}}          matches any topic in first position, B in second position
+	// {{A}, {B}}         matches topic A in first position, B in second position
+	// {{A, B}}, {C, D}}  matches topic (A OR B) in first position, (C OR D) in second position
+	Topics *[][]common.Hash
+}
+
+// runFilter runs a filter and returns the matching logs with the same limits
+// as eth_getLogs.
+func runFilter(ctx context.Context, r *Resolver, filter *filters.Filter) ([]*Log, error) {
+	logs, err := filter.Logs(ctx, int(r.backend.RPCLogsCap()), int64(r.backend.RPCBlockRangeCap()))
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*Log, 0, len(logs))
+	for _, log := range logs {
+		ret = append(ret, &Log{
+			r:           r,
+			transaction: &Transaction{r: r, hash: log.TxHash},
+			log:         log,
+		})
+	}
+	return ret, nil
+}
+
+func (b *Block) Logs(ctx context.Context, args struct{ Filter BlockFilterCriteria }) ([]*Log, error) {
+	hash, err := b.Hash(ctx)
+	if err != nil {
+		return nil, err
+	}
+	crit := ethfilters.FilterCriteria{BlockHash: &hash}
+	if args.Filter.Addresses != nil {
+		crit.Addresses = *args.Filter.Addresses
+	}
+	if args.Filter.Topics != nil {
+		crit.Topics = *args.Filter.Topics
+	}
+	return runFilter(ctx, b.r, filters.NewBlockFilter(b.r.logger, b.r.backend, crit))
+}
+
+func (b *Block) Account(ctx context.Context, args struct {
+	Address common.Address
+}) (*Account, error) {
+	blockNrOrHash, err := b.blockNumberOrHash(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Account{
+		r:             b.r,
+		address:       args.Address,
+		blockNrOrHash: blockNrOrHash,
+	}, nil
+}
+
+// CallResult encapsulates the result of an invocation of the `call` accessor.
+type CallResult struct {
+	data    hexutil.Bytes  // The return data from the call
+	gasUsed hexutil.Uint64 // The amount of gas used
+	status  hexutil.Uint64 // The return status of the call - 0 for failure or 1 for success.
+}
+
+func (c *CallResult) Data() hexutil.Bytes {
+	return c.data
+}
+
+func (c *CallResult) GasUsed() hexutil.Uint64 {
+	return c.gasUsed
+}
+
+func (c *CallResult) Status() hexutil.Uint64 {
+	return c.status
+}
+
+// doCall executes the call at the block number
+func (r *Resolver) doCall(ctx context.Context, args evmtypes.TransactionArgs, blockNum rpctypes.BlockNumber) (*CallResult, error) {
+	result, err := r.backend.DoCall(ctx, args, blockNum, nil)
+	if err != nil {
+		return nil, err
+	}
+	status := hexutil.Uint64(1)
+	if result.Failed() {
+		status = 0
+	}
+	return &CallResult{
+		data:    result.Ret,
+		gasUsed: hexutil.Uint64(result.GasUsed),
+		status:  status,
+	}, nil
+}
+
+func (b *Block) Call(ctx context.Context, args struct {
+	Data evmtypes.TransactionArgs
+}) (*CallResult, error) {
+	blockNrOrHash, err := b.blockNumberOrHash(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return b.r.doCall(ctx, args.Data, *blockNrOrHash.BlockNumber)
+}
+
+func (b *Block) EstimateGas(ctx context.Context, args struct {
+	Data evmtypes.TransactionArgs
+}) (hexutil.Uint64, error) {
+	blockNrOrHash, err := b.blockNumberOrHash(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return b.r.backend.EstimateGas(ctx, args.Data, &blockNrOrHash, nil)
+}
+
+type Pending struct {
+	r *Resolver
+}
+
+func (p *Pending) TransactionCount(ctx context.Context) (hexutil.Uint64, error) {
+	msgs, err := p.r.pendingMsgs(ctx)
+	return hexutil.Uint64(len(msgs)), err
+}
+
+func (p *Pending) Transactions(ctx context.Context) (*[]*Transaction, error) {
+	msgs, err := p.r.pendingMsgs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*Transaction, 0, len(msgs))
+	for i, msg := range msgs {
+		ret = append(ret, &Transaction{
+			r:     p.r,
+			hash:  msg.Hash(),
+			msg:   msg,
+			index: uint64(i),
+		})
+	}
+	return &ret, nil
+}
+
+func (p *Pending) Account(ctx context.Context, args struct {
+	Address common.Address
+}) *Account {
+	return &Account{
+		r:             p.r,
+		address:       args.Address,
+		blockNrOrHash: blockNumberOrHashWithNumber(rpctypes.EthPendingBlockNumber),
+	}
+}
+
+func (p *Pending) Call(ctx context.Context, args struct {
+	Data evmtypes.TransactionArgs
+}) (*CallResult, error) {
+	return p.r.doCall(ctx, args.Data, rpctypes.EthPendingBlockNumber)
+}
+
+func (p *Pending) EstimateGas(ctx context.Context, args struct {
+	Data evmtypes.TransactionArgs
+}) (hexutil.Uint64, error) {
+	latestBlockNr := blockNumberOrHashWithNumber(rpctypes.EthLatestBlockNumber)
+	return p.r.backend.EstimateGas(ctx, args.Data, &latestBlockNr, nil)
+}
+
+// Resolver is the top-level object in the GraphQL hierarchy.
+type Resolver struct {
+	backend Backend
+	logger  log.Logger
+}
+
+// newBlock returns the resolver of a block, which may not exist
+func (r *Resolver) newBlock(numberOrHash rpctypes.BlockNumberOrHash) *Block {
+	return &Block{r: r, numberOrHash: numberOrHash}
+}
+
+// pendingMsgs returns the Ethereum transaction messages of the mempool
+func (r *Resolver) pendingMsgs(ctx context.Context) ([]*evmtypes.MsgEthereumTx, error) {
+	txs, err := r.backend.PendingTransactions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var msgs []*evmtypes.MsgEthereumTx
+	for _, tx := range txs {
+		for _, msg := range (*tx).GetMsgs() {
+			if ethMsg, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+				msgs = append(msgs, ethMsg)
+			}
+		}
+	}
+	return msgs, nil
+}
+
+// latestBlockNumber returns the number of the latest committed block
+func (r *Resolver) latestBlockNumber(ctx context.Context) (Long, error) {
+	latest, err := r.backend.BlockNumber(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return Long(latest), nil //nolint:gosec // G115 // the heights are positive
+}
+
+// existingBlock returns the resolver of the block, or nil if the block doesn't
+// exist.
+func (r *Resolver) existingBlock(ctx context.Context, numberOrHash rpctypes.BlockNumberOrHash) (*Block, error) {
+	block := r.newBlock(numberOrHash)
+	// Resolve the CometBFT block, return nil if it doesn't exist.
+	if _, err := block.resolveComet(ctx); err != nil {
+		if errors.Is(err, errBlockNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return block, nil
+}
+
+func (r *Resolver) Block(ctx context.Context, args struct {
+	Number *Long
+	Hash   *common.Hash
+}) (*Block, error) {
+	if args.Number != nil && args.Hash != nil {
+		return nil, errors.New("only one of number or hash must be specified")
+	}
+	var numberOrHash rpctypes.BlockNumberOrHash
+	switch {
+	case args.Number != nil:
+		if *args.Number < 0 {
+			return nil, nil
+		}
+		// CometBFT returns an error for the future heights
+		latest, err := r.latestBlockNumber(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if *args.Number > latest {
+			return nil, nil
+		}
+		numberOrHash = blockNumberOrHashWithNumber(rpctypes.BlockNumber(*args.Number))
+	case args.Hash != nil:
+		numberOrHash = blockNumberOrHashWithHash(*args.Hash)
+	default:
+		numberOrHash = blockNumberOrHashWithNumber(rpctypes.EthLatestBlockNumber)
+	}
+	return r.existingBlock(ctx, numberOrHash)
+}
+
+func (r *Resolver) Blocks(ctx context.Context, args struct {
+	From *Long
+	To   *Long
+}) ([]*Block, error) {
+	if args.From == nil {
+		return nil, errors.New("from block number must be specified")
+	}
+	from := *args.From
+
+	latest, err := r.latestBlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	to := latest
+	if args.To != nil {
+		to = *args.To
+	}
+	if to < from {
+		return nil, errInvalidBlockRange
+	}
+	// Blocks after the latest one don't exist
+	to = min(to, latest)
+	if limit := Long(r.backend.RPCBlockRangeCap()); limit > 0 && to-from >= limit {
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", limit)
+	}
+	var ret []*Block
+	for i := from; i <= to; i++ {
+		block, err := r.existingBlock(ctx, blockNumberOrHashWithNumber(rpctypes.BlockNumber(i)))
+		if err != nil {
+			return nil, err
+		}
+		if block != nil {
+			ret = append(ret, block)
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+func (r *Resolver) Pending(ctx context.Context) *Pending {
+	return &Pending{r}
+}
+
+func (r *Resolver) Transaction(ctx context.Context, args struct{ Hash common.Hash }) (*Transaction, error) {
+	tx := &Transaction{
+		r:    r,
+		hash: args.Hash,
+	}
+	// Resolve the transaction; if it doesn't exist, return nil.
+	msg, _, err := tx.resolve(ctx)
+	if err != nil || msg == nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+func (r *Resolver) SendRawTransaction(ctx context.Context, args struct{ Data hexutil.Bytes }) (common.Hash, error) {
+	return r.backend.SendRawTransaction(ctx, args.Data)
+}
+
+// FilterCriteria encapsulates the arguments to `logs` on the root resolver object.
+type FilterCriteria struct {
+	FromBlock *Long             // beginning of the queried range, nil means genesis block
+	ToBlock   *Long             // end of the range, nil means latest block
+	Addresses *[]common.Address // restricts matches to events created by specific contracts
+
+	// The Topic list restricts matches to particular event topics. Each event has a list
+	// of topics. Topics matches a prefix of that list. An empty element slice matches any
+	// topic. Non-empty elements represent an alternative that matches any of the
+	// contained topics.
+	Topics *[][]common.Hash
+}
+
+func (r *Resolver) Logs(ctx context.Context, args struct{ Filter FilterCriteria }) ([]*Log, error) {
+	// Convert the RPC block numbers into internal representations
+	begin := rpctypes.EthLatestBlockNumber.Int64()
+	if args.Filter.FromBlock != nil {
+		begin = int64(*args.Filter.FromBlock)
+	}
+	end := rpctypes.EthLatestBlockNumber.Int64()
+	if args.Filter.ToBlock != nil {
+		end = int64(*args.Filter.ToBlock)
+	}
+	if begin > 0 && end > 0 && begin > end {
+		return nil, errInvalidBlockRange
+	}
+	var addresses []common.Address
+	if args.Filter.Addresses != nil {
+		addresses = *args.Filter.Addresses
+	}
+	var topics [][]common.Hash
+	if args.Filter.Topics != nil {
+		topics = *args.Filter.Topics
+	}
+	// Construct the range filter
+	filter := filters.NewRangeFilter(r.logger, r.backend, begin, end, addresses, topics)
+	return runFilter(ctx, r, filter)
+}
+
+func (r *Resolver) GasPrice(ctx context.Context) (hexutil.Big, error) {
+	price, err := r.backend.GasPrice(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return *price, nil
+}
+
+func (r *Resolver) MaxPriorityFeePerGas(ctx context.Context) (hexutil.Big, error) {
+	head, err := r.backend.CurrentHeader(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	tipcap, err := r.backend.SuggestGasTipCap(ctx, head.BaseFee)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return (hexutil.Big)(*tipcap), nil
+}
+
+func (r *Resolver) ChainID(ctx context.Context) (hexutil.Big, error) {
+	chainID, err := r.backend.ChainID(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return *chainID, nil
+}
+
+// SyncState represents the synchronisation status returned from the `syncing` accessor.
+type SyncState struct {
+	startingBlock hexutil.Uint64
+	currentBlock  hexutil.Uint64
+}
+
+func (s *SyncState) StartingBlock() hexutil.Uint64 {
+	return s.startingBlock
+}
+
+func (s *SyncState) CurrentBlock() hexutil.Uint64 {
+	return s.currentBlock
+}
+
+// HighestBlock returns the current block, CometBFT doesn't report the highest
+// block of its peers.
+func (s *SyncState) HighestBlock() hexutil.Uint64 {
+	return s.currentBlock
+}
+
+// Syncing returns false in case the node is currently not syncing with the network. It can be up-to-date or has not
+// yet received the latest block headers from its peers. In case it is synchronizing:
+// - startingBlock: block number this node started to synchronize from
+// - currentBlock:  block number this node is currently importing
+// - highestBlock:  block number of the highest block header this node has received from peers
+func (r *Resolver) Syncing(ctx context.Context) (*SyncState, error) {
+	progress, err := r.backend.Syncing(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Return not syncing if the synchronisation already completed
+	status, ok := progress.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	// Otherwise gather the block sync stats
+	state := &SyncState{}
+	state.startingBlock, _ = status["startingBlock"].(hexutil.Uint64)
+	state.currentBlock, _ = status["currentBlock"].(hexutil.Uint64)
+	return state, nil
+}
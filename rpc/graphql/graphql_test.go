@@ -0,0 +1,299 @@
+package graphql_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/require"
+
+	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/evm/rpc/graphql"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	chainID    = big.NewInt(9001)
+	testKey, _ = crypto.GenerateKey()
+	sender     = crypto.PubkeyToAddress(testKey.PublicKey)
+	receiver   = common.HexToAddress("0x1000000000000000000000000000000000000001")
+)
+
+// fakeBackend serves a chain of two blocks, with one transaction in the
+// second block and another one in the mempool. The other methods of the
+// backend panic.
+type fakeBackend struct {
+	graphql.Backend
+
+	msgs    map[int64][]*evmtypes.MsgEthereumTx
+	pending *evmtypes.MsgEthereumTx
+}
+
+func newFakeBackend(t *testing.T) *fakeBackend {
+	t.Helper()
+	return &fakeBackend{
+		msgs: map[int64][]*evmtypes.MsgEthereumTx{
+			1: nil,
+			2: {newMsg(t, 0)},
+		},
+		pending: newMsg(t, 1),
+	}
+}
+
+func newMsg(t *testing.T, nonce uint64) *evmtypes.MsgEthereumTx {
+	t.Helper()
+	signer := ethtypes.LatestSignerForChainID(chainID)
+	tx, err := ethtypes.SignNewTx(testKey, signer, &ethtypes.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     nonce,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(10),
+		Gas:       21000,
+		To:        &receiver,
+		Value:     big.NewInt(100),
+	})
+	require.NoError(t, err)
+	msg := &evmtypes.MsgEthereumTx{}
+	require.NoError(t, msg.FromSignedEthereumTx(tx, signer))
+	return msg
+}
+
+func blockHash(height int64) common.Hash {
+	return common.BigToHash(big.NewInt(0xb10c00 + height))
+}
+
+func (b *fakeBackend) BlockNumber(context.Context) (hexutil.Uint64, error) {
+	return 2, nil
+}
+
+func (b *fakeBackend) CometBlockByNumber(_ context.Context, blockNum rpctypes.BlockNumber) (*cmtrpctypes.ResultBlock, error) {
+	height := blockNum.Int64()
+	if height < 0 {
+		height = 2
+	}
+	if _, ok := b.msgs[height]; !ok {
+		return nil, nil
+	}
+	return &cmtrpctypes.ResultBlock{
+		BlockID: cmttypes.BlockID{Hash: blockHash(height).Bytes()},
+		Block:   &cmttypes.Block{Header: cmttypes.Header{Height: height}},
+	}, nil
+}
+
+func (b *fakeBackend) CometBlockByHash(ctx context.Context, hash common.Hash) (*cmtrpctypes.ResultBlock, error) {
+	for height := range b.msgs {
+		if blockHash(height) == hash {
+			return b.CometBlockByNumber(ctx, rpctypes.BlockNumber(height))
+		}
+	}
+	return nil, nil
+}
+
+func (b *fakeBackend) CometBlockResultByNumber(_ context.Context, height *int64) (*cmtrpctypes.ResultBlockResults, error) {
+	return &cmtrpctypes.ResultBlockResults{Height: *height}, nil
+}
+
+func (b *fakeBackend) EthMsgsFromCometBlock(_ context.Context, resBlock *cmtrpctypes.ResultBlock, _ *cmtrpctypes.ResultBlockResults) []*evmtypes.MsgEthereumTx {
+	return b.msgs[resBlock.Block.Height]
+}
+
+func (b *fakeBackend) ReceiptsFromCometBlock(_ context.Context, resBlock *cmtrpctypes.ResultBlock, _ *cmtrpctypes.ResultBlockResults, msgs []*evmtypes.MsgEthereumTx) ([]*ethtypes.Receipt, error) {
+	receipts := make([]*ethtypes.Receipt, len(msgs))
+	for i, msg := range msgs {
+		receipts[i] = &ethtypes.Receipt{
+			Type:              ethtypes.DynamicFeeTxType,
+			Status:            ethtypes.ReceiptStatusSuccessful,
+			CumulativeGasUsed: 21000,
+			GasUsed:           21000,
+			EffectiveGasPrice: big.NewInt(8),
+			TxHash:            msg.Hash(),
+			BlockHash:         blockHash(resBlock.Block.Height),
+			BlockNumber:       big.NewInt(resBlock.Block.Height),
+			TransactionIndex:  uint(i),
+			Logs: []*ethtypes.Log{{
+				Address: receiver,
+				Topics:  []common.Hash{common.HexToHash("0x01")},
+				Data:    []byte{0xda, 0x7a},
+				TxHash:  msg.Hash(),
+				Index:   uint(i),
+			}},
+		}
+	}
+	return receipts, nil
+}
+
+func (b *fakeBackend) EthBlockFromCometBlock(ctx context.Context, resBlock *cmtrpctypes.ResultBlock, blockRes *cmtrpctypes.ResultBlockResults) (*ethtypes.Block, error) {
+	height := resBlock.Block.Height
+	header := &ethtypes.Header{
+		Number:     big.NewInt(height),
+		Difficulty: big.NewInt(0),
+		GasLimit:   10_000_000,
+		BaseFee:    big.NewInt(7),
+	}
+	if height > 1 {
+		header.ParentHash = blockHash(height - 1)
+	}
+	msgs := b.msgs[height]
+	txs := make([]*ethtypes.Transaction, len(msgs))
+	for i, msg := range msgs {
+		txs[i] = msg.AsTransaction()
+	}
+	receipts, err := b.ReceiptsFromCometBlock(ctx, resBlock, blockRes, msgs)
+	if err != nil {
+		return nil, err
+	}
+	return ethtypes.NewBlock(header, &ethtypes.Body{Transactions: txs}, receipts, trie.NewStackTrie(nil)), nil
+}
+
+func (b *fakeBackend) GetTxByEthHash(_ context.Context, hash common.Hash) (*servertypes.TxResult, error) {
+	for height, msgs := range b.msgs {
+		for _, msg := range msgs {
+			if msg.Hash() == hash {
+				return &servertypes.TxResult{Height: height}, nil
+			}
+		}
+	}
+	return nil, errors.New("tx not found")
+}
+
+func (b *fakeBackend) PendingTransactions(context.Context) ([]*sdk.Tx, error) {
+	var tx sdk.Tx = b.pending
+	return []*sdk.Tx{&tx}, nil
+}
+
+func (b *fakeBackend) GetBalance(_ context.Context, address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Big, error) {
+	if address != sender || blockNrOrHash.BlockNumber == nil {
+		return nil, fmt.Errorf("unexpected balance query %s %v", address, blockNrOrHash)
+	}
+	// the balance is the height of the query
+	return (*hexutil.Big)(big.NewInt(blockNrOrHash.BlockNumber.Int64())), nil
+}
+
+func (b *fakeBackend) RPCBlockRangeCap() int32 {
+	return 10
+}
+
+func (b *fakeBackend) ChainID(context.Context) (*hexutil.Big, error) {
+	return (*hexutil.Big)(chainID), nil
+}
+
+func query(t *testing.T, handler http.Handler, q string) *httptest.ResponseRecorder {
+	t.Helper()
+	body := fmt.Sprintf(`{"query":%q}`, q)
+	req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestHandler(t *testing.T) {
+	backend := newFakeBackend(t)
+	handler, err := graphql.NewHandler(log.NewNopLogger(), backend, 0)
+	require.NoError(t, err)
+
+	committed := backend.msgs[2][0].Hash()
+	pending := backend.pending.Hash()
+
+	testCases := []struct {
+		name    string
+		query   string
+		expCode int
+		expJSON string
+	}{
+		{
+			"block with nested transactions and logs",
+			`{ block(number: 2) { number hash parent { number } baseFeePerGas transactionCount
+				transactions { hash index from(block: 2) { address balance } to { address } value gasPrice status gasUsed
+					logs { index topics data account { address } transaction { hash } } } } }`,
+			http.StatusOK,
+			fmt.Sprintf(`{"data":{"block":{"number":"0x2","hash":"%s","parent":{"number":"0x1"},"baseFeePerGas":"0x7","transactionCount":"0x1",
+				"transactions":[{"hash":"%s","index":"0x0","from":{"address":"%s","balance":"0x2"},"to":{"address":"%s"},"value":"0x64","gasPrice":"0x8","status":"0x1","gasUsed":"0x5208",
+					"logs":[{"index":"0x0","topics":["%s"],"data":"0xda7a","account":{"address":"%s"},"transaction":{"hash":"%s"}}]}]}}}`,
+				blockHash(2).Hex(), committed.Hex(), strings.ToLower(sender.Hex()), strings.ToLower(receiver.Hex()),
+				common.HexToHash("0x01").Hex(), strings.ToLower(receiver.Hex()), committed.Hex()),
+		},
+		{
+			"block by hash, the accounts are queried at its height",
+			fmt.Sprintf(`{ block(hash: "%s") { number parent { number } account(address: "%s") { balance } } }`, blockHash(1).Hex(), sender.Hex()),
+			http.StatusOK,
+			`{"data":{"block":{"number":"0x1","parent":null,"account":{"balance":"0x1"}}}}`,
+		},
+		{
+			"future block",
+			`{ block(number: 3) { number } }`,
+			http.StatusOK,
+			`{"data":{"block":null}}`,
+		},
+		{
+			"blocks",
+			`{ blocks(from: 1) { number } }`,
+			http.StatusOK,
+			`{"data":{"blocks":[{"number":"0x1"},{"number":"0x2"}]}}`,
+		},
+		{
+			"committed transaction",
+			fmt.Sprintf(`{ transaction(hash: "%s") { nonce block { number } index effectiveGasPrice } }`, committed.Hex()),
+			http.StatusOK,
+			`{"data":{"transaction":{"nonce":"0x0","block":{"number":"0x2"},"index":"0x0","effectiveGasPrice":"0x8"}}}`,
+		},
+		{
+			"pending transaction",
+			fmt.Sprintf(`{ transaction(hash: "%s") { nonce block { number } index status } pending { transactionCount } }`, pending.Hex()),
+			http.StatusOK,
+			`{"data":{"transaction":{"nonce":"0x1","block":null,"index":null,"status":null},"pending":{"transactionCount":"0x1"}}}`,
+		},
+		{
+			"unknown transaction",
+			fmt.Sprintf(`{ transaction(hash: "%s") { nonce } }`, common.HexToHash("0x1234").Hex()),
+			http.StatusOK,
+			`{"data":{"transaction":null}}`,
+		},
+		{
+			"chain id",
+			`{ chainID }`,
+			http.StatusOK,
+			`{"data":{"chainID":"0x2329"}}`,
+		},
+		{
+			"invalid query",
+			`{ block { unknownField } }`,
+			http.StatusBadRequest,
+			`{"errors":[{"message":"Cannot query field \"unknownField\" on type \"Block\".","locations":[{"line":1,"column":11}]}]}`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := query(t, handler, tc.query)
+			require.Equal(t, tc.expCode, rec.Code, rec.Body.String())
+			require.JSONEq(t, tc.expJSON, rec.Body.String())
+		})
+	}
+}
+
+func TestHandlerInvalidBody(t *testing.T) {
+	handler, err := graphql.NewHandler(log.NewNopLogger(), newFakeBackend(t), 0)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query":`))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
@@ -0,0 +1,61 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"cosmossdk.io/log"
+)
+
+// handler answers the GraphQL queries posted in JSON
+type handler struct {
+	schema  *graphql.Schema
+	timeout time.Duration
+}
+
+// NewHandler returns an http.Handler answering the GraphQL queries with the
+// EIP-1767 schema. The queries are canceled after the timeout, if it isn't
+// zero.
+func NewHandler(logger log.Logger, backend Backend, timeout time.Duration) (http.Handler, error) {
+	resolver := &Resolver{backend: backend, logger: logger}
+	schema, err := graphql.ParseSchema(schema, resolver)
+	if err != nil {
+		return nil, err
+	}
+	return handler{schema: schema, timeout: timeout}, nil
+}
+
+func (h handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var params struct {
+		Query         string                 `json:"query"`
+		OperationName string                 `json:"operationName"`
+		Variables     map[string]interface{} `json:"variables"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	if h.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, h.timeout)
+		defer cancel()
+	}
+
+	response := h.schema.Exec(ctx, params.Query, params.OperationName, params.Variables)
+	responseJSON, err := json.Marshal(response)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if len(response.Errors) > 0 {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	_, _ = w.Write(responseJSON)
+}
@@ -0,0 +1,195 @@
+// Package middleware implements the helpers shared by the middlewares of the
+// HTTP and WebSocket JSON-RPC servers.
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+)
+
+const (
+	// forwardHeader is the header marking the requests forwarded by the
+	// WebSocket server to the HTTP server.
+	forwardHeader = "X-Cosmos-EVM-Forward"
+	// maxBodySize is the maximum size of the HTTP request body read by the
+	// middlewares, it matches the body limit of the go-ethereum HTTP server.
+	maxBodySize = 5 * 1024 * 1024
+)
+
+// Request is the part of a JSON-RPC request inspected by the middlewares
+type Request struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params,omitempty"`
+}
+
+// ParseRequests decodes the requests of a JSON-RPC message, and reports
+// whether it is a batch. It decodes the message the same way as the
+// go-ethereum server: only the first JSON value is read, the decoding errors
+// are ignored and the batch elements are decoded independently, so the
+// middlewares see the same methods as the server.
+func ParseRequests(msg []byte) ([]Request, bool) {
+	var raw json.RawMessage
+	if err := json.NewDecoder(bytes.NewReader(msg)).Decode(&raw); err != nil {
+		return nil, false
+	}
+
+	if !isBatch(raw) {
+		reqs := []Request{{}}
+		_ = json.Unmarshal(raw, &reqs[0])
+		return reqs, false
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	_, _ = dec.Token() // skip '['
+	var reqs []Request
+	for dec.More() {
+		reqs = append(reqs, Request{})
+		_ = dec.Decode(&reqs[len(reqs)-1])
+	}
+	return reqs, true
+}
+
+// isBatch returns true when the first non-whitespace character is '['
+func isBatch(raw json.RawMessage) bool {
+	for _, c := range raw {
+		if c == 0x20 || c == 0x09 || c == 0x0a || c == 0x0d {
+			continue
+		}
+		return c == '['
+	}
+	return false
+}
+
+// Error is a JSON-RPC error object
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type errorResponse struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Error   Error           `json:"error"`
+}
+
+func newErrorResponse(id json.RawMessage, err Error) errorResponse {
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+	return errorResponse{Version: "2.0", ID: id, Error: err}
+}
+
+// ErrorResponse returns the JSON-RPC response of a rejected message, with the
+// error returned by errFn for every request of a batch.
+func ErrorResponse(msg []byte, errFn func(req Request) Error) []byte {
+	reqs, batch := ParseRequests(msg)
+
+	var res any
+	if batch && len(reqs) > 0 {
+		batchRes := make([]errorResponse, len(reqs))
+		for i, req := range reqs {
+			batchRes[i] = newErrorResponse(req.ID, errFn(req))
+		}
+		res = batchRes
+	} else {
+		var req Request
+		if len(reqs) == 1 {
+			req = reqs[0]
+		}
+		res = newErrorResponse(req.ID, errFn(req))
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		// the ids were decoded as valid JSON
+		panic(err)
+	}
+	return bz
+}
+
+// WriteError writes the JSON-RPC error response of a rejected HTTP request
+func WriteError(w http.ResponseWriter, status int, res []byte) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(res)
+}
+
+// ReadBody returns the beginning of the body of the request, up to the body
+// limit of the server, and restores it so it can be read again by the next
+// handler.
+func ReadBody(r *http.Request) ([]byte, error) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize))
+	if err != nil {
+		return nil, err
+	}
+	// the remaining of a body exceeding the limit is left to the server
+	r.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(body), r.Body), r.Body}
+	return body, nil
+}
+
+// clientContextKey is the context key of the authenticated client of a request
+type clientContextKey struct{}
+
+// WithClient returns a shallow copy of the request carrying the authenticated
+// identity of its client, for the middlewares after the authentication.
+func WithClient(r *http.Request, client string) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), clientContextKey{}, client))
+}
+
+// Client returns the authenticated identity of the client of the request, or
+// an empty string if it didn't authenticate.
+func Client(r *http.Request) string {
+	client, _ := r.Context().Value(clientContextKey{}).(string)
+	return client
+}
+
+// RemoteIP returns the IP of the client of the request
+func RemoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// ForwardToken authenticates the requests forwarded by the WebSocket server to
+// the HTTP server, which were already checked by the WebSocket middlewares.
+type ForwardToken string
+
+// NewForwardToken returns a new random ForwardToken
+func NewForwardToken() ForwardToken {
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		panic(err)
+	}
+	return ForwardToken(hex.EncodeToString(token))
+}
+
+// Mark marks a request forwarded by the WebSocket server
+func (t ForwardToken) Mark(r *http.Request) {
+	r.Header.Set(forwardHeader, string(t))
+}
+
+// Handler returns an http.Handler passing the requests forwarded by the
+// WebSocket server to forwarded, and the other requests to next.
+func (t ForwardToken) Handler(forwarded, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if t != "" && r.Header.Get(forwardHeader) == string(t) {
+			// the header must not reach the next handlers
+			r.Header.Del(forwardHeader)
+			forwarded.ServeHTTP(w, r)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,94 @@
+package middleware_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/rpc/middleware"
+)
+
+func TestParseRequests(t *testing.T) {
+	testCases := []struct {
+		name     string
+		msg      string
+		expReqs  []middleware.Request
+		expBatch bool
+	}{
+		{
+			"single request",
+			`{"jsonrpc":"2.0","id":1,"method":"eth_call"}`,
+			[]middleware.Request{{ID: json.RawMessage("1"), Method: "eth_call"}},
+			false,
+		},
+		{
+			"only the first value is read",
+			`{"jsonrpc":"2.0","id":1,"method":"eth_call"}{"id":2,"method":"debug_traceCall"}`,
+			[]middleware.Request{{ID: json.RawMessage("1"), Method: "eth_call"}},
+			false,
+		},
+		{
+			"type errors are ignored",
+			`{"jsonrpc":"2.0","method":"debug_traceCall","id":1,"params":5}`,
+			[]middleware.Request{{ID: json.RawMessage("1"), Method: "debug_traceCall", Params: json.RawMessage("5")}},
+			false,
+		},
+		{
+			"batch with a malformed element",
+			` [{"id":1,"method":5},{"id":"a","method":"debug_traceCall"}]`,
+			[]middleware.Request{{ID: json.RawMessage("1")}, {ID: json.RawMessage(`"a"`), Method: "debug_traceCall"}},
+			true,
+		},
+		{
+			"invalid JSON",
+			`{"method":`,
+			nil,
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reqs, batch := middleware.ParseRequests([]byte(tc.msg))
+			require.Equal(t, tc.expReqs, reqs)
+			require.Equal(t, tc.expBatch, batch)
+		})
+	}
+}
+
+func TestReadBody(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"method":"eth_call"}`))
+	body, err := middleware.ReadBody(req)
+	require.NoError(t, err)
+	require.Equal(t, `{"method":"eth_call"}`, string(body))
+
+	var res map[string]string
+	require.NoError(t, json.NewDecoder(req.Body).Decode(&res))
+	require.Equal(t, "eth_call", res["method"])
+}
+
+func TestForwardToken(t *testing.T) {
+	token := middleware.NewForwardToken()
+	var routed string
+	handler := token.Handler(
+		http.HandlerFunc(func(http.ResponseWriter, *http.Request) { routed = "forwarded" }),
+		http.HandlerFunc(func(http.ResponseWriter, *http.Request) { routed = "next" }),
+	)
+
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	require.Equal(t, "next", routed)
+
+	req = httptest.NewRequest(http.MethodPost, "/", nil)
+	middleware.NewForwardToken().Mark(req)
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	require.Equal(t, "next", routed, "the token of another server is not accepted")
+
+	req = httptest.NewRequest(http.MethodPost, "/", nil)
+	token.Mark(req)
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	require.Equal(t, "forwarded", routed)
+}
@@ -164,6 +164,37 @@ func (a *API) TraceCall(ctx context.Context, args evmtypes.TransactionArgs, bloc
 	return a.backend.TraceCall(ctx, args, blockNrOrHash, config)
 }
 
+// StorageRangeAt returns the storage of the contract before the execution of
+// the transaction at txIndex in the block. The storage is keyed by the hash of
+// the keys like in geth, but keyStart and nextKey are raw storage keys.
+func (a *API) StorageRangeAt(ctx context.Context, blockNrOrHash rpctypes.BlockNumberOrHash, txIndex int, contractAddress common.Address, keyStart hexutil.Bytes, maxResult int) (*rpctypes.StorageRangeResult, error) {
+	a.logger.Debug("debug_storageRangeAt", "block number or hash", blockNrOrHash, "txIndex", txIndex, "address", contractAddress)
+	ctx, span := tracer.Start(ctx, "debug_storageRangeAt", trace.WithAttributes(attribute.String("address", contractAddress.String())))
+	defer span.End()
+	return a.backend.StorageRangeAt(ctx, blockNrOrHash, txIndex, contractAddress, keyStart, maxResult)
+}
+
+// AccountRange enumerates the contract accounts at the end of the block in
+// ascending address order. The start and next fields are raw addresses.
+// Accounts without code are not enumerated, so incompletes is ignored. The
+// storage of each account is capped, debug_storageRangeAt returns the rest.
+func (a *API) AccountRange(ctx context.Context, blockNrOrHash rpctypes.BlockNumberOrHash, start hexutil.Bytes, maxResults int, nocode, nostorage, _ bool) (*rpctypes.AccountRangeResult, error) {
+	a.logger.Debug("debug_accountRange", "block number or hash", blockNrOrHash, "start", start, "maxResults", maxResults)
+	ctx, span := tracer.Start(ctx, "debug_accountRange")
+	defer span.End()
+	return a.backend.AccountRange(ctx, blockNrOrHash, start, maxResults, nocode, nostorage)
+}
+
+// GetModifiedAccountsByNumber returns the accounts modified by the EVM between
+// the startNum and the endNum blocks, endNum included and startNum excluded.
+// If endNum is omitted, it returns the accounts modified by the startNum block.
+func (a *API) GetModifiedAccountsByNumber(ctx context.Context, startNum uint64, endNum *uint64) ([]common.Address, error) {
+	a.logger.Debug("debug_getModifiedAccountsByNumber", "start", startNum, "end", endNum)
+	ctx, span := tracer.Start(ctx, "debug_getModifiedAccountsByNumber")
+	defer span.End()
+	return a.backend.GetModifiedAccountsByNumber(ctx, startNum, endNum)
+}
+
 // GetRawBlock retrieves the RLP-encoded block by block number or hash.
 func (a *API) GetRawBlock(ctx context.Context, blockNrOrHash rpctypes.BlockNumberOrHash) (_ hexutil.Bytes, err error) {
 	a.logger.Debug("debug_getRawBlock", "block number or hash", blockNrOrHash)
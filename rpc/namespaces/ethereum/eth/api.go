@@ -3,6 +3,7 @@ package eth
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -13,6 +14,7 @@ import (
 	"go.opentelemetry.io/otel"
 
 	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/stream"
 	rpctypes "github.com/cosmos/evm/rpc/types"
 	evmtrace "github.com/cosmos/evm/trace"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
@@ -22,6 +24,10 @@ import (
 
 var tracer = otel.Tracer("evm/rpc/namespaces/ethereum/eth")
 
+// txSyncPollInterval is the interval of the receipt lookups of
+// eth_sendRawTransactionSync between the stream events.
+const txSyncPollInterval = 200 * time.Millisecond
+
 // The Ethereum API allows applications to connect to an node of any Cosmos EVM based blockchain.
 // Developers can interact with on-chain EVM data
 // and send different types of transactions to the network by utilizing the
@@ -42,11 +48,12 @@ type EthereumAPI interface {
 	//
 	// Retrieves information on the state data for addresses regardless of whether
 	// it is a user or a smart contract.
-	GetTransactionByHash(hash common.Hash) (*rpctypes.RPCTransaction, error)
+	GetTransactionByHash(hash common.Hash) (interface{}, error)
 	GetTransactionCount(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Uint64, error)
 	GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error)
-	GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
-	GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNumber, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
+	GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (interface{}, error)
+	GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNumber, idx hexutil.Uint) (interface{}, error)
+	GetTransactionsByAddress(address common.Address, args rpctypes.TransactionsByAddressArgs) (*rpctypes.TransactionsByAddressResult, error)
 	// eth_getBlockReceipts
 
 	// Writing Transactions
@@ -54,6 +61,7 @@ type EthereumAPI interface {
 	// Allows developers to both send ETH from one address to another, write data
 	// on-chain, and interact with smart contracts.
 	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
+	SendRawTransactionSync(data hexutil.Bytes, timeout *hexutil.Uint64) (map[string]interface{}, error)
 	SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error)
 	// eth_sendPrivateTransaction
 	// eth_cancel	PrivateTransaction
@@ -72,6 +80,7 @@ type EthereumAPI interface {
 	// Allows developers to read data from the blockchain which includes executing
 	// smart contracts. However, no data is published to the Ethereum network.
 	Call(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, overrides *json.RawMessage) (hexutil.Bytes, error)
+	SimulateV1(opts rpctypes.SimOpts, blockNrOrHash *rpctypes.BlockNumberOrHash) ([]rpctypes.SimBlockResult, error)
 
 	// Chain Information
 	//
@@ -116,13 +125,15 @@ var _ EthereumAPI = (*PublicAPI)(nil)
 type PublicAPI struct {
 	logger  log.Logger
 	backend backend.EVMBackend
+	events  *stream.RPCStream
 }
 
 // NewPublicAPI creates an instance of the public ETH Web3 API.
-func NewPublicAPI(logger log.Logger, backend backend.EVMBackend) *PublicAPI {
+func NewPublicAPI(logger log.Logger, backend backend.EVMBackend, events *stream.RPCStream) *PublicAPI {
 	api := &PublicAPI{
 		logger:  logger.With("client", "json-rpc"),
 		backend: backend,
+		events:  events,
 	}
 
 	return api
@@ -193,11 +204,26 @@ func (e *PublicAPI) GetBlockReceipts(ctx context.Context, blockNrOrHash rpctypes
 ///////////////////////////////////////////////////////////////////////////////
 
 // GetTransactionByHash returns the transaction identified by hash.
-func (e *PublicAPI) GetTransactionByHash(hash common.Hash) (_ *rpctypes.RPCTransaction, err error) {
+// The Cosmos transactions are returned as pseudo-transactions if they are
+// enabled.
+func (e *PublicAPI) GetTransactionByHash(hash common.Hash) (_ interface{}, err error) {
 	ctx, span := tracer.Start(context.Background(), "eth_getTransactionByHash")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	e.logger.Debug("eth_getTransactionByHash", "hash", hash.Hex())
-	return e.backend.GetTransactionByHash(ctx, hash)
+	tx, err := e.backend.GetTransactionByHash(ctx, hash)
+	if err != nil || tx != nil {
+		return tx, err
+	}
+	return nilIfEmpty(e.backend.GetCosmosTransactionByHash(ctx, hash))
+}
+
+// GetTransactionsByAddress returns a page of the transactions in which the
+// address appears. It requires the address indexes to be enabled.
+func (e *PublicAPI) GetTransactionsByAddress(address common.Address, args rpctypes.TransactionsByAddressArgs) (_ *rpctypes.TransactionsByAddressResult, err error) {
+	ctx, span := tracer.Start(context.Background(), "eth_getTransactionsByAddress")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	e.logger.Debug("eth_getTransactionsByAddress", "address", address.Hex(), "roles", args.Roles, "reverse", args.Reverse)
+	return e.backend.GetTransactionsByAddress(ctx, address, args)
 }
 
 // GetTransactionCount returns the number of transactions at the given address up to the given block number.
@@ -238,19 +264,39 @@ func (e *PublicAPI) GetBlockTransactionCountByNumber(blockNum rpctypes.BlockNumb
 }
 
 // GetTransactionByBlockHashAndIndex returns the transaction identified by hash and index.
-func (e *PublicAPI) GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (_ *rpctypes.RPCTransaction, err error) {
+// The indexes following the Ethereum transactions are the ones of the
+// pseudo-transactions of the Cosmos transactions if they are enabled.
+func (e *PublicAPI) GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (_ interface{}, err error) {
 	ctx, span := tracer.Start(context.Background(), "eth_getTransactionByBlockHashAndIndex")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	e.logger.Debug("eth_getTransactionByBlockHashAndIndex", "hash", hash.Hex(), "index", idx)
-	return e.backend.GetTransactionByBlockHashAndIndex(ctx, hash, idx)
+	tx, err := e.backend.GetTransactionByBlockHashAndIndex(ctx, hash, idx)
+	if err != nil || tx != nil {
+		return tx, err
+	}
+	return nilIfEmpty(e.backend.GetCosmosTransactionByBlockAndIndex(ctx, rpctypes.BlockNumberOrHash{BlockHash: &hash}, idx))
 }
 
 // GetTransactionByBlockNumberAndIndex returns the transaction identified by number and index.
-func (e *PublicAPI) GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNumber, idx hexutil.Uint) (_ *rpctypes.RPCTransaction, err error) {
+// The indexes following the Ethereum transactions are the ones of the
+// pseudo-transactions of the Cosmos transactions if they are enabled.
+func (e *PublicAPI) GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNumber, idx hexutil.Uint) (_ interface{}, err error) {
 	ctx, span := tracer.Start(context.Background(), "eth_getTransactionByBlockNumberAndIndex")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	e.logger.Debug("eth_getTransactionByBlockNumberAndIndex", "number", blockNum, "index", idx)
-	return e.backend.GetTransactionByBlockNumberAndIndex(ctx, blockNum, idx)
+	tx, err := e.backend.GetTransactionByBlockNumberAndIndex(ctx, blockNum, idx)
+	if err != nil || tx != nil {
+		return tx, err
+	}
+	return nilIfEmpty(e.backend.GetCosmosTransactionByBlockAndIndex(ctx, rpctypes.BlockNumberOrHash{BlockNumber: &blockNum}, idx))
+}
+
+// nilIfEmpty returns an untyped nil instead of a nil pseudo-transaction.
+func nilIfEmpty(tx *rpctypes.RPCCosmosTransaction, err error) (interface{}, error) {
+	if err != nil || tx == nil {
+		return nil, err
+	}
+	return tx, nil
 }
 
 ///////////////////////////////////////////////////////////////////////////////
@@ -265,6 +311,82 @@ func (e *PublicAPI) SendRawTransaction(data hexutil.Bytes) (_ common.Hash, err e
 	return e.backend.SendRawTransaction(ctx, data)
 }
 
+// SendRawTransactionSync sends a raw Ethereum transaction and waits for its
+// receipt, as defined by EIP-7966. The timeout is in milliseconds and is
+// capped by the tx-sync-timeout of the node, which is also the default.
+func (e *PublicAPI) SendRawTransactionSync(data hexutil.Bytes, timeout *hexutil.Uint64) (_ map[string]interface{}, err error) {
+	ctx, span := tracer.Start(context.Background(), "eth_sendRawTransactionSync")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	e.logger.Debug("eth_sendRawTransactionSync", "length", len(data))
+
+	maxTimeout := e.backend.RPCTxSyncTimeout()
+	syncTimeout := maxTimeout
+	if timeout != nil {
+		syncTimeout = min(time.Duration(*timeout)*time.Millisecond, maxTimeout) //#nosec G115 -- int overflow is not a concern here
+	}
+
+	hash, err := e.backend.SendRawTransaction(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, syncTimeout)
+	defer cancel()
+	return e.waitForReceipt(ctx, hash, syncTimeout)
+}
+
+// waitForReceipt returns the receipt of the transaction once it is indexed.
+// The lookup is retried on every new header or log of the event streams, and
+// periodically since the indexer may lag behind the events.
+func (e *PublicAPI) waitForReceipt(ctx context.Context, hash common.Hash, timeout time.Duration) (map[string]interface{}, error) {
+	updates := make(chan struct{}, 1)
+	notify := func() {
+		select {
+		case updates <- struct{}{}:
+		default:
+		}
+	}
+	if e.events != nil {
+		go watchStream(ctx, e.events.HeaderStream(), notify)
+		go watchStream(ctx, e.events.LogStream(), notify)
+	}
+
+	ticker := time.NewTicker(txSyncPollInterval)
+	defer ticker.Stop()
+
+	for {
+		// the receipt lookup doesn't retry, so that the wait is bounded by ctx
+		if res, err := e.backend.GetTxByEthHash(ctx, hash); err == nil {
+			receipt, err := e.backend.ReceiptFromTxResult(ctx, hash, res)
+			if err != nil || receipt != nil {
+				return receipt, err
+			}
+		}
+
+		select {
+		case <-updates:
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil, &rpctypes.TxSyncTimeoutError{Hash: hash, Timeout: timeout}
+		}
+	}
+}
+
+// watchStream calls notify when new items are added to the stream, until the
+// context is done.
+func watchStream[V any](ctx context.Context, s *stream.Stream[V], notify func()) {
+	_, offset := s.ReadNonBlocking(-1)
+	for {
+		var items []V
+		items, offset = s.ReadBlocking(ctx, offset)
+		if len(items) == 0 {
+			// canceled
+			return
+		}
+		notify()
+	}
+}
+
 // SendTransaction sends an Ethereum transaction.
 func (e *PublicAPI) SendTransaction(args evmtypes.TransactionArgs) (_ common.Hash, err error) {
 	ctx, span := tracer.Start(context.Background(), "eth_sendTransaction")
@@ -344,6 +466,18 @@ func (e *PublicAPI) Call(
 	return (hexutil.Bytes)(data.Ret), nil
 }
 
+// SimulateV1 executes series of transactions on top of a base state, with
+// optional block and state overrides, and returns the simulated blocks.
+func (e *PublicAPI) SimulateV1(
+	opts rpctypes.SimOpts,
+	blockNrOrHash *rpctypes.BlockNumberOrHash,
+) (_ []rpctypes.SimBlockResult, err error) {
+	ctx, span := tracer.Start(context.Background(), "eth_simulateV1")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	e.logger.Debug("eth_simulateV1", "blocks", len(opts.BlockStateCalls), "block number or hash", blockNrOrHash)
+	return e.backend.SimulateV1(ctx, opts, blockNrOrHash)
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 ///                           Event Logs													          ///
 ///////////////////////////////////////////////////////////////////////////////
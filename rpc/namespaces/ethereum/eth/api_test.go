@@ -0,0 +1,188 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/stream"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
+
+	"cosmossdk.io/log"
+)
+
+// txSyncBackend is a stub backend for eth_sendRawTransactionSync, which finds
+// the transaction once indexed is set.
+type txSyncBackend struct {
+	backend.EVMBackend
+
+	hash          common.Hash
+	syncTimeout   time.Duration
+	indexed       atomic.Bool
+	txLookup      atomic.Int32
+	receiptLookup atomic.Int32
+}
+
+func (b *txSyncBackend) RPCTxSyncTimeout() time.Duration { return b.syncTimeout }
+
+func (b *txSyncBackend) SendRawTransaction(context.Context, hexutil.Bytes) (common.Hash, error) {
+	return b.hash, nil
+}
+
+func (b *txSyncBackend) GetTxByEthHash(context.Context, common.Hash) (*servertypes.TxResult, error) {
+	b.txLookup.Add(1)
+	if !b.indexed.Load() {
+		return nil, errors.New("tx not found")
+	}
+	return &servertypes.TxResult{Height: 1}, nil
+}
+
+func (b *txSyncBackend) ReceiptFromTxResult(_ context.Context, hash common.Hash, _ *servertypes.TxResult) (map[string]interface{}, error) {
+	b.receiptLookup.Add(1)
+	return map[string]interface{}{"transactionHash": hash}, nil
+}
+
+// cosmosTxBackend is a stub backend finding a single pseudo-transaction.
+type cosmosTxBackend struct {
+	backend.EVMBackend
+
+	cosmosTx *rpctypes.RPCCosmosTransaction
+}
+
+func (b *cosmosTxBackend) GetTransactionByHash(context.Context, common.Hash) (*rpctypes.RPCTransaction, error) {
+	return nil, nil
+}
+
+func (b *cosmosTxBackend) GetTransactionByBlockNumberAndIndex(context.Context, rpctypes.BlockNumber, hexutil.Uint) (*rpctypes.RPCTransaction, error) {
+	return nil, nil
+}
+
+func (b *cosmosTxBackend) GetCosmosTransactionByHash(_ context.Context, hash common.Hash) (*rpctypes.RPCCosmosTransaction, error) {
+	if hash != b.cosmosTx.Hash {
+		return nil, nil
+	}
+	return b.cosmosTx, nil
+}
+
+func (b *cosmosTxBackend) GetCosmosTransactionByBlockAndIndex(_ context.Context, _ rpctypes.BlockNumberOrHash, idx hexutil.Uint) (*rpctypes.RPCCosmosTransaction, error) {
+	if uint64(idx) != uint64(*b.cosmosTx.TransactionIndex) {
+		return nil, nil
+	}
+	return b.cosmosTx, nil
+}
+
+// noEventsClient is an events client without subscriptions, so the streams are
+// only fed by the tests.
+type noEventsClient struct{}
+
+func (noEventsClient) Subscribe(context.Context, string, string, ...int) (<-chan coretypes.ResultEvent, error) {
+	return nil, errors.New("no subscriptions")
+}
+
+func (noEventsClient) Unsubscribe(context.Context, string, string) error { return nil }
+
+func (noEventsClient) UnsubscribeAll(context.Context, string) error { return nil }
+
+func newTxSyncAPI(b *txSyncBackend) (*PublicAPI, *stream.RPCStream) {
+	events := stream.NewRPCStreams(noEventsClient{}, log.NewNopLogger(), nil)
+	// the streams are created on first use
+	events.HeaderStream()
+	return NewPublicAPI(log.NewNopLogger(), b, events), events
+}
+
+func TestSendRawTransactionSyncTimeout(t *testing.T) {
+	b := &txSyncBackend{hash: common.HexToHash("0x01"), syncTimeout: 50 * time.Millisecond}
+	api, _ := newTxSyncAPI(b)
+
+	// the timeout of the request is capped by the timeout of the node
+	timeout := hexutil.Uint64(time.Minute.Milliseconds())
+	start := time.Now()
+	_, err := api.SendRawTransactionSync(hexutil.Bytes{0x1}, &timeout)
+	require.Less(t, time.Since(start), 10*time.Second)
+
+	var timeoutErr *rpctypes.TxSyncTimeoutError
+	require.ErrorAs(t, err, &timeoutErr)
+	require.Equal(t, b.syncTimeout, timeoutErr.Timeout)
+
+	// the error has the code of EIP-7966 and the tx hash as data
+	var rpcErr rpc.Error
+	require.ErrorAs(t, err, &rpcErr)
+	require.Equal(t, rpctypes.ErrCodeTxSyncTimeout, rpcErr.ErrorCode())
+	var dataErr rpc.DataError
+	require.ErrorAs(t, err, &dataErr)
+	require.Equal(t, b.hash.Hex(), dataErr.ErrorData())
+
+	// a shorter timeout of the request is kept
+	timeout = 10
+	_, err = api.SendRawTransactionSync(hexutil.Bytes{0x1}, &timeout)
+	require.ErrorAs(t, err, &timeoutErr)
+	require.Equal(t, 10*time.Millisecond, timeoutErr.Timeout)
+	require.Zero(t, b.receiptLookup.Load())
+}
+
+func TestSendRawTransactionSyncStreamEvent(t *testing.T) {
+	b := &txSyncBackend{hash: common.HexToHash("0x02"), syncTimeout: time.Minute}
+	api, events := newTxSyncAPI(b)
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		// the tx is indexed after the first lookup, and a new block is announced
+		// until the receipt is returned since the waiter may not be subscribed yet
+		for b.txLookup.Load() == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		b.indexed.Store(true)
+		ticker := time.NewTicker(10 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				events.HeaderStream().Add(stream.RPCHeader{})
+			}
+		}
+	}()
+
+	// the timeout is shorter than the poll interval, so the receipt can only be
+	// found on a stream event
+	timeout := hexutil.Uint64(txSyncPollInterval.Milliseconds() - 50)
+	receipt, err := api.SendRawTransactionSync(hexutil.Bytes{0x1}, &timeout)
+	require.NoError(t, err)
+	require.Equal(t, b.hash, receipt["transactionHash"])
+	require.Equal(t, int32(1), b.receiptLookup.Load())
+}
+
+func TestGetTransactionCosmosTx(t *testing.T) {
+	index := hexutil.Uint64(1)
+	b := &cosmosTxBackend{cosmosTx: &rpctypes.RPCCosmosTransaction{Hash: common.HexToHash("0x03"), TransactionIndex: &index}}
+	api := NewPublicAPI(log.NewNopLogger(), b, nil)
+
+	// the lookups fall back on the pseudo-transactions
+	tx, err := api.GetTransactionByHash(b.cosmosTx.Hash)
+	require.NoError(t, err)
+	require.Equal(t, b.cosmosTx, tx)
+	tx, err = api.GetTransactionByBlockNumberAndIndex(rpctypes.BlockNumber(1), 1)
+	require.NoError(t, err)
+	require.Equal(t, b.cosmosTx, tx)
+
+	// the transactions found nowhere are null
+	tx, err = api.GetTransactionByHash(common.HexToHash("0x04"))
+	require.NoError(t, err)
+	require.Nil(t, tx)
+	tx, err = api.GetTransactionByBlockNumberAndIndex(rpctypes.BlockNumber(1), 2)
+	require.NoError(t, err)
+	require.Nil(t, tx)
+}
@@ -17,6 +17,7 @@ import (
 
 	"github.com/cosmos/evm/rpc/stream"
 	"github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
 	evmtrace "github.com/cosmos/evm/trace"
 
 	"cosmossdk.io/log"
@@ -54,6 +55,7 @@ type Backend interface {
 	BlockBloomFromCometBlock(ctx context.Context, blockRes *coretypes.ResultBlockResults) (ethtypes.Bloom, error)
 
 	BloomStatus() (uint64, uint64)
+	LogIndexer() servertypes.EVMTxIndexer
 
 	RPCFilterCap() int32
 	RPCLogsCap() int32
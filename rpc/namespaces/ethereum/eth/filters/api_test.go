@@ -16,6 +16,7 @@ import (
 	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 
 	"github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
 
 	"cosmossdk.io/log"
 )
@@ -50,6 +51,7 @@ func (m *mockBackendForAPI) BlockBloomFromCometBlock(_ context.Context, _ *coret
 	return ethtypes.Bloom{}, nil
 }
 func (m *mockBackendForAPI) BloomStatus() (uint64, uint64)  { return 0, 0 }
+func (m *mockBackendForAPI) LogIndexer() servertypes.EVMTxIndexer { return nil }
 func (m *mockBackendForAPI) RPCFilterCap() int32            { return m.filterCap }
 func (m *mockBackendForAPI) RPCLogsCap() int32              { return 10000 }
 func (m *mockBackendForAPI) RPCBlockRangeCap() int32        { return 10000 }
@@ -116,23 +116,99 @@ func (f *Filter) Logs(ctx context.Context, logLimit int, blockLimit int64) (logs
 			return nil, err
 		}
 
-		return f.blockLogs(blockRes, bloom)
+		return f.blockLogs(ctx, blockRes, bloom)
 	}
 
+	appendBlockLogs := func(height uint64) error {
+		blockRes, bloom, err := f.blockResults(ctx, height)
+		if err != nil {
+			return err
+		}
+
+		// check logs limit before fetching more
+		if len(logs) >= logLimit {
+			return fmt.Errorf("query returned more than %d results", logLimit)
+		}
+
+		filtered, err := f.blockLogs(ctx, blockRes, bloom)
+		if err != nil {
+			return fmt.Errorf("failed to fetch block by number %d: %w", height, err)
+		}
+
+		// check logs limit
+		if len(logs)+len(filtered) > logLimit {
+			return fmt.Errorf("query returned more than %d results", logLimit)
+		}
+		logs = append(logs, filtered...)
+		return nil
+	}
+
+	if err := f.forEachBlock(ctx, blockLimit, appendBlockLogs); err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
+
+// ForEachBlockLogs calls fn with the logs matching the range filter of every
+// block which may contain some, in increasing height order, without
+// accumulating them. It stops at the first error. The range is not limited if
+// blockLimit is negative.
+func (f *Filter) ForEachBlockLogs(ctx context.Context, blockLimit int64, fn func(logs []*ethtypes.Log) error) (err error) {
+	ctx, span := tracer.Start(ctx, "Filter.ForEachBlockLogs")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	return f.forEachBlock(ctx, blockLimit, func(height uint64) error {
+		blockRes, bloom, err := f.blockResults(ctx, height)
+		if err != nil {
+			return err
+		}
+
+		filtered, err := f.blockLogs(ctx, blockRes, bloom)
+		if err != nil {
+			return fmt.Errorf("failed to fetch block by number %d: %w", height, err)
+		}
+		if len(filtered) == 0 {
+			return nil
+		}
+		return fn(filtered)
+	})
+}
+
+// blockResults returns the results and the bloom filter of the block at height
+func (f *Filter) blockResults(ctx context.Context, height uint64) (*cmtrpctypes.ResultBlockResults, ethtypes.Bloom, error) {
+	h := int64(height) //#nosec G115
+	blockRes, err := f.backend.CometBlockResultByNumber(ctx, &h)
+	if err != nil {
+		f.logger.Debug("failed to fetch block result from CometBFT", "height", height, "error", err.Error())
+		return nil, ethtypes.Bloom{}, fmt.Errorf("failed to fetch block result from CometBFT: %w", err)
+	}
+
+	bloom, err := f.backend.BlockBloomFromCometBlock(ctx, blockRes)
+	if err != nil {
+		return nil, ethtypes.Bloom{}, fmt.Errorf("failed to query block bloom filter from block results: %w", err)
+	}
+	return blockRes, bloom, nil
+}
+
+// forEachBlock resolves the range of the filter and calls fn with the heights
+// of the range which may contain matching logs, in increasing order: the
+// blocks returned by the log index for the part of the range it covers, and
+// every block of the rest of the range.
+func (f *Filter) forEachBlock(ctx context.Context, blockLimit int64, fn func(height uint64) error) error {
 	// Disallow pending logs.
 	if f.criteria.FromBlock.Int64() == rpc.PendingBlockNumber.Int64() || f.criteria.ToBlock.Int64() == rpc.PendingBlockNumber.Int64() {
-		return nil, errPendingLogsUnsupported
+		return errPendingLogsUnsupported
 	}
 
 	// Figure out the limits of the filter range
 	header, err := f.backend.HeaderByNumber(ctx, types.EthLatestBlockNumber)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch header by number (latest): %w", err)
+		return fmt.Errorf("failed to fetch header by number (latest): %w", err)
 	}
 
 	if header == nil || header.Number == nil {
 		f.logger.Debug("header not found or has no number")
-		return nil, nil
+		return nil
 	}
 
 	head := header.Number.Uint64()
@@ -152,21 +228,21 @@ func (f *Filter) Logs(ctx context.Context, logLimit int, blockLimit int64) (logs
 
 	from, err := resolveSpecial(f.criteria.FromBlock.Int64())
 	if err != nil {
-		return nil, err
+		return err
 	}
 	to, err := resolveSpecial(f.criteria.ToBlock.Int64())
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// check bounds
 	if from > to {
-		return nil, errInvalidBlockRange
+		return errInvalidBlockRange
 	}
 
 	// If the range starts beyond head, return empty (geth-compatible)
 	if from > head {
-		return []*ethtypes.Log{}, nil
+		return nil
 	}
 
 	// Clamp 'to' to head if it exceeds the current chain height
@@ -174,64 +250,119 @@ func (f *Filter) Logs(ctx context.Context, logLimit int, blockLimit int64) (logs
 		to = head
 	}
 
-	if blockLimit > 0 && to-from > uint64(blockLimit) {
-		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
+	// use the log index for the part of the range it covers, and scan the rest
+	indexed, scanFrom, err := f.indexedBlocks(from, to)
+	if err != nil {
+		return err
 	}
 
-	for height := from; height <= to; height++ {
-		h := int64(height) //#nosec G115
-		blockRes, err := f.backend.CometBlockResultByNumber(ctx, &h)
-		if err != nil {
-			f.logger.Debug("failed to fetch block result from CometBFT", "height", height, "error", err.Error())
-			return nil, fmt.Errorf("failed to fetch block result from CometBFT: %w", err)
-		}
+	if blockLimit > 0 && scanFrom <= to && to-scanFrom > uint64(blockLimit) {
+		return fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
+	}
 
-		bloom, err := f.backend.BlockBloomFromCometBlock(ctx, blockRes)
-		if err != nil {
-			return nil, fmt.Errorf("failed to query block bloom filter from block results: %w", err)
+	for _, height := range indexed {
+		if err := fn(uint64(height)); err != nil { //#nosec G115 -- height is positive
+			return err
 		}
-
-		// check logs limit before fetching more
-		if len(logs) >= logLimit {
-			return nil, fmt.Errorf("query returned more than %d results", logLimit)
+	}
+	for height := scanFrom; height <= to; height++ {
+		if err := fn(height); err != nil {
+			return err
 		}
+	}
+	return nil
+}
 
-		filtered, err := f.blockLogs(blockRes, bloom)
-		if err != nil {
-			return nil, fmt.Errorf("failed to fetch block by number %d: %w", height, err)
-		}
+// indexedBlocks returns the blocks of the range which contain matching logs
+// according to the log index, and the first block of the range which
+// isn't covered by the index and must be scanned. The whole range is scanned
+// if the log index is disabled or the filter has no address nor topic.
+func (f *Filter) indexedBlocks(from, to uint64) ([]int64, uint64, error) {
+	if !hasLogCriteria(f.criteria.Addresses, f.criteria.Topics) {
+		return nil, from, nil
+	}
+	idxer := f.backend.LogIndexer()
+	if idxer == nil {
+		return nil, from, nil
+	}
+	first, last, err := idxer.LogIndexedRangeAt(int64(from)) //#nosec G115 -- block numbers are unlikely to exceed int64
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch log index range: %w", err)
+	}
+	if first < 0 {
+		return nil, from, nil
+	}
 
-		// check logs limit
-		if len(logs)+len(filtered) > logLimit {
-			return nil, fmt.Errorf("query returned more than %d results", logLimit)
+	end := min(to, uint64(last)) //#nosec G115 -- checked for negative values already
+
+	blocks, err := idxer.GetLogBlocks(f.criteria.Addresses, f.criteria.Topics, int64(from), int64(end)) //#nosec G115 -- bounded by the index range
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query log index: %w", err)
+	}
+	return blocks, end + 1, nil
+}
+
+// hasLogCriteria returns true if the filter matches on an address or a topic.
+func hasLogCriteria(addresses []common.Address, topics [][]common.Hash) bool {
+	if len(addresses) > 0 {
+		return true
+	}
+	for _, sub := range topics {
+		if len(sub) > 0 {
+			return true
 		}
-		logs = append(logs, filtered...)
 	}
-	return logs, nil
+	return false
+}
+
+// syntheticLogsBackend is implemented by the backends adding synthetic logs to
+// the logs of the blocks, which aren't part of the block bloom filter.
+type syntheticLogsBackend interface {
+	SyntheticLogs(ctx context.Context, blockRes *cmtrpctypes.ResultBlockResults) ([][]*ethtypes.Log, error)
+	SyntheticLogsCanMatch(ctx context.Context, height int64, addresses []common.Address, topics [][]common.Hash) (bool, error)
 }
 
 // blockLogs returns the logs matching the filter criteria within a single block.
-func (f *Filter) blockLogs(blockRes *cmtrpctypes.ResultBlockResults, bloom ethtypes.Bloom) ([]*ethtypes.Log, error) {
-	if !bloomFilter(bloom, f.criteria.Addresses, f.criteria.Topics) {
-		return []*ethtypes.Log{}, nil
+func (f *Filter) blockLogs(ctx context.Context, blockRes *cmtrpctypes.ResultBlockResults, bloom ethtypes.Bloom) ([]*ethtypes.Log, error) {
+	unfiltered := make([]*ethtypes.Log, 0)
+	if bloomFilter(bloom, f.criteria.Addresses, f.criteria.Topics) {
+		logsList, err := backend.GetLogsFromBlockResults(blockRes)
+		if err != nil {
+			return []*ethtypes.Log{}, errors.Wrapf(err, "failed to fetch logs block number %d", blockRes.Height)
+		}
+		for _, logs := range logsList {
+			unfiltered = append(unfiltered, logs...)
+		}
 	}
 
-	logsList, err := backend.GetLogsFromBlockResults(blockRes)
-	if err != nil {
-		return []*ethtypes.Log{}, errors.Wrapf(err, "failed to fetch logs block number %d", blockRes.Height)
+	if sb, ok := f.backend.(syntheticLogsBackend); ok {
+		// the synthetic logs are only built if the filter can match them
+		canMatch, err := sb.SyntheticLogsCanMatch(ctx, blockRes.Height, f.criteria.Addresses, f.criteria.Topics)
+		if err != nil {
+			return []*ethtypes.Log{}, errors.Wrapf(err, "failed to fetch synthetic logs block number %d", blockRes.Height)
+		}
+		if !canMatch {
+			return f.filterBlockLogs(unfiltered), nil
+		}
+		synthetic, err := sb.SyntheticLogs(ctx, blockRes)
+		if err != nil {
+			return []*ethtypes.Log{}, errors.Wrapf(err, "failed to fetch synthetic logs block number %d", blockRes.Height)
+		}
+		for _, logs := range synthetic {
+			unfiltered = append(unfiltered, logs...)
+		}
 	}
 
-	unfiltered := make([]*ethtypes.Log, 0)
-	for _, logs := range logsList {
-		unfiltered = append(unfiltered, logs...)
-	}
+	return f.filterBlockLogs(unfiltered), nil
+}
 
+// filterBlockLogs returns the logs of a block matching the filter criteria.
+func (f *Filter) filterBlockLogs(unfiltered []*ethtypes.Log) []*ethtypes.Log {
 	logs := FilterLogs(unfiltered, nil, nil, f.criteria.Addresses, f.criteria.Topics)
 	if len(logs) == 0 {
-		return []*ethtypes.Log{}, nil
+		return []*ethtypes.Log{}
 	}
-
-	return logs, nil
+	return logs
 }
 
 func createBloomFilters(filters [][][]byte, logger log.Logger) [][]BloomIV {
@@ -17,6 +17,7 @@ import (
 
 	filtermocks "github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters/mocks"
 	rpctypes "github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
 
 	"cosmossdk.io/log"
 )
@@ -148,6 +149,107 @@ func TestFilter_Logs_FutureBlockRange(t *testing.T) {
 	require.Empty(t, logs)
 }
 
+// logIndexerStub is a log index covering a fixed range of blocks.
+type logIndexerStub struct {
+	servertypes.EVMTxIndexer
+
+	first, last int64
+	blocks      []int64
+}
+
+func (s *logIndexerStub) LogIndexedRange() (int64, int64, error) {
+	return s.first, s.last, nil
+}
+
+func (s *logIndexerStub) LogIndexedRangeAt(height int64) (int64, int64, error) {
+	if height < s.first || height > s.last {
+		return -1, -1, nil
+	}
+	return s.first, s.last, nil
+}
+
+func (s *logIndexerStub) GetLogBlocks(_ []common.Address, _ [][]common.Hash, from, to int64) ([]int64, error) {
+	var blocks []int64
+	for _, block := range s.blocks {
+		if block >= from && block <= to {
+			blocks = append(blocks, block)
+		}
+	}
+	return blocks, nil
+}
+
+func TestFilter_Logs_LogIndex(t *testing.T) {
+	logger := log.NewNopLogger()
+	address := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	idxer := &logIndexerStub{first: 1, last: 80, blocks: []int64{5, 20, 40}}
+
+	testCases := []struct {
+		name       string
+		from, to   int64
+		indexer    servertypes.EVMTxIndexer
+		expHeights []int64
+		expErr     string
+	}{
+		{
+			name:       "indexed blocks then scan above the index",
+			from:       10,
+			to:         100,
+			indexer:    idxer,
+			expHeights: []int64{20, 40, 81, 82, 83, 84, 85, 86, 87, 88, 89, 90, 91, 92, 93, 94, 95, 96, 97, 98, 99, 100},
+		},
+		{
+			name:       "range fully indexed",
+			from:       1,
+			to:         30,
+			indexer:    idxer,
+			expHeights: []int64{5, 20},
+		},
+		{
+			name:    "range starting above the index is scanned",
+			from:    85,
+			to:      100,
+			indexer: idxer,
+			expHeights: []int64{
+				85, 86, 87, 88, 89, 90, 91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
+			},
+		},
+		{
+			name:    "disabled log index is capped",
+			from:    10,
+			to:      100,
+			indexer: nil,
+			expErr:  "maximum [from, to] blocks distance: 50",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			backend := filtermocks.NewBackend(t)
+			backend.EXPECT().HeaderByNumber(mock.Anything, rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(100)}, nil)
+			backend.EXPECT().LogIndexer().Return(tc.indexer)
+
+			var heights []int64
+			backend.EXPECT().CometBlockResultByNumber(mock.Anything, mock.Anything).RunAndReturn(
+				func(_ context.Context, height *int64) (*cmtrpctypes.ResultBlockResults, error) {
+					heights = append(heights, *height)
+					return &cmtrpctypes.ResultBlockResults{Height: *height}, nil
+				},
+			).Maybe()
+			backend.EXPECT().BlockBloomFromCometBlock(mock.Anything, mock.Anything).Return(ethtypes.Bloom{}, nil).Maybe()
+
+			f := NewRangeFilter(logger, backend, tc.from, tc.to, []common.Address{address}, nil)
+			logs, err := f.Logs(context.Background(), 100, 50)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Empty(t, logs)
+			require.Equal(t, tc.expHeights, heights)
+		})
+	}
+}
+
 func TestFilter(t *testing.T) {
 	logger := log.NewNopLogger()
 	testCases := []struct {
@@ -0,0 +1,529 @@
+package ots
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtrace "github.com/cosmos/evm/trace"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+var tracer = otel.Tracer("evm/rpc/namespaces/ethereum/ots")
+
+// ErrAddressIndexDisabled is returned by the address history queries when the
+// address appearance index of the custom indexer is disabled.
+var ErrAddressIndexDisabled = errors.New("address indexes are disabled, enable json-rpc.enable-indexer and json-rpc.enable-address-index")
+
+// Backend defines the methods required by the ots API backend
+type Backend interface {
+	BlockNumber(ctx context.Context) (hexutil.Uint64, error)
+	GetBlockByNumber(ctx context.Context, blockNum rpctypes.BlockNumber, fullTx bool) (map[string]interface{}, error)
+	GetBlockByHash(ctx context.Context, hash common.Hash, fullTx bool) (map[string]interface{}, error)
+	GetBlockReceipts(ctx context.Context, blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error)
+	CometBlockByNumber(ctx context.Context, blockNum rpctypes.BlockNumber) (*coretypes.ResultBlock, error)
+	CometBlockResultByNumber(ctx context.Context, height *int64) (*coretypes.ResultBlockResults, error)
+	EthMsgsFromCometBlock(ctx context.Context, block *coretypes.ResultBlock, blockRes *coretypes.ResultBlockResults) []*evmtypes.MsgEthereumTx
+	GetCode(ctx context.Context, address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error)
+	GetTransactionCount(ctx context.Context, address common.Address, blockNum rpctypes.BlockNumber) (*hexutil.Uint64, error)
+	TraceTransaction(ctx context.Context, hash common.Hash, config *rpctypes.TraceConfig) (interface{}, error)
+	TraceBlock(ctx context.Context, height rpctypes.BlockNumber, config *rpctypes.TraceConfig, block *coretypes.ResultBlock) ([]*evmtypes.TxTraceResult, error)
+	AddressIndexer() servertypes.EVMTxIndexer
+}
+
+var callTracerConfig = &rpctypes.TraceConfig{
+	TraceConfig: evmtypes.TraceConfig{Tracer: "callTracer"},
+}
+
+// API is the collection of Otterscan APIs.
+type API struct {
+	ctx     *server.Context
+	logger  log.Logger
+	backend Backend
+}
+
+// NewAPI creates a new API definition for the Otterscan methods of the
+// Ethereum service.
+func NewAPI(ctx *server.Context, backend Backend) *API {
+	return &API{
+		ctx:     ctx,
+		logger:  ctx.Logger.With("module", "ots"),
+		backend: backend,
+	}
+}
+
+// GetApiLevel returns the Otterscan API level implemented by the node.
+func (a *API) GetApiLevel() uint64 { //nolint:revive,stylecheck // method name is part of the Otterscan API
+	a.logger.Debug("ots_getApiLevel")
+	return APILevel
+}
+
+// HasCode returns true if the address holds a contract at the given block.
+func (a *API) HasCode(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (_ bool, err error) {
+	a.logger.Debug("ots_hasCode", "address", address, "block number or hash", blockNrOrHash)
+	ctx, span := tracer.Start(context.Background(), "ots_hasCode", trace.WithAttributes(attribute.String("address", address.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	code, err := a.backend.GetCode(ctx, address, blockNrOrHash)
+	if err != nil {
+		return false, err
+	}
+	return len(code) > 0, nil
+}
+
+// GetInternalOperations returns the value transfers, contract creations and
+// self destructs performed by the given transaction below the top level call.
+func (a *API) GetInternalOperations(hash common.Hash) (_ []*InternalOperation, err error) {
+	a.logger.Debug("ots_getInternalOperations", "hash", hash)
+	ctx, span := tracer.Start(context.Background(), "ots_getInternalOperations", trace.WithAttributes(attribute.String("hash", hash.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	frame, err := a.traceCalls(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	return internalOperations(frame, []*InternalOperation{}), nil
+}
+
+// TraceTransaction returns the call frames of the given transaction in
+// depth-first order.
+func (a *API) TraceTransaction(hash common.Hash) (_ []*TraceEntry, err error) {
+	a.logger.Debug("ots_traceTransaction", "hash", hash)
+	ctx, span := tracer.Start(context.Background(), "ots_traceTransaction", trace.WithAttributes(attribute.String("hash", hash.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	frame, err := a.traceCalls(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	return traceEntries(frame, 0, nil), nil
+}
+
+// GetTransactionError returns the revert data of the given transaction, or
+// empty bytes if it succeeded.
+func (a *API) GetTransactionError(hash common.Hash) (_ hexutil.Bytes, err error) {
+	a.logger.Debug("ots_getTransactionError", "hash", hash)
+	ctx, span := tracer.Start(context.Background(), "ots_getTransactionError", trace.WithAttributes(attribute.String("hash", hash.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	frame, err := a.traceCalls(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	if frame.Error == "" || frame.Output == nil {
+		return hexutil.Bytes{}, nil
+	}
+	return frame.Output, nil
+}
+
+// GetBlockDetails returns the header of the given block together with its
+// transaction count, issuance and total fees.
+func (a *API) GetBlockDetails(blockNr rpctypes.BlockNumber) (_ map[string]interface{}, err error) {
+	a.logger.Debug("ots_getBlockDetails", "number", blockNr)
+	ctx, span := tracer.Start(context.Background(), "ots_getBlockDetails", trace.WithAttributes(attribute.Int64("height", blockNr.Int64())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	block, err := a.backend.GetBlockByNumber(ctx, blockNr, false)
+	if err != nil {
+		return nil, err
+	}
+	return a.blockDetails(ctx, block)
+}
+
+// GetBlockDetailsByHash returns the header of the given block together with
+// its transaction count, issuance and total fees.
+func (a *API) GetBlockDetailsByHash(hash common.Hash) (_ map[string]interface{}, err error) {
+	a.logger.Debug("ots_getBlockDetailsByHash", "hash", hash)
+	ctx, span := tracer.Start(context.Background(), "ots_getBlockDetailsByHash", trace.WithAttributes(attribute.String("hash", hash.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	block, err := a.backend.GetBlockByHash(ctx, hash, false)
+	if err != nil {
+		return nil, err
+	}
+	return a.blockDetails(ctx, block)
+}
+
+// GetBlockTransactions returns a page of the transactions of the given block
+// together with their receipts. Pages are numbered from the end of the block.
+// The input of the transactions is truncated to the method selector and the
+// logs are removed from the receipts.
+func (a *API) GetBlockTransactions(blockNr rpctypes.BlockNumber, pageNumber, pageSize uint64) (_ map[string]interface{}, err error) {
+	a.logger.Debug("ots_getBlockTransactions", "number", blockNr, "page", pageNumber, "size", pageSize)
+	ctx, span := tracer.Start(context.Background(), "ots_getBlockTransactions", trace.WithAttributes(attribute.Int64("height", blockNr.Int64())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	block, err := a.backend.GetBlockByNumber(ctx, blockNr, true)
+	if err != nil || block == nil {
+		return nil, err
+	}
+	txs, receipts, err := a.blockTransactions(ctx, block)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := pageBounds(len(txs), pageNumber, pageSize)
+	pageTxs := txs[start:end]
+	for _, tx := range pageTxs {
+		if len(tx.Input) > 4 {
+			tx.Input = tx.Input[:4]
+		}
+	}
+	pageReceipts := receipts[start:end]
+	for _, receipt := range pageReceipts {
+		receipt["logs"] = nil
+		receipt["logsBloom"] = nil
+	}
+
+	block["transactions"] = pageTxs
+	block["transactionCount"] = len(txs)
+	return map[string]interface{}{
+		"fullblock": block,
+		"receipts":  pageReceipts,
+	}, nil
+}
+
+// SearchTransactionsBefore returns the transactions in which the address
+// appears in the blocks before the given one, 0 meaning the latest block. The
+// transactions are returned from the newest to the oldest, at least pageSize
+// of them unless the history is exhausted.
+func (a *API) SearchTransactionsBefore(address common.Address, blockNum uint64, pageSize uint64) (_ *TransactionsWithReceipts, err error) {
+	a.logger.Debug("ots_searchTransactionsBefore", "address", address, "number", blockNum, "size", pageSize)
+	ctx, span := tracer.Start(context.Background(), "ots_searchTransactionsBefore", trace.WithAttributes(attribute.String("address", address.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	return a.searchTransactions(ctx, address, blockNum, pageSize, true)
+}
+
+// SearchTransactionsAfter returns the transactions in which the address
+// appears in the blocks after the given one, 0 meaning the genesis block. The
+// transactions are returned from the newest to the oldest, at least pageSize
+// of them unless the history is exhausted.
+func (a *API) SearchTransactionsAfter(address common.Address, blockNum uint64, pageSize uint64) (_ *TransactionsWithReceipts, err error) {
+	a.logger.Debug("ots_searchTransactionsAfter", "address", address, "number", blockNum, "size", pageSize)
+	ctx, span := tracer.Start(context.Background(), "ots_searchTransactionsAfter", trace.WithAttributes(attribute.String("address", address.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	return a.searchTransactions(ctx, address, blockNum, pageSize, false)
+}
+
+// GetTransactionBySenderAndNonce returns the hash of the transaction sent by
+// the address with the given nonce, or nil if not found. Without the address
+// index, the block is found by a binary search over the account nonce, which
+// requires the historical state.
+func (a *API) GetTransactionBySenderAndNonce(address common.Address, nonce uint64) (_ *common.Hash, err error) {
+	a.logger.Debug("ots_getTransactionBySenderAndNonce", "address", address, "nonce", nonce)
+	ctx, span := tracer.Start(context.Background(), "ots_getTransactionBySenderAndNonce", trace.WithAttributes(attribute.String("address", address.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	if idxer := a.backend.AddressIndexer(); idxer != nil {
+		return idxer.GetTxHashBySenderAndNonce(address, nonce)
+	}
+
+	latest, err := a.backend.BlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// the nonce is consumed in the first block with a greater account nonce
+	height, err := searchHeight(1, int64(latest), func(height int64) (bool, error) { //#nosec G115 -- block number fits int64
+		count, err := a.backend.GetTransactionCount(ctx, address, rpctypes.BlockNumber(height))
+		if err != nil {
+			return false, err
+		}
+		return uint64(*count) > nonce, nil
+	})
+	if err != nil || height < 0 {
+		return nil, err
+	}
+
+	msgs, _, err := a.ethMsgs(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	for _, msg := range msgs {
+		if msg.GetSender() == address && msg.AsTransaction().Nonce() == nonce {
+			hash := msg.Hash()
+			return &hash, nil
+		}
+	}
+	return nil, nil
+}
+
+// GetContractCreator returns the transaction and the address that created the
+// given contract, or nil if the address isn't a contract. The creation block
+// is found by a binary search over the contract code, which requires the
+// historical state.
+func (a *API) GetContractCreator(address common.Address) (_ *ContractCreator, err error) {
+	a.logger.Debug("ots_getContractCreator", "address", address)
+	ctx, span := tracer.Start(context.Background(), "ots_getContractCreator", trace.WithAttributes(attribute.String("address", address.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	latest, err := a.backend.BlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	hasCode := func(height int64) (bool, error) {
+		blockNr := rpctypes.BlockNumber(height)
+		code, err := a.backend.GetCode(ctx, address, rpctypes.BlockNumberOrHash{BlockNumber: &blockNr})
+		if err != nil {
+			return false, err
+		}
+		return len(code) > 0, nil
+	}
+	if ok, err := hasCode(int64(latest)); err != nil || !ok { //#nosec G115 -- block number fits int64
+		return nil, err
+	}
+	height, err := searchHeight(1, int64(latest), hasCode) //#nosec G115 -- block number fits int64
+	if err != nil || height < 0 {
+		return nil, err
+	}
+
+	msgs, block, err := a.ethMsgs(ctx, height)
+	if err != nil || len(msgs) == 0 {
+		return nil, err
+	}
+	res, err := a.backend.TraceBlock(ctx, rpctypes.BlockNumber(height), callTracerConfig, block)
+	if err != nil {
+		return nil, err
+	}
+	if len(res) != len(msgs) {
+		return nil, fmt.Errorf("expected %d transaction traces, got %d", len(msgs), len(res))
+	}
+	for i, r := range res {
+		if r.Error != "" {
+			return nil, fmt.Errorf("failed to trace transaction %s: %s", msgs[i].Hash().Hex(), r.Error)
+		}
+		var frame callFrame
+		if err := decodeResult(r.Result, &frame); err != nil {
+			return nil, err
+		}
+		if creator := findCreator(&frame, address); creator != nil {
+			return &ContractCreator{Tx: msgs[i].Hash(), Creator: *creator}, nil
+		}
+	}
+	return nil, nil
+}
+
+// traceCalls returns the call frames of the given transaction.
+func (a *API) traceCalls(ctx context.Context, hash common.Hash) (*callFrame, error) {
+	res, err := a.backend.TraceTransaction(ctx, hash, callTracerConfig)
+	if err != nil {
+		return nil, err
+	}
+	var frame callFrame
+	if err := decodeResult(res, &frame); err != nil {
+		return nil, err
+	}
+	return &frame, nil
+}
+
+// ethMsgs returns the Ethereum transactions of the block at the given height.
+func (a *API) ethMsgs(ctx context.Context, height int64) ([]*evmtypes.MsgEthereumTx, *coretypes.ResultBlock, error) {
+	block, err := a.backend.CometBlockByNumber(ctx, rpctypes.BlockNumber(height))
+	if err != nil {
+		return nil, nil, err
+	}
+	if block == nil || block.Block == nil {
+		return nil, nil, fmt.Errorf("block %d not found", height)
+	}
+	blockRes, err := a.backend.CometBlockResultByNumber(ctx, &block.Block.Height)
+	if err != nil {
+		return nil, nil, fmt.Errorf("block result not found for height %d", height)
+	}
+	return a.backend.EthMsgsFromCometBlock(ctx, block, blockRes), block, nil
+}
+
+// blockDetails returns the details of the given block, nil if not found.
+func (a *API) blockDetails(ctx context.Context, block map[string]interface{}) (map[string]interface{}, error) {
+	if block == nil {
+		return nil, nil
+	}
+	blockNr, err := blockNumber(block)
+	if err != nil {
+		return nil, err
+	}
+	receipts, err := a.backend.GetBlockReceipts(ctx, rpctypes.BlockNumberOrHash{BlockNumber: &blockNr})
+	if err != nil {
+		return nil, err
+	}
+
+	totalFees := new(big.Int)
+	for _, receipt := range receipts {
+		fee, err := receiptFee(receipt)
+		if err != nil {
+			return nil, err
+		}
+		totalFees.Add(totalFees, fee)
+	}
+
+	txs, _ := block["transactions"].([]interface{})
+	delete(block, "transactions")
+	block["transactionCount"] = len(txs)
+	block["logsBloom"] = nil
+	return map[string]interface{}{
+		"block":     block,
+		"issuance":  Issuance{},
+		"totalFees": (*hexutil.Big)(totalFees),
+	}, nil
+}
+
+// blockTransactions returns the full transactions of the block together with
+// their receipts.
+func (a *API) blockTransactions(ctx context.Context, block map[string]interface{}) ([]*rpctypes.RPCTransaction, []map[string]interface{}, error) {
+	blockNr, err := blockNumber(block)
+	if err != nil {
+		return nil, nil, err
+	}
+	receipts, err := a.backend.GetBlockReceipts(ctx, rpctypes.BlockNumberOrHash{BlockNumber: &blockNr})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rawTxs, _ := block["transactions"].([]interface{})
+	if len(rawTxs) != len(receipts) {
+		return nil, nil, fmt.Errorf("expected %d receipts in block %d, got %d", len(rawTxs), blockNr, len(receipts))
+	}
+	txs := make([]*rpctypes.RPCTransaction, len(rawTxs))
+	for i, rawTx := range rawTxs {
+		tx, ok := rawTx.(*rpctypes.RPCTransaction)
+		if !ok || tx == nil {
+			return nil, nil, fmt.Errorf("invalid transaction type %T in block %d", rawTx, blockNr)
+		}
+		txs[i] = tx
+	}
+	return txs, receipts, nil
+}
+
+// searchTransactions returns a page of the transaction history of the address
+// using the address index.
+func (a *API) searchTransactions(ctx context.Context, address common.Address, blockNum, pageSize uint64, before bool) (*TransactionsWithReceipts, error) {
+	idxer := a.backend.AddressIndexer()
+	if idxer == nil {
+		return nil, ErrAddressIndexDisabled
+	}
+	if pageSize == 0 || pageSize > math.MaxInt32 {
+		return nil, fmt.Errorf("invalid page size %d", pageSize)
+	}
+
+	results, exhausted, err := searchAddress(idxer, address, blockNum, int(pageSize), before)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &TransactionsWithReceipts{
+		Txs:      []*rpctypes.RPCTransaction{},
+		Receipts: []map[string]interface{}{},
+	}
+	for i := 0; i < len(results); {
+		height := results[i].Result.Height
+		block, err := a.backend.GetBlockByNumber(ctx, rpctypes.BlockNumber(height), true)
+		if err != nil {
+			return nil, err
+		}
+		if block == nil {
+			return nil, fmt.Errorf("block %d not found", height)
+		}
+		txs, receipts, err := a.blockTransactions(ctx, block)
+		if err != nil {
+			return nil, err
+		}
+		for ; i < len(results) && results[i].Result.Height == height; i++ {
+			index := int(results[i].Result.EthTxIndex)
+			if index < 0 || index >= len(txs) {
+				return nil, fmt.Errorf("tx index %d out of range in block %d", index, height)
+			}
+			receipts[index]["timestamp"] = block["timestamp"]
+			page.Txs = append(page.Txs, txs[index])
+			page.Receipts = append(page.Receipts, receipts[index])
+		}
+	}
+
+	if before {
+		page.FirstPage = blockNum == 0
+		page.LastPage = exhausted
+	} else {
+		// the results are always returned from the newest to the oldest
+		for i, j := 0, len(page.Txs)-1; i < j; i, j = i+1, j-1 {
+			page.Txs[i], page.Txs[j] = page.Txs[j], page.Txs[i]
+			page.Receipts[i], page.Receipts[j] = page.Receipts[j], page.Receipts[i]
+		}
+		page.FirstPage = exhausted
+		page.LastPage = blockNum == 0
+	}
+	return page, nil
+}
+
+// searchAddress returns at least limit txs in which the address appears in the
+// blocks before or after the given one, unless the history is exhausted. The
+// txs of a block are never split across pages, as the pages are delimited by
+// block numbers.
+func searchAddress(idxer servertypes.EVMTxIndexer, address common.Address, blockNum uint64, limit int, before bool) ([]*servertypes.AddressTxResult, bool, error) {
+	start := servertypes.TxPosition{Height: int64(blockNum) + 1} //#nosec G115 -- block number fits int64
+	if before {
+		start = servertypes.TxPosition{Height: int64(blockNum) - 1, EthTxIndex: math.MaxInt32} //#nosec G115 -- block number fits int64
+		if blockNum == 0 {
+			start.Height = math.MaxInt64
+		}
+	}
+
+	results, next, err := idxer.GetByAddress(address, servertypes.AddressRoleAll, start, before, limit)
+	if err != nil || len(results) == 0 {
+		return results, next == nil, err
+	}
+
+	// complete the last block of the page
+	lastHeight := results[len(results)-1].Result.Height
+	for next != nil && next.Height == lastHeight {
+		var more []*servertypes.AddressTxResult
+		more, next, err = idxer.GetByAddress(address, servertypes.AddressRoleAll, *next, before, limit)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, res := range more {
+			if res.Result.Height != lastHeight {
+				next = &servertypes.TxPosition{Height: res.Result.Height, EthTxIndex: res.Result.EthTxIndex}
+				break
+			}
+			results = append(results, res)
+		}
+	}
+	return results, next == nil, nil
+}
+
+// blockNumber returns the number of the given RPC block.
+func blockNumber(block map[string]interface{}) (rpctypes.BlockNumber, error) {
+	number, ok := block["number"].(*hexutil.Big)
+	if !ok || number == nil {
+		return 0, fmt.Errorf("invalid block number type: %T", block["number"])
+	}
+	return rpctypes.BlockNumber(number.ToInt().Int64()), nil
+}
+
+// receiptFee returns the fee paid by the transaction of the given RPC receipt.
+func receiptFee(receipt map[string]interface{}) (*big.Int, error) {
+	gasUsed, ok := receipt["gasUsed"].(hexutil.Uint64)
+	if !ok {
+		return nil, fmt.Errorf("invalid gas used type: %T", receipt["gasUsed"])
+	}
+	gasPrice, ok := receipt["effectiveGasPrice"].(*hexutil.Big)
+	if !ok || gasPrice == nil {
+		return nil, fmt.Errorf("invalid effective gas price type: %T", receipt["effectiveGasPrice"])
+	}
+	return new(big.Int).Mul(new(big.Int).SetUint64(uint64(gasUsed)), gasPrice.ToInt()), nil
+}
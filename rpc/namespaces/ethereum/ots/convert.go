@@ -0,0 +1,158 @@
+package ots
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// callFrame is the output of the callTracer.
+type callFrame struct {
+	Type    string          `json:"type"`
+	From    common.Address  `json:"from"`
+	To      *common.Address `json:"to,omitempty"`
+	Value   *hexutil.Big    `json:"value,omitempty"`
+	Gas     hexutil.Uint64  `json:"gas"`
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	Input   hexutil.Bytes   `json:"input"`
+	Output  hexutil.Bytes   `json:"output,omitempty"`
+	Error   string          `json:"error,omitempty"`
+	Calls   []callFrame     `json:"calls,omitempty"`
+}
+
+// decodeResult converts the generic JSON decoded tracer result into the given
+// type.
+func decodeResult(result interface{}, v interface{}) error {
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bz, v)
+}
+
+// internalOperations returns the value transfers, contract creations and self
+// destructs of the frames below the given one. The operations of the reverted
+// frames are skipped.
+func internalOperations(frame *callFrame, ops []*InternalOperation) []*InternalOperation {
+	for i := range frame.Calls {
+		call := &frame.Calls[i]
+		if call.Error != "" {
+			continue
+		}
+
+		opType := -1
+		switch vm.StringToOp(call.Type) {
+		case vm.CALL:
+			if call.Value != nil && call.Value.ToInt().Sign() > 0 {
+				opType = OpTransfer
+			}
+		case vm.CREATE:
+			opType = OpCreate
+		case vm.CREATE2:
+			opType = OpCreate2
+		case vm.SELFDESTRUCT:
+			opType = OpSelfDestruct
+		}
+		if opType >= 0 {
+			op := &InternalOperation{Type: opType, From: call.From, Value: frameValue(call)}
+			if call.To != nil {
+				op.To = *call.To
+			}
+			ops = append(ops, op)
+		}
+		ops = internalOperations(call, ops)
+	}
+	return ops
+}
+
+// traceEntries flattens the call frame and its children in depth-first order.
+func traceEntries(frame *callFrame, depth int, entries []*TraceEntry) []*TraceEntry {
+	entry := &TraceEntry{
+		Type:   frame.Type,
+		Depth:  depth,
+		From:   frame.From,
+		Input:  frame.Input,
+		Output: frame.Output,
+	}
+	if frame.To != nil {
+		entry.To = *frame.To
+	}
+	switch vm.StringToOp(frame.Type) {
+	case vm.STATICCALL, vm.DELEGATECALL:
+		// these frames don't transfer value
+	default:
+		entry.Value = frameValue(frame)
+	}
+
+	entries = append(entries, entry)
+	for i := range frame.Calls {
+		entries = traceEntries(&frame.Calls[i], depth+1, entries)
+	}
+	return entries
+}
+
+// findCreator returns the address that created the given contract in the
+// frame or its children, or nil if the contract isn't created there.
+func findCreator(frame *callFrame, contract common.Address) *common.Address {
+	switch vm.StringToOp(frame.Type) {
+	case vm.CREATE, vm.CREATE2:
+		if frame.Error == "" && frame.To != nil && *frame.To == contract {
+			return &frame.From
+		}
+	}
+	for i := range frame.Calls {
+		if creator := findCreator(&frame.Calls[i], contract); creator != nil {
+			return creator
+		}
+	}
+	return nil
+}
+
+// frameValue returns the value of the frame, defaulting to zero for the frames
+// that don't transfer value.
+func frameValue(frame *callFrame) *hexutil.Big {
+	if frame.Value == nil {
+		return (*hexutil.Big)(new(big.Int))
+	}
+	return frame.Value
+}
+
+// pageBounds returns the bounds of the given page of a list of total items.
+// Pages are numbered from the end of the list, so the first page holds the
+// last items.
+func pageBounds(total int, pageNumber, pageSize uint64) (int, int) {
+	end := uint64(total)
+	if skip := pageNumber * pageSize; pageSize != 0 && skip/pageSize == pageNumber && skip < end {
+		end -= skip
+	} else {
+		end = 0
+	}
+	start := uint64(0)
+	if end > pageSize {
+		start = end - pageSize
+	}
+	return int(start), int(end) //#nosec G115 -- bounded by total
+}
+
+// searchHeight returns the lowest height in [low, high] for which found
+// returns true, or -1 if there is none. found must be monotonic.
+func searchHeight(low, high int64, found func(int64) (bool, error)) (int64, error) {
+	result := int64(-1)
+	for low <= high {
+		mid := low + (high-low)/2
+		ok, err := found(mid)
+		if err != nil {
+			return -1, err
+		}
+		if ok {
+			result = mid
+			high = mid - 1
+		} else {
+			low = mid + 1
+		}
+	}
+	return result, nil
+}
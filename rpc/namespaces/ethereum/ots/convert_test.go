@@ -0,0 +1,144 @@
+package ots
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallFrameConversions(t *testing.T) {
+	var (
+		sender   = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		contract = common.HexToAddress("0x2000000000000000000000000000000000000002")
+		callee   = common.HexToAddress("0x3000000000000000000000000000000000000003")
+		created  = common.HexToAddress("0x4000000000000000000000000000000000000004")
+	)
+
+	raw := map[string]interface{}{
+		"type":    "CALL",
+		"from":    sender.Hex(),
+		"to":      contract.Hex(),
+		"value":   "0x10",
+		"gas":     "0x5208",
+		"gasUsed": "0x5000",
+		"input":   "0x01",
+		"output":  "0x02",
+		"calls": []interface{}{
+			map[string]interface{}{
+				"type":  "CALL",
+				"from":  contract.Hex(),
+				"to":    callee.Hex(),
+				"value": "0x5",
+				"gas":   "0x100",
+				"input": "0x",
+			},
+			map[string]interface{}{
+				"type":  "STATICCALL",
+				"from":  contract.Hex(),
+				"to":    callee.Hex(),
+				"gas":   "0x100",
+				"input": "0x",
+			},
+			map[string]interface{}{
+				"type":  "CALL",
+				"from":  contract.Hex(),
+				"to":    callee.Hex(),
+				"value": "0x7",
+				"gas":   "0x100",
+				"input": "0x",
+				"error": "execution reverted",
+			},
+			map[string]interface{}{
+				"type":   "CREATE2",
+				"from":   contract.Hex(),
+				"to":     created.Hex(),
+				"value":  "0x0",
+				"gas":    "0x200",
+				"input":  "0x6000",
+				"output": "0x00",
+				"calls": []interface{}{
+					map[string]interface{}{
+						"type":  "SELFDESTRUCT",
+						"from":  created.Hex(),
+						"to":    sender.Hex(),
+						"value": "0x1",
+						"gas":   "0x0",
+						"input": "0x",
+					},
+				},
+			},
+		},
+	}
+
+	var frame callFrame
+	require.NoError(t, decodeResult(raw, &frame))
+
+	ops := internalOperations(&frame, []*InternalOperation{})
+	expOps := []InternalOperation{
+		{Type: OpTransfer, From: contract, To: callee, Value: (*hexutil.Big)(big.NewInt(5))},
+		{Type: OpCreate2, From: contract, To: created, Value: (*hexutil.Big)(big.NewInt(0))},
+		{Type: OpSelfDestruct, From: created, To: sender, Value: (*hexutil.Big)(big.NewInt(1))},
+	}
+	require.Len(t, ops, len(expOps))
+	for i, op := range ops {
+		require.Equal(t, expOps[i].Type, op.Type)
+		require.Equal(t, expOps[i].From, op.From)
+		require.Equal(t, expOps[i].To, op.To)
+		require.Zero(t, expOps[i].Value.ToInt().Cmp(op.Value.ToInt()))
+	}
+
+	entries := traceEntries(&frame, 0, nil)
+	require.Len(t, entries, 6)
+	require.Equal(t, 0, entries[0].Depth)
+	require.Equal(t, big.NewInt(16), entries[0].Value.ToInt())
+	require.Equal(t, "STATICCALL", entries[2].Type)
+	require.Nil(t, entries[2].Value)
+	require.Equal(t, 2, entries[5].Depth)
+	require.Equal(t, "SELFDESTRUCT", entries[5].Type)
+
+	creator := findCreator(&frame, created)
+	require.NotNil(t, creator)
+	require.Equal(t, contract, *creator)
+	require.Nil(t, findCreator(&frame, callee))
+}
+
+func TestPageBounds(t *testing.T) {
+	testCases := []struct {
+		name             string
+		total            int
+		pageNumber, size uint64
+		expStart, expEnd int
+	}{
+		{"first page", 10, 0, 4, 6, 10},
+		{"second page", 10, 1, 4, 2, 6},
+		{"last partial page", 10, 2, 4, 0, 2},
+		{"out of range", 10, 3, 4, 0, 0},
+		{"overflow", 10, 1 << 62, 8, 0, 0},
+		{"zero size", 10, 0, 0, 0, 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			start, end := pageBounds(tc.total, tc.pageNumber, tc.size)
+			require.Equal(t, tc.expStart, start)
+			require.Equal(t, tc.expEnd, end)
+		})
+	}
+}
+
+func TestSearchHeight(t *testing.T) {
+	calls := 0
+	height, err := searchHeight(1, 1000, func(h int64) (bool, error) {
+		calls++
+		return h >= 421, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(421), height)
+	require.LessOrEqual(t, calls, 11)
+
+	height, err = searchHeight(1, 1000, func(int64) (bool, error) { return false, nil })
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), height)
+}
@@ -0,0 +1,63 @@
+package ots
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+)
+
+// APILevel is the Otterscan API level implemented by the namespace.
+const APILevel = 8
+
+// Types of the internal operations returned by ots_getInternalOperations.
+const (
+	OpTransfer     = 0
+	OpSelfDestruct = 1
+	OpCreate       = 2
+	OpCreate2      = 3
+)
+
+// InternalOperation is a value transfer, contract creation or self destruct
+// performed by a transaction below the top level call.
+type InternalOperation struct {
+	Type  int            `json:"type"`
+	From  common.Address `json:"from"`
+	To    common.Address `json:"to"`
+	Value *hexutil.Big   `json:"value"`
+}
+
+// TraceEntry is a call frame of a transaction as returned by
+// ots_traceTransaction.
+type TraceEntry struct {
+	Type   string         `json:"type"`
+	Depth  int            `json:"depth"`
+	From   common.Address `json:"from"`
+	To     common.Address `json:"to"`
+	Value  *hexutil.Big   `json:"value"`
+	Input  hexutil.Bytes  `json:"input"`
+	Output hexutil.Bytes  `json:"output"`
+}
+
+// ContractCreator is the transaction and address that created a contract.
+type ContractCreator struct {
+	Tx      common.Hash    `json:"hash"`
+	Creator common.Address `json:"creator"`
+}
+
+// TransactionsWithReceipts is a page of the transaction history of an
+// address.
+type TransactionsWithReceipts struct {
+	Txs       []*rpctypes.RPCTransaction `json:"txs"`
+	Receipts  []map[string]interface{}   `json:"receipts"`
+	FirstPage bool                       `json:"firstPage"`
+	LastPage  bool                       `json:"lastPage"`
+}
+
+// Issuance is the amount of tokens minted by a block. Block rewards are
+// distributed by the Cosmos SDK modules, so they are always zero.
+type Issuance struct {
+	BlockReward hexutil.Big `json:"blockReward"`
+	UncleReward hexutil.Big `json:"uncleReward"`
+	Issuance    hexutil.Big `json:"issuance"`
+}
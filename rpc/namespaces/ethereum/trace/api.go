@@ -0,0 +1,413 @@
+package trace
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtrace "github.com/cosmos/evm/trace"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+var tracer = otel.Tracer("evm/rpc/namespaces/ethereum/trace")
+
+// Backend defines the methods required by the trace API backend
+type Backend interface {
+	BlockNumber(ctx context.Context) (hexutil.Uint64, error)
+	BlockNumberFromComet(ctx context.Context, blockNrOrHash rpctypes.BlockNumberOrHash) (rpctypes.BlockNumber, error)
+	CometBlockByNumber(ctx context.Context, blockNum rpctypes.BlockNumber) (*coretypes.ResultBlock, error)
+	CometBlockResultByNumber(ctx context.Context, height *int64) (*coretypes.ResultBlockResults, error)
+	EthMsgsFromCometBlock(ctx context.Context, block *coretypes.ResultBlock, blockRes *coretypes.ResultBlockResults) []*evmtypes.MsgEthereumTx
+	GetTxByEthHash(ctx context.Context, txHash common.Hash) (*servertypes.TxResult, error)
+	GetCode(ctx context.Context, address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error)
+	TraceTransaction(ctx context.Context, hash common.Hash, config *rpctypes.TraceConfig) (interface{}, error)
+	TraceBlock(ctx context.Context, height rpctypes.BlockNumber, config *rpctypes.TraceConfig, block *coretypes.ResultBlock) ([]*evmtypes.TxTraceResult, error)
+
+	RPCBlockRangeCap() int32
+}
+
+var (
+	callTracerConfig = &rpctypes.TraceConfig{
+		TraceConfig: evmtypes.TraceConfig{Tracer: "callTracer"},
+	}
+	stateDiffTracerConfig = &rpctypes.TraceConfig{
+		TraceConfig:  evmtypes.TraceConfig{Tracer: "prestateTracer"},
+		TracerConfig: json.RawMessage(`{"diffMode":true}`),
+	}
+	vmTraceTracerConfig = &rpctypes.TraceConfig{
+		TraceConfig: evmtypes.TraceConfig{EnableMemory: true, DisableStorage: true},
+	}
+)
+
+// API is the collection of OpenEthereum compatible tracing APIs.
+type API struct {
+	ctx     *server.Context
+	logger  log.Logger
+	backend Backend
+}
+
+// NewAPI creates a new API definition for the trace methods of the Ethereum
+// service.
+func NewAPI(ctx *server.Context, backend Backend) *API {
+	return &API{
+		ctx:     ctx,
+		logger:  ctx.Logger.With("module", "trace"),
+		backend: backend,
+	}
+}
+
+// tracedBlock is a block together with its Ethereum transactions.
+type tracedBlock struct {
+	block  *coretypes.ResultBlock
+	hash   common.Hash
+	number uint64
+	msgs   []*evmtypes.MsgEthereumTx
+}
+
+// Block returns the flat traces of all the transactions of the given block.
+func (a *API) Block(blockNr rpctypes.BlockNumber) (_ []*Trace, err error) {
+	a.logger.Debug("trace_block", "number", blockNr)
+	ctx, span := tracer.Start(context.Background(), "trace_block", trace.WithAttributes(attribute.Int64("height", blockNr.Int64())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	block, err := a.getBlock(ctx, blockNr)
+	if err != nil {
+		return nil, err
+	}
+	return a.blockTraces(ctx, block)
+}
+
+// Transaction returns the flat traces of the given transaction.
+func (a *API) Transaction(hash common.Hash) (_ []*Trace, err error) {
+	a.logger.Debug("trace_transaction", "hash", hash)
+	ctx, span := tracer.Start(context.Background(), "trace_transaction", trace.WithAttributes(attribute.String("hash", hash.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	txResult, err := a.backend.GetTxByEthHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	block, err := a.backend.CometBlockByNumber(ctx, rpctypes.BlockNumber(txResult.Height))
+	if err != nil {
+		return nil, err
+	}
+	if block == nil || block.Block == nil {
+		return nil, fmt.Errorf("block %d not found", txResult.Height)
+	}
+
+	res, err := a.backend.TraceTransaction(ctx, hash, callTracerConfig)
+	if err != nil {
+		return nil, err
+	}
+	var frame callFrame
+	if err := decodeResult(res, &frame); err != nil {
+		return nil, err
+	}
+
+	blockHash := common.BytesToHash(block.BlockID.Hash)
+	blockNumber := uint64(txResult.Height)  //#nosec G115 -- height is positive
+	position := uint64(txResult.EthTxIndex) //#nosec G115 -- index is positive
+	traces := flattenCallFrame(&frame, []int{}, nil)
+	for _, t := range traces {
+		t.BlockHash = &blockHash
+		t.BlockNumber = &blockNumber
+		t.TransactionHash = &hash
+		t.TransactionPosition = &position
+	}
+	return traces, nil
+}
+
+// Filter returns the flat traces of the given block range matching the sender
+// and recipient filters.
+func (a *API) Filter(args FilterArgs) (_ []*Trace, err error) {
+	a.logger.Debug("trace_filter", "args", args)
+	ctx, span := tracer.Start(context.Background(), "trace_filter")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	latest, err := a.backend.BlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	from, to := int64(latest), int64(latest) //#nosec G115 -- block number fits int64
+	if args.FromBlock != nil && *args.FromBlock >= 0 {
+		from = args.FromBlock.Int64()
+	}
+	if args.ToBlock != nil && *args.ToBlock >= 0 {
+		to = args.ToBlock.Int64()
+	}
+	if from > to {
+		return nil, fmt.Errorf("invalid block range: fromBlock %d is greater than toBlock %d", from, to)
+	}
+	if blockRangeCap := int64(a.backend.RPCBlockRangeCap()); blockRangeCap > 0 && to-from+1 > blockRangeCap {
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockRangeCap)
+	}
+
+	fromAddresses := addressSet(args.FromAddress)
+	toAddresses := addressSet(args.ToAddress)
+
+	var (
+		traces  = []*Trace{}
+		skipped uint64
+	)
+	for height := from; height <= to; height++ {
+		block, err := a.getBlock(ctx, rpctypes.BlockNumber(height))
+		if err != nil {
+			return nil, err
+		}
+		blockTraces, err := a.blockTraces(ctx, block)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range blockTraces {
+			if !matchTrace(t, fromAddresses, toAddresses) {
+				continue
+			}
+			if args.After != nil && skipped < *args.After {
+				skipped++
+				continue
+			}
+			traces = append(traces, t)
+			if args.Count != nil && uint64(len(traces)) >= *args.Count {
+				return traces, nil
+			}
+		}
+	}
+	return traces, nil
+}
+
+// ReplayBlockTransactions replays all the transactions of the given block and
+// returns the requested trace types of every transaction: "trace",
+// "stateDiff" and/or "vmTrace".
+func (a *API) ReplayBlockTransactions(blockNrOrHash rpctypes.BlockNumberOrHash, traceTypes []string) (_ []*TraceResults, err error) {
+	a.logger.Debug("trace_replayBlockTransactions", "block number or hash", blockNrOrHash, "types", traceTypes)
+	ctx, span := tracer.Start(context.Background(), "trace_replayBlockTransactions")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	var withTrace, withStateDiff, withVMTrace bool
+	for _, traceType := range traceTypes {
+		switch traceType {
+		case TraceTypeTrace:
+			withTrace = true
+		case TraceTypeStateDiff:
+			withStateDiff = true
+		case TraceTypeVMTrace:
+			withVMTrace = true
+		default:
+			return nil, fmt.Errorf("invalid trace type %q", traceType)
+		}
+	}
+
+	blockNr, err := a.backend.BlockNumberFromComet(ctx, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	block, err := a.getBlock(ctx, blockNr)
+	if err != nil {
+		return nil, err
+	}
+
+	// the call traces are always needed for the transactions output
+	frames, err := a.traceBlock(ctx, block, callTracerConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*TraceResults, len(block.msgs))
+	for i, msg := range block.msgs {
+		var frame callFrame
+		if err := decodeResult(frames[i], &frame); err != nil {
+			return nil, err
+		}
+		results[i] = &TraceResults{
+			Output:          frame.Output,
+			TransactionHash: msg.Hash(),
+		}
+		if results[i].Output == nil {
+			results[i].Output = hexutil.Bytes{}
+		}
+		if withTrace {
+			results[i].Trace = flattenCallFrame(&frame, []int{}, nil)
+		}
+	}
+
+	if withStateDiff {
+		diffs, err := a.traceBlock(ctx, block, stateDiffTracerConfig)
+		if err != nil {
+			return nil, err
+		}
+		for i := range results {
+			var diff prestateDiff
+			if err := decodeResult(diffs[i], &diff); err != nil {
+				return nil, err
+			}
+			results[i].StateDiff = stateDiffFromPrestate(&diff)
+		}
+	}
+
+	if withVMTrace {
+		logs, err := a.traceBlock(ctx, block, vmTraceTracerConfig)
+		if err != nil {
+			return nil, err
+		}
+		codeAt := a.codeReader(ctx, block)
+		for i, msg := range block.msgs {
+			var res structLoggerResult
+			if err := decodeResult(logs[i], &res); err != nil {
+				return nil, err
+			}
+			tx := msg.AsTransaction()
+			code := tx.Data()
+			if tx.To() != nil {
+				code = codeAt(*tx.To())
+			}
+			builder := &vmTraceBuilder{logs: res.StructLogs, codeAt: codeAt}
+			results[i].VMTrace = builder.build(code)
+		}
+	}
+
+	return results, nil
+}
+
+// getBlock returns the block at the given height together with its Ethereum
+// transactions.
+func (a *API) getBlock(ctx context.Context, blockNr rpctypes.BlockNumber) (*tracedBlock, error) {
+	if blockNr == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+	block, err := a.backend.CometBlockByNumber(ctx, blockNr)
+	if err != nil {
+		return nil, err
+	}
+	if block == nil || block.Block == nil {
+		return nil, fmt.Errorf("block %d not found", blockNr)
+	}
+	blockRes, err := a.backend.CometBlockResultByNumber(ctx, &block.Block.Height)
+	if err != nil {
+		return nil, fmt.Errorf("block result not found for height %d", block.Block.Height)
+	}
+	return &tracedBlock{
+		block:  block,
+		hash:   common.BytesToHash(block.BlockID.Hash),
+		number: uint64(block.Block.Height), //#nosec G115 -- height is positive
+		msgs:   a.backend.EthMsgsFromCometBlock(ctx, block, blockRes),
+	}, nil
+}
+
+// traceBlock traces all the transactions of the block with the given config
+// and returns the raw result of every transaction.
+func (a *API) traceBlock(ctx context.Context, block *tracedBlock, config *rpctypes.TraceConfig) ([]interface{}, error) {
+	if len(block.msgs) == 0 {
+		return nil, nil
+	}
+	res, err := a.backend.TraceBlock(ctx, rpctypes.BlockNumber(block.block.Block.Height), config, block.block)
+	if err != nil {
+		return nil, err
+	}
+	if len(res) != len(block.msgs) {
+		return nil, fmt.Errorf("expected %d transaction traces, got %d", len(block.msgs), len(res))
+	}
+	results := make([]interface{}, len(res))
+	for i, r := range res {
+		if r.Error != "" {
+			return nil, fmt.Errorf("failed to trace transaction %s: %s", block.msgs[i].Hash().Hex(), r.Error)
+		}
+		results[i] = r.Result
+	}
+	return results, nil
+}
+
+// blockTraces returns the flat traces of all the transactions of the block.
+func (a *API) blockTraces(ctx context.Context, block *tracedBlock) ([]*Trace, error) {
+	frames, err := a.traceBlock(ctx, block, callTracerConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	traces := []*Trace{}
+	for i, msg := range block.msgs {
+		var frame callFrame
+		if err := decodeResult(frames[i], &frame); err != nil {
+			return nil, err
+		}
+		txHash := msg.Hash()
+		position := uint64(i)
+		for _, t := range flattenCallFrame(&frame, []int{}, nil) {
+			t.BlockHash = &block.hash
+			t.BlockNumber = &block.number
+			t.TransactionHash = &txHash
+			t.TransactionPosition = &position
+			traces = append(traces, t)
+		}
+	}
+	return traces, nil
+}
+
+// codeReader returns a function reading the code of the contracts from the
+// state of the parent block. The results are cached.
+func (a *API) codeReader(ctx context.Context, block *tracedBlock) func(common.Address) []byte {
+	parent := rpctypes.BlockNumber(block.block.Block.Height - 1)
+	cache := make(map[common.Address][]byte)
+	return func(addr common.Address) []byte {
+		if code, ok := cache[addr]; ok {
+			return code
+		}
+		code, err := a.backend.GetCode(ctx, addr, rpctypes.BlockNumberOrHash{BlockNumber: &parent})
+		if err != nil {
+			a.logger.Debug("failed to get code", "address", addr.Hex(), "error", err.Error())
+		}
+		cache[addr] = code
+		return code
+	}
+}
+
+// matchTrace returns true if the trace sender and recipient are included in
+// the given sets. An empty set matches any address.
+func matchTrace(t *Trace, fromAddresses, toAddresses map[common.Address]struct{}) bool {
+	var from, to *common.Address
+	switch t.Type {
+	case TypeSuicide:
+		from, to = t.Action.Address, t.Action.RefundAddress
+	case TypeCreate:
+		from = t.Action.From
+		if t.Result != nil {
+			to = t.Result.Address
+		}
+	default:
+		from, to = t.Action.From, t.Action.To
+	}
+	return matchAddress(from, fromAddresses) && matchAddress(to, toAddresses)
+}
+
+func matchAddress(addr *common.Address, set map[common.Address]struct{}) bool {
+	if len(set) == 0 {
+		return true
+	}
+	if addr == nil {
+		return false
+	}
+	_, ok := set[*addr]
+	return ok
+}
+
+func addressSet(addresses []common.Address) map[common.Address]struct{} {
+	set := make(map[common.Address]struct{}, len(addresses))
+	for _, addr := range addresses {
+		set[addr] = struct{}{}
+	}
+	return set
+}
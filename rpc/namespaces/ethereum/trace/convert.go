@@ -0,0 +1,230 @@
+package trace
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// callFrame is the output of the callTracer.
+type callFrame struct {
+	Type    string          `json:"type"`
+	From    common.Address  `json:"from"`
+	To      *common.Address `json:"to,omitempty"`
+	Value   *hexutil.Big    `json:"value,omitempty"`
+	Gas     hexutil.Uint64  `json:"gas"`
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	Input   hexutil.Bytes   `json:"input"`
+	Output  hexutil.Bytes   `json:"output,omitempty"`
+	Error   string          `json:"error,omitempty"`
+	Calls   []callFrame     `json:"calls,omitempty"`
+}
+
+// prestateAccount is an account of the prestateTracer output.
+type prestateAccount struct {
+	Balance *hexutil.Big                `json:"balance,omitempty"`
+	Code    hexutil.Bytes               `json:"code,omitempty"`
+	Nonce   uint64                      `json:"nonce,omitempty"`
+	Storage map[common.Hash]common.Hash `json:"storage,omitempty"`
+}
+
+// prestateDiff is the output of the prestateTracer in diff mode.
+type prestateDiff struct {
+	Pre  map[common.Address]*prestateAccount `json:"pre"`
+	Post map[common.Address]*prestateAccount `json:"post"`
+}
+
+// decodeResult converts the generic JSON decoded tracer result into the given
+// type.
+func decodeResult(result interface{}, v interface{}) error {
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bz, v)
+}
+
+// flattenCallFrame converts a callTracer frame and its children into the flat
+// trace format, in depth-first order.
+func flattenCallFrame(frame *callFrame, traceAddress []int, traces []*Trace) []*Trace {
+	trace := &Trace{
+		Subtraces:    len(frame.Calls),
+		TraceAddress: traceAddress,
+	}
+
+	gas := frame.Gas
+	gasUsed := frame.GasUsed
+	switch op := vm.StringToOp(frame.Type); op {
+	case vm.CREATE, vm.CREATE2:
+		init := frame.Input
+		trace.Type = TypeCreate
+		trace.Action = Action{
+			From:           &frame.From,
+			Gas:            &gas,
+			Init:           &init,
+			Value:          frameValue(frame),
+			CreationMethod: strings.ToLower(op.String()),
+		}
+		if frame.Error == "" {
+			code := frame.Output
+			trace.Result = &Result{GasUsed: &gasUsed, Code: &code, Address: frame.To}
+		}
+	case vm.SELFDESTRUCT:
+		from := frame.From
+		trace.Type = TypeSuicide
+		trace.Action = Action{
+			Address:       &from,
+			RefundAddress: frame.To,
+			Balance:       frameValue(frame),
+		}
+	default:
+		input := frame.Input
+		trace.Type = TypeCall
+		trace.Action = Action{
+			CallType: strings.ToLower(frame.Type),
+			From:     &frame.From,
+			To:       frame.To,
+			Gas:      &gas,
+			Input:    &input,
+			Value:    frameValue(frame),
+		}
+		if frame.Error == "" {
+			output := frame.Output
+			trace.Result = &Result{GasUsed: &gasUsed, Output: &output}
+		}
+	}
+	if frame.Error != "" {
+		trace.Error = traceError(frame.Error)
+	}
+
+	traces = append(traces, trace)
+	for i := range frame.Calls {
+		childAddress := make([]int, len(traceAddress)+1)
+		copy(childAddress, traceAddress)
+		childAddress[len(traceAddress)] = i
+		traces = flattenCallFrame(&frame.Calls[i], childAddress, traces)
+	}
+	return traces
+}
+
+// frameValue returns the value of the frame, defaulting to zero for the frames
+// that don't transfer value, e.g. STATICCALL.
+func frameValue(frame *callFrame) *hexutil.Big {
+	if frame.Value == nil {
+		return (*hexutil.Big)(new(big.Int))
+	}
+	return frame.Value
+}
+
+// traceError converts the EVM error messages to the ones used by
+// OpenEthereum.
+func traceError(err string) string {
+	switch err {
+	case vm.ErrExecutionReverted.Error():
+		return "Reverted"
+	case vm.ErrOutOfGas.Error():
+		return "Out of gas"
+	case vm.ErrInsufficientBalance.Error():
+		return "Insufficient balance for transfer"
+	case vm.ErrDepth.Error():
+		return "Out of stack"
+	default:
+		if strings.HasPrefix(err, "invalid opcode") {
+			return "Bad instruction"
+		}
+		if strings.HasPrefix(err, "invalid jump destination") {
+			return "Bad jump destination"
+		}
+		return err
+	}
+}
+
+// stateDiffFromPrestate converts the prestateTracer diff mode output into the
+// OpenEthereum state diff format.
+func stateDiffFromPrestate(diff *prestateDiff) StateDiff {
+	result := make(StateDiff)
+	for addr, pre := range diff.Pre {
+		post, ok := diff.Post[addr]
+		if !ok {
+			// accounts pruned from post are deleted
+			result[addr] = accountDiff(pre, "-")
+			continue
+		}
+		result[addr] = modifiedAccountDiff(pre, post)
+	}
+	for addr, post := range diff.Post {
+		if _, ok := diff.Pre[addr]; !ok {
+			// accounts not present before the execution are created
+			result[addr] = accountDiff(post, "+")
+		}
+	}
+	return result
+}
+
+// accountDiff returns the diff of an account that was created or deleted.
+func accountDiff(account *prestateAccount, kind string) *AccountDiff {
+	balance := account.Balance
+	if balance == nil {
+		balance = (*hexutil.Big)(new(big.Int))
+	}
+	code := account.Code
+	if code == nil {
+		code = hexutil.Bytes{}
+	}
+	result := &AccountDiff{
+		Balance: map[string]interface{}{kind: balance},
+		Code:    map[string]interface{}{kind: code},
+		Nonce:   map[string]interface{}{kind: hexutil.Uint64(account.Nonce)},
+		Storage: make(map[common.Hash]interface{}, len(account.Storage)),
+	}
+	for key, value := range account.Storage {
+		result.Storage[key] = map[string]interface{}{kind: value}
+	}
+	return result
+}
+
+// modifiedAccountDiff returns the diff of an account that existed before and
+// after the execution. Post only contains the modified fields.
+func modifiedAccountDiff(pre, post *prestateAccount) *AccountDiff {
+	result := &AccountDiff{
+		Balance: "=",
+		Code:    "=",
+		Nonce:   "=",
+		Storage: make(map[common.Hash]interface{}),
+	}
+	if post.Balance != nil {
+		from := pre.Balance
+		if from == nil {
+			from = (*hexutil.Big)(new(big.Int))
+		}
+		result.Balance = changed(from, post.Balance)
+	}
+	if post.Nonce != 0 && post.Nonce != pre.Nonce {
+		result.Nonce = changed(hexutil.Uint64(pre.Nonce), hexutil.Uint64(post.Nonce))
+	}
+	if post.Code != nil {
+		from := pre.Code
+		if from == nil {
+			from = hexutil.Bytes{}
+		}
+		result.Code = changed(from, post.Code)
+	}
+	for key, value := range post.Storage {
+		result.Storage[key] = changed(pre.Storage[key], value)
+	}
+	for key, value := range pre.Storage {
+		// cleared slots are pruned from post
+		if _, ok := post.Storage[key]; !ok {
+			result.Storage[key] = changed(value, common.Hash{})
+		}
+	}
+	return result
+}
+
+func changed(from, to interface{}) map[string]interface{} {
+	return map[string]interface{}{"*": FromTo{From: from, To: to}}
+}
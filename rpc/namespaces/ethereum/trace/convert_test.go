@@ -0,0 +1,198 @@
+package trace
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenCallFrame(t *testing.T) {
+	var (
+		sender   = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		contract = common.HexToAddress("0x2000000000000000000000000000000000000002")
+		callee   = common.HexToAddress("0x3000000000000000000000000000000000000003")
+		created  = common.HexToAddress("0x4000000000000000000000000000000000000004")
+	)
+
+	raw := map[string]interface{}{
+		"type":    "CALL",
+		"from":    sender.Hex(),
+		"to":      contract.Hex(),
+		"value":   "0x10",
+		"gas":     "0x5208",
+		"gasUsed": "0x5000",
+		"input":   "0x01",
+		"output":  "0x02",
+		"calls": []interface{}{
+			map[string]interface{}{
+				"type":    "STATICCALL",
+				"from":    contract.Hex(),
+				"to":      callee.Hex(),
+				"gas":     "0x100",
+				"gasUsed": "0x100",
+				"input":   "0x",
+				"error":   "execution reverted",
+			},
+			map[string]interface{}{
+				"type":    "CREATE2",
+				"from":    contract.Hex(),
+				"to":      created.Hex(),
+				"value":   "0x0",
+				"gas":     "0x200",
+				"gasUsed": "0x150",
+				"input":   "0x6000",
+				"output":  "0x00",
+				"calls": []interface{}{
+					map[string]interface{}{
+						"type":  "SELFDESTRUCT",
+						"from":  created.Hex(),
+						"to":    sender.Hex(),
+						"value": "0x1",
+						"gas":   "0x0",
+						"input": "0x",
+					},
+				},
+			},
+		},
+	}
+
+	var frame callFrame
+	require.NoError(t, decodeResult(raw, &frame))
+	traces := flattenCallFrame(&frame, []int{}, nil)
+	require.Len(t, traces, 4)
+
+	root := traces[0]
+	require.Equal(t, TypeCall, root.Type)
+	require.Equal(t, "call", root.Action.CallType)
+	require.Equal(t, &sender, root.Action.From)
+	require.Equal(t, &contract, root.Action.To)
+	require.Equal(t, big.NewInt(16), root.Action.Value.ToInt())
+	require.Equal(t, 2, root.Subtraces)
+	require.Empty(t, root.TraceAddress)
+	require.NotNil(t, root.Result)
+	require.Equal(t, hexutil.Bytes{0x02}, *root.Result.Output)
+
+	reverted := traces[1]
+	require.Equal(t, "staticcall", reverted.Action.CallType)
+	require.Equal(t, []int{0}, reverted.TraceAddress)
+	require.Equal(t, "Reverted", reverted.Error)
+	require.Nil(t, reverted.Result)
+	require.Equal(t, int64(0), reverted.Action.Value.ToInt().Int64())
+
+	create := traces[2]
+	require.Equal(t, TypeCreate, create.Type)
+	require.Equal(t, "create2", create.Action.CreationMethod)
+	require.Equal(t, hexutil.Bytes{0x60, 0x00}, *create.Action.Init)
+	require.Equal(t, []int{1}, create.TraceAddress)
+	require.Equal(t, &created, create.Result.Address)
+	require.Equal(t, 1, create.Subtraces)
+
+	suicide := traces[3]
+	require.Equal(t, TypeSuicide, suicide.Type)
+	require.Equal(t, []int{1, 0}, suicide.TraceAddress)
+	require.Equal(t, &created, suicide.Action.Address)
+	require.Equal(t, &sender, suicide.Action.RefundAddress)
+
+	// the JSON output keeps the null result of failed frames
+	bz, err := json.Marshal(reverted)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"result":null`)
+}
+
+func TestStateDiffFromPrestate(t *testing.T) {
+	var (
+		modified = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		created  = common.HexToAddress("0x2000000000000000000000000000000000000002")
+		deleted  = common.HexToAddress("0x3000000000000000000000000000000000000003")
+		slot1    = common.HexToHash("0x01")
+		slot2    = common.HexToHash("0x02")
+	)
+
+	diff := &prestateDiff{
+		Pre: map[common.Address]*prestateAccount{
+			modified: {
+				Balance: (*hexutil.Big)(big.NewInt(100)),
+				Nonce:   1,
+				Storage: map[common.Hash]common.Hash{slot1: common.HexToHash("0xaa"), slot2: common.HexToHash("0xbb")},
+			},
+			deleted: {Balance: (*hexutil.Big)(big.NewInt(5)), Code: hexutil.Bytes{0x60}},
+		},
+		Post: map[common.Address]*prestateAccount{
+			modified: {
+				Balance: (*hexutil.Big)(big.NewInt(90)),
+				Nonce:   2,
+				Storage: map[common.Hash]common.Hash{slot1: common.HexToHash("0xcc")},
+			},
+			created: {Balance: (*hexutil.Big)(big.NewInt(10)), Code: hexutil.Bytes{0x60}},
+		},
+	}
+
+	result := stateDiffFromPrestate(diff)
+	require.Len(t, result, 3)
+
+	acc := result[modified]
+	require.Equal(t, changed((*hexutil.Big)(big.NewInt(100)), (*hexutil.Big)(big.NewInt(90))), acc.Balance)
+	require.Equal(t, changed(hexutil.Uint64(1), hexutil.Uint64(2)), acc.Nonce)
+	require.Equal(t, "=", acc.Code)
+	require.Equal(t, changed(common.HexToHash("0xaa"), common.HexToHash("0xcc")), acc.Storage[slot1])
+	require.Equal(t, changed(common.HexToHash("0xbb"), common.Hash{}), acc.Storage[slot2])
+
+	born := result[created]
+	require.Equal(t, map[string]interface{}{"+": (*hexutil.Big)(big.NewInt(10))}, born.Balance)
+	require.Equal(t, map[string]interface{}{"+": hexutil.Bytes{0x60}}, born.Code)
+
+	dead := result[deleted]
+	require.Equal(t, map[string]interface{}{"-": (*hexutil.Big)(big.NewInt(5))}, dead.Balance)
+	require.Equal(t, map[string]interface{}{"-": hexutil.Uint64(0)}, dead.Nonce)
+}
+
+func TestVMTraceBuilder(t *testing.T) {
+	callee := common.HexToAddress("0x3000000000000000000000000000000000000003")
+	calleeCode := []byte{0x00}
+
+	logs := []structLog{
+		{Pc: 0, Op: "PUSH1", Gas: 100, GasCost: 3, Depth: 1, Stack: []string{}},
+		{Pc: 2, Op: "PUSH1", Gas: 97, GasCost: 3, Depth: 1, Stack: []string{"0x2a"}},
+		{Pc: 4, Op: "MSTORE", Gas: 94, GasCost: 6, Depth: 1, Stack: []string{"0x2a", "0x0"}},
+		{Pc: 5, Op: "PUSH1", Gas: 88, GasCost: 3, Depth: 1, Stack: []string{}, Memory: []string{"000000000000000000000000000000000000000000000000000000000000002a"}},
+		{Pc: 7, Op: "SSTORE", Gas: 85, GasCost: 20, Depth: 1, Stack: []string{"0x2a", "0x1"}},
+		// CALL(gas, addr, value, argsOffset, argsSize, retOffset, retSize)
+		{Pc: 8, Op: "CALL", Gas: 65, GasCost: 40, Depth: 1, Stack: []string{"0x0", "0x0", "0x0", "0x0", "0x0", "0x3000000000000000000000000000000000000003", "0x10"}},
+		{Pc: 0, Op: "STOP", Gas: 10, GasCost: 0, Depth: 2, Stack: []string{}},
+		{Pc: 9, Op: "STOP", Gas: 35, GasCost: 0, Depth: 1, Stack: []string{"0x1"}},
+	}
+
+	builder := &vmTraceBuilder{
+		logs: logs,
+		codeAt: func(addr common.Address) []byte {
+			require.Equal(t, callee, addr)
+			return calleeCode
+		},
+	}
+	trace := builder.build([]byte{0x60})
+	require.Len(t, trace.Ops, 7)
+
+	push := trace.Ops[0]
+	require.Equal(t, []string{"0x2a"}, push.Ex.Push)
+	require.Equal(t, uint64(97), push.Ex.Used)
+
+	mstore := trace.Ops[2]
+	require.Empty(t, mstore.Ex.Push)
+	require.NotNil(t, mstore.Ex.Mem)
+	require.Equal(t, uint64(0), mstore.Ex.Mem.Off)
+	require.Equal(t, byte(0x2a), mstore.Ex.Mem.Data[31])
+
+	sstore := trace.Ops[4]
+	require.Equal(t, &VMStorageDiff{Key: "0x1", Val: "0x2a"}, sstore.Ex.Store)
+
+	call := trace.Ops[5]
+	require.NotNil(t, call.Sub)
+	require.Equal(t, hexutil.Bytes(calleeCode), call.Sub.Code)
+	require.Len(t, call.Sub.Ops, 1)
+	require.Equal(t, []string{"0x1"}, call.Ex.Push)
+	require.Equal(t, uint64(35), call.Ex.Used)
+}
@@ -0,0 +1,140 @@
+package trace
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+)
+
+// Trace types of the flat trace format.
+const (
+	TypeCall    = "call"
+	TypeCreate  = "create"
+	TypeSuicide = "suicide"
+)
+
+// Trace types accepted by trace_replayBlockTransactions.
+const (
+	TraceTypeTrace     = "trace"
+	TraceTypeStateDiff = "stateDiff"
+	TraceTypeVMTrace   = "vmTrace"
+)
+
+// Trace is a single frame of a transaction execution in the OpenEthereum flat
+// trace format.
+type Trace struct {
+	Action              Action       `json:"action"`
+	BlockHash           *common.Hash `json:"blockHash,omitempty"`
+	BlockNumber         *uint64      `json:"blockNumber,omitempty"`
+	Error               string       `json:"error,omitempty"`
+	Result              *Result      `json:"result"`
+	Subtraces           int          `json:"subtraces"`
+	TraceAddress        []int        `json:"traceAddress"`
+	TransactionHash     *common.Hash `json:"transactionHash,omitempty"`
+	TransactionPosition *uint64      `json:"transactionPosition,omitempty"`
+	Type                string       `json:"type"`
+}
+
+// Action is the input of a trace frame. The fields set depend on the trace
+// type: call, create or suicide.
+type Action struct {
+	// call
+	CallType string          `json:"callType,omitempty"`
+	From     *common.Address `json:"from,omitempty"`
+	To       *common.Address `json:"to,omitempty"`
+	Input    *hexutil.Bytes  `json:"input,omitempty"`
+	// create
+	Init           *hexutil.Bytes `json:"init,omitempty"`
+	CreationMethod string         `json:"creationMethod,omitempty"`
+	// call and create
+	Gas   *hexutil.Uint64 `json:"gas,omitempty"`
+	Value *hexutil.Big    `json:"value,omitempty"`
+	// suicide
+	Address       *common.Address `json:"address,omitempty"`
+	RefundAddress *common.Address `json:"refundAddress,omitempty"`
+	Balance       *hexutil.Big    `json:"balance,omitempty"`
+}
+
+// Result is the output of a successful trace frame.
+type Result struct {
+	GasUsed *hexutil.Uint64 `json:"gasUsed,omitempty"`
+	Output  *hexutil.Bytes  `json:"output,omitempty"`
+	// create
+	Address *common.Address `json:"address,omitempty"`
+	Code    *hexutil.Bytes  `json:"code,omitempty"`
+}
+
+// FilterArgs are the arguments of trace_filter.
+type FilterArgs struct {
+	FromBlock   *rpctypes.BlockNumber `json:"fromBlock"`
+	ToBlock     *rpctypes.BlockNumber `json:"toBlock"`
+	FromAddress []common.Address      `json:"fromAddress"`
+	ToAddress   []common.Address      `json:"toAddress"`
+	After       *uint64               `json:"after"`
+	Count       *uint64               `json:"count"`
+}
+
+// TraceResults is the result of replaying a transaction with
+// trace_replayBlockTransactions.
+type TraceResults struct {
+	Output          hexutil.Bytes `json:"output"`
+	StateDiff       StateDiff     `json:"stateDiff"`
+	Trace           []*Trace      `json:"trace"`
+	VMTrace         *VMTrace      `json:"vmTrace"`
+	TransactionHash common.Hash   `json:"transactionHash"`
+}
+
+// StateDiff is the set of accounts modified by a transaction.
+type StateDiff map[common.Address]*AccountDiff
+
+// AccountDiff contains the changes of an account. Every field holds either the
+// "=" string, when unchanged, or a single entry map keyed by "+" (created),
+// "-" (deleted) or "*" (changed).
+type AccountDiff struct {
+	Balance interface{}                 `json:"balance"`
+	Code    interface{}                 `json:"code"`
+	Nonce   interface{}                 `json:"nonce"`
+	Storage map[common.Hash]interface{} `json:"storage"`
+}
+
+// FromTo is the value of a "*" diff entry.
+type FromTo struct {
+	From interface{} `json:"from"`
+	To   interface{} `json:"to"`
+}
+
+// VMTrace is the opcode level trace of a call frame.
+type VMTrace struct {
+	Code hexutil.Bytes  `json:"code"`
+	Ops  []*VMOperation `json:"ops"`
+}
+
+// VMOperation is a single executed opcode.
+type VMOperation struct {
+	Cost uint64               `json:"cost"`
+	Ex   *VMExecutedOperation `json:"ex"`
+	Pc   uint64               `json:"pc"`
+	Sub  *VMTrace             `json:"sub"`
+	Op   string               `json:"op"`
+}
+
+// VMExecutedOperation contains the effects of an executed opcode.
+type VMExecutedOperation struct {
+	Mem   *VMMemoryDiff  `json:"mem"`
+	Push  []string       `json:"push"`
+	Store *VMStorageDiff `json:"store"`
+	Used  uint64         `json:"used"`
+}
+
+// VMMemoryDiff is a memory write.
+type VMMemoryDiff struct {
+	Data hexutil.Bytes `json:"data"`
+	Off  uint64        `json:"off"`
+}
+
+// VMStorageDiff is a storage write.
+type VMStorageDiff struct {
+	Key string `json:"key"`
+	Val string `json:"val"`
+}
@@ -0,0 +1,204 @@
+package trace
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/holiman/uint256"
+)
+
+// structLog is a single step of the struct logger output.
+type structLog struct {
+	Pc      uint64   `json:"pc"`
+	Op      string   `json:"op"`
+	Gas     uint64   `json:"gas"`
+	GasCost uint64   `json:"gasCost"`
+	Depth   int      `json:"depth"`
+	Error   string   `json:"error,omitempty"`
+	Stack   []string `json:"stack,omitempty"`
+	Memory  []string `json:"memory,omitempty"`
+}
+
+// structLoggerResult is the output of the struct logger.
+type structLoggerResult struct {
+	StructLogs []structLog `json:"structLogs"`
+}
+
+// vmTraceBuilder rebuilds the OpenEthereum vmTrace of a transaction from the
+// struct logger output.
+type vmTraceBuilder struct {
+	logs   []structLog
+	codeAt func(common.Address) []byte
+}
+
+// build returns the vmTrace of the transaction, code being the code executed
+// by the top level frame.
+func (b *vmTraceBuilder) build(code []byte) *VMTrace {
+	if len(b.logs) == 0 {
+		return &VMTrace{Code: code, Ops: []*VMOperation{}}
+	}
+	trace, _ := b.frame(0, b.logs[0].Depth, code)
+	return trace
+}
+
+// frame builds the vmTrace of the call frame starting at the given step. It
+// returns the index of the first step after the frame.
+func (b *vmTraceBuilder) frame(i, depth int, code []byte) (*VMTrace, int) {
+	trace := &VMTrace{Code: code, Ops: []*VMOperation{}}
+	for i < len(b.logs) && b.logs[i].Depth >= depth {
+		step := &b.logs[i]
+		if step.Depth > depth {
+			// steps of a frame that couldn't be attributed to an opcode
+			i++
+			continue
+		}
+
+		op := &VMOperation{Pc: step.Pc, Cost: step.GasCost, Op: step.Op}
+		next := i + 1
+		if next < len(b.logs) && b.logs[next].Depth == depth+1 {
+			op.Sub, next = b.frame(next, depth+1, b.subCode(step))
+		}
+
+		if step.Error == "" {
+			ex := &VMExecutedOperation{Push: []string{}}
+			if step.Gas > step.GasCost {
+				ex.Used = step.Gas - step.GasCost
+			}
+			if next < len(b.logs) && b.logs[next].Depth == depth {
+				after := &b.logs[next]
+				ex.Used = after.Gas
+				ex.Push = pushedValues(step.Op, after.Stack)
+				ex.Mem = memoryWrite(step, after)
+			}
+			if step.Op == vm.SSTORE.String() && len(step.Stack) >= 2 {
+				ex.Store = &VMStorageDiff{
+					Key: stackBack(step.Stack, 0),
+					Val: stackBack(step.Stack, 1),
+				}
+			}
+			op.Ex = ex
+		}
+
+		trace.Ops = append(trace.Ops, op)
+		i = next
+	}
+	return trace, i
+}
+
+// subCode returns the code executed by the frame entered by the given step.
+func (b *vmTraceBuilder) subCode(step *structLog) []byte {
+	switch step.Op {
+	case vm.CALL.String(), vm.CALLCODE.String(), vm.DELEGATECALL.String(), vm.STATICCALL.String():
+		addr, ok := stackUint(step.Stack, 1)
+		if !ok || b.codeAt == nil {
+			return nil
+		}
+		return b.codeAt(common.BytesToAddress(addr.Bytes()))
+	case vm.CREATE.String(), vm.CREATE2.String():
+		offset, ok1 := stackUint(step.Stack, 1)
+		size, ok2 := stackUint(step.Stack, 2)
+		if !ok1 || !ok2 {
+			return nil
+		}
+		return memorySlice(step.Memory, offset, size)
+	default:
+		return nil
+	}
+}
+
+// pushedValues returns the values pushed onto the stack by the given opcode.
+func pushedValues(op string, stack []string) []string {
+	n := 1
+	switch {
+	case strings.HasPrefix(op, "DUP") || strings.HasPrefix(op, "SWAP"):
+		// the whole affected segment of the stack
+		n = int(vm.StringToOp(op)-vm.DUP1)%16 + 2
+	case strings.HasPrefix(op, "LOG"):
+		n = 0
+	default:
+		switch vm.StringToOp(op) {
+		case vm.STOP, vm.POP, vm.MSTORE, vm.MSTORE8, vm.SSTORE, vm.TSTORE, vm.JUMP, vm.JUMPI, vm.JUMPDEST,
+			vm.RETURN, vm.REVERT, vm.SELFDESTRUCT, vm.INVALID, vm.CALLDATACOPY, vm.CODECOPY,
+			vm.EXTCODECOPY, vm.RETURNDATACOPY, vm.MCOPY:
+			n = 0
+		}
+	}
+	if n > len(stack) {
+		n = len(stack)
+	}
+	pushed := make([]string, n)
+	copy(pushed, stack[len(stack)-n:])
+	return pushed
+}
+
+// memoryWrite returns the memory region written by the given step, read from
+// the memory of the step after it.
+func memoryWrite(step, after *structLog) *VMMemoryDiff {
+	var (
+		offset, size *uint256.Int
+		ok1, ok2     bool
+	)
+	switch vm.StringToOp(step.Op) {
+	case vm.MSTORE:
+		offset, ok1 = stackUint(step.Stack, 0)
+		size, ok2 = uint256.NewInt(32), true
+	case vm.MSTORE8:
+		offset, ok1 = stackUint(step.Stack, 0)
+		size, ok2 = uint256.NewInt(1), true
+	case vm.CALLDATACOPY, vm.CODECOPY, vm.RETURNDATACOPY, vm.MCOPY:
+		offset, ok1 = stackUint(step.Stack, 0)
+		size, ok2 = stackUint(step.Stack, 2)
+	case vm.EXTCODECOPY:
+		offset, ok1 = stackUint(step.Stack, 1)
+		size, ok2 = stackUint(step.Stack, 3)
+	case vm.CALL, vm.CALLCODE:
+		offset, ok1 = stackUint(step.Stack, 5)
+		size, ok2 = stackUint(step.Stack, 6)
+	case vm.DELEGATECALL, vm.STATICCALL:
+		offset, ok1 = stackUint(step.Stack, 4)
+		size, ok2 = stackUint(step.Stack, 5)
+	default:
+		return nil
+	}
+	if !ok1 || !ok2 || size.IsZero() {
+		return nil
+	}
+	data := memorySlice(after.Memory, offset, size)
+	if data == nil {
+		return nil
+	}
+	return &VMMemoryDiff{Off: offset.Uint64(), Data: data}
+}
+
+// memorySlice returns a copy of the memory region, or nil if it is out of
+// bounds.
+func memorySlice(memory []string, offset, size *uint256.Int) []byte {
+	mem, err := hex.DecodeString(strings.Join(memory, ""))
+	if err != nil || !offset.IsUint64() || !size.IsUint64() {
+		return nil
+	}
+	start, length := offset.Uint64(), size.Uint64()
+	if start > uint64(len(mem)) || length > uint64(len(mem))-start {
+		return nil
+	}
+	return common.CopyBytes(mem[start : start+length])
+}
+
+// stackBack returns the n-th item from the top of the stack.
+func stackBack(stack []string, n int) string {
+	return stack[len(stack)-1-n]
+}
+
+// stackUint parses the n-th item from the top of the stack.
+func stackUint(stack []string, n int) (*uint256.Int, bool) {
+	if n >= len(stack) {
+		return nil, false
+	}
+	value, err := uint256.FromHex(stackBack(stack, n))
+	if err != nil {
+		return nil, false
+	}
+	return value, true
+}
@@ -0,0 +1,209 @@
+// Package ndjson streams the receipts and logs of block ranges over HTTP as
+// newline delimited JSON, one receipt or log per line.
+//
+// Unlike eth_getBlockReceipts and eth_getLogs, the results are written block
+// by block as they are fetched, so the ranges are neither limited by the
+// JSON-RPC logs and block range caps nor by the batch response size. A stream
+// which fails ends with an {"error": "<message>"} line.
+package ndjson
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethfilters "github.com/ethereum/go-ethereum/eth/filters"
+
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+
+	"cosmossdk.io/log"
+)
+
+// ContentType is the content type of the streamed responses
+const ContentType = "application/x-ndjson"
+
+// Backend defines the methods of the JSON-RPC backend used by the streams
+type Backend interface {
+	filters.Backend
+	BlockNumber(ctx context.Context) (hexutil.Uint64, error)
+	GetBlockReceipts(ctx context.Context, blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error)
+}
+
+// ReceiptsRequest is the body of the receipts stream requests
+type ReceiptsRequest struct {
+	FromBlock rpctypes.BlockNumber `json:"fromBlock"`
+	ToBlock   rpctypes.BlockNumber `json:"toBlock"`
+}
+
+// Server serves the receipts and logs streams
+type Server struct {
+	logger       log.Logger
+	backend      Backend
+	writeTimeout time.Duration
+}
+
+// NewServer returns a Server streaming the receipts and logs of the backend.
+// The write deadline of the connection is extended by writeTimeout before
+// every write, if it isn't zero, so that the streams aren't interrupted by the
+// write timeout of the HTTP server.
+func NewServer(logger log.Logger, backend Backend, writeTimeout time.Duration) *Server {
+	return &Server{
+		logger:       logger.With("module", "ndjson"),
+		backend:      backend,
+		writeTimeout: writeTimeout,
+	}
+}
+
+// ReceiptsHandler returns an http.Handler streaming the receipts of the
+// blocks of the ReceiptsRequest range, in the eth_getBlockReceipts format.
+func (s *Server) ReceiptsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := ReceiptsRequest{FromBlock: rpctypes.EthLatestBlockNumber, ToBlock: rpctypes.EthLatestBlockNumber}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		stream := s.newStream(w)
+		stream.end(s.streamReceipts(r.Context(), stream, req))
+	})
+}
+
+// LogsHandler returns an http.Handler streaming the logs matching the filter
+// criteria of the request, in the eth_getLogs format. The criteria must
+// define a block range, not a block hash.
+func (s *Server) LogsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var crit ethfilters.FilterCriteria
+		if err := json.NewDecoder(r.Body).Decode(&crit); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if crit.BlockHash != nil {
+			http.Error(w, "the logs of a block hash can't be streamed, use eth_getLogs", http.StatusBadRequest)
+			return
+		}
+
+		begin := rpctypes.EthLatestBlockNumber.Int64()
+		if crit.FromBlock != nil {
+			begin = crit.FromBlock.Int64()
+		}
+		end := rpctypes.EthLatestBlockNumber.Int64()
+		if crit.ToBlock != nil {
+			end = crit.ToBlock.Int64()
+		}
+		filter := filters.NewRangeFilter(s.logger, s.backend, begin, end, crit.Addresses, crit.Topics)
+
+		stream := s.newStream(w)
+		stream.end(filter.ForEachBlockLogs(r.Context(), -1, func(logs []*ethtypes.Log) error {
+			for _, ethLog := range logs {
+				if err := stream.write(ethLog); err != nil {
+					return err
+				}
+			}
+			return stream.flush()
+		}))
+	})
+}
+
+// streamReceipts writes the receipts of the blocks of the request range
+func (s *Server) streamReceipts(ctx context.Context, stream *stream, req ReceiptsRequest) error {
+	latest, err := s.backend.BlockNumber(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch the latest block number: %w", err)
+	}
+	resolve := func(number rpctypes.BlockNumber) int64 {
+		switch {
+		case number == rpctypes.EthEarliestBlockNumber:
+			return 1
+		case number < 0:
+			return int64(latest) //#nosec G115 -- the block number fits in int64
+		default:
+			return number.Int64()
+		}
+	}
+
+	from, to := resolve(req.FromBlock), resolve(req.ToBlock)
+	if from > to {
+		return errors.New("invalid block range params")
+	}
+	to = min(to, int64(latest)) //#nosec G115 -- the block number fits in int64
+
+	for height := from; height <= to; height++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		blockNum := rpctypes.BlockNumber(height)
+		receipts, err := s.backend.GetBlockReceipts(ctx, rpctypes.BlockNumberOrHash{BlockNumber: &blockNum})
+		if err != nil {
+			return fmt.Errorf("failed to fetch the receipts of block %d: %w", height, err)
+		}
+		for _, receipt := range receipts {
+			if err := stream.write(receipt); err != nil {
+				return err
+			}
+		}
+		if err := stream.flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// stream writes the lines of a response
+type stream struct {
+	logger       log.Logger
+	rc           *http.ResponseController
+	enc          *json.Encoder
+	writeTimeout time.Duration
+}
+
+func (s *Server) newStream(w http.ResponseWriter) *stream {
+	w.Header().Set("Content-Type", ContentType)
+	return &stream{
+		logger:       s.logger,
+		rc:           http.NewResponseController(w),
+		enc:          json.NewEncoder(w),
+		writeTimeout: s.writeTimeout,
+	}
+}
+
+// write writes a value as a JSON line
+func (s *stream) write(v any) error {
+	if s.writeTimeout > 0 {
+		// not every ResponseWriter supports deadlines, e.g. the test recorders
+		_ = s.rc.SetWriteDeadline(time.Now().Add(s.writeTimeout))
+	}
+	return s.enc.Encode(v)
+}
+
+// flush sends the buffered lines to the client
+func (s *stream) flush() error {
+	if err := s.rc.Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+	return nil
+}
+
+// end ends the stream with an error line if err isn't nil
+func (s *stream) end(err error) {
+	if err == nil {
+		return
+	}
+	if errors.Is(err, context.Canceled) {
+		// the client went away
+		return
+	}
+	s.logger.Debug("stream failed", "error", err.Error())
+	if err := s.write(map[string]string{"error": err.Error()}); err != nil {
+		return
+	}
+	_ = s.flush()
+}
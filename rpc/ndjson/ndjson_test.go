@@ -0,0 +1,173 @@
+package ndjson_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/cosmos/evm/rpc/ndjson"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	emitter = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	other   = common.HexToAddress("0x2000000000000000000000000000000000000002")
+)
+
+// fakeBackend serves a chain of five blocks, whose every block has one
+// receipt, and a log of the emitter and of the other address. The other
+// methods of the backend panic.
+type fakeBackend struct {
+	ndjson.Backend
+
+	latest int64
+	failAt int64
+}
+
+func (b *fakeBackend) BlockNumber(context.Context) (hexutil.Uint64, error) {
+	return hexutil.Uint64(b.latest), nil //#nosec G115
+}
+
+func (b *fakeBackend) GetBlockReceipts(_ context.Context, blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error) {
+	height := blockNrOrHash.BlockNumber.Int64()
+	if height == b.failAt {
+		return nil, fmt.Errorf("block %d is pruned", height)
+	}
+	return []map[string]interface{}{{"blockNumber": hexutil.Uint64(height)}}, nil //#nosec G115
+}
+
+func (b *fakeBackend) HeaderByNumber(context.Context, rpctypes.BlockNumber) (*ethtypes.Header, error) {
+	return &ethtypes.Header{Number: big.NewInt(b.latest)}, nil
+}
+
+func (b *fakeBackend) LogIndexer() servertypes.EVMTxIndexer {
+	return nil
+}
+
+func (b *fakeBackend) CometBlockResultByNumber(_ context.Context, height *int64) (*cmtrpctypes.ResultBlockResults, error) {
+	txLogs := []*evmtypes.Log{
+		{Address: emitter.Hex(), Data: []byte{byte(*height)}},
+		{Address: other.Hex(), Data: []byte{byte(*height)}},
+	}
+	data, err := (&sdk.TxMsgData{MsgResponses: []*codectypes.Any{
+		codectypes.UnsafePackAny(&evmtypes.MsgEthereumTxResponse{Logs: txLogs}),
+	}}).Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return &cmtrpctypes.ResultBlockResults{
+		Height:     *height,
+		TxsResults: []*abcitypes.ExecTxResult{{Data: data}},
+	}, nil
+}
+
+func (b *fakeBackend) BlockBloomFromCometBlock(context.Context, *cmtrpctypes.ResultBlockResults) (ethtypes.Bloom, error) {
+	var bloom ethtypes.Bloom
+	bloom.Add(emitter.Bytes())
+	bloom.Add(other.Bytes())
+	return bloom, nil
+}
+
+func post(t *testing.T, handler http.Handler, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+// lines decodes the JSON lines of a response
+func lines(t *testing.T, rec *httptest.ResponseRecorder) []map[string]interface{} {
+	t.Helper()
+	var decoded []map[string]interface{}
+	scanner := bufio.NewScanner(rec.Body)
+	for scanner.Scan() {
+		var line map[string]interface{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		decoded = append(decoded, line)
+	}
+	require.NoError(t, scanner.Err())
+	return decoded
+}
+
+func TestReceiptsHandler(t *testing.T) {
+	testCases := []struct {
+		name       string
+		body       string
+		failAt     int64
+		expCode    int
+		expHeights []int64
+		expErr     string
+	}{
+		{"range", `{"fromBlock":"0x2","toBlock":"0x4"}`, 0, http.StatusOK, []int64{2, 3, 4}, ""},
+		{"earliest to latest", `{"fromBlock":"earliest","toBlock":"latest"}`, 0, http.StatusOK, []int64{1, 2, 3, 4, 5}, ""},
+		{"range clamped to the latest block", `{"fromBlock":"0x4","toBlock":"0x100"}`, 0, http.StatusOK, []int64{4, 5}, ""},
+		{"invalid range", `{"fromBlock":"0x4","toBlock":"0x2"}`, 0, http.StatusOK, nil, "invalid block range params"},
+		{"failure ends the stream", `{"fromBlock":"0x1","toBlock":"0x5"}`, 3, http.StatusOK, []int64{1, 2}, "block 3 is pruned"},
+		{"invalid body", `{"fromBlock":`, 0, http.StatusBadRequest, nil, ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			backend := &fakeBackend{latest: 5, failAt: tc.failAt}
+			server := ndjson.NewServer(log.NewNopLogger(), backend, 0)
+
+			rec := post(t, server.ReceiptsHandler(), tc.body)
+			require.Equal(t, tc.expCode, rec.Code, rec.Body.String())
+			if tc.expCode != http.StatusOK {
+				return
+			}
+			require.Equal(t, ndjson.ContentType, rec.Header().Get("Content-Type"))
+
+			decoded := lines(t, rec)
+			if tc.expErr != "" {
+				require.NotEmpty(t, decoded)
+				require.Contains(t, decoded[len(decoded)-1]["error"], tc.expErr)
+				decoded = decoded[:len(decoded)-1]
+			}
+			require.Len(t, decoded, len(tc.expHeights))
+			for i, height := range tc.expHeights {
+				require.Equal(t, hexutil.EncodeUint64(uint64(height)), decoded[i]["blockNumber"]) //#nosec G115
+			}
+		})
+	}
+}
+
+func TestLogsHandler(t *testing.T) {
+	backend := &fakeBackend{latest: 5}
+	server := ndjson.NewServer(log.NewNopLogger(), backend, 0)
+
+	// the range isn't limited by the block range cap of the JSON-RPC backend
+	rec := post(t, server.LogsHandler(), fmt.Sprintf(`{"fromBlock":"0x2","toBlock":"latest","address":"%s"}`, emitter.Hex()))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	decoded := lines(t, rec)
+	require.Len(t, decoded, 4)
+	for i, line := range decoded {
+		require.Equal(t, strings.ToLower(emitter.Hex()), strings.ToLower(line["address"].(string)))
+		require.Equal(t, hexutil.EncodeUint64(uint64(i+2)), line["blockNumber"]) //#nosec G115
+	}
+
+	rec = post(t, server.LogsHandler(), fmt.Sprintf(`{"blockHash":"%s"}`, common.Hash{1}.Hex()))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
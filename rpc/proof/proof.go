@@ -0,0 +1,265 @@
+// Package proof verifies the Merkle proofs returned by eth_getProof.
+//
+// Cosmos EVM doesn't keep the state in a Merkle Patricia trie. The accounts
+// live in the x/auth IAVL store and the code hashes and contract storage live
+// in the x/vm IAVL store, both committed to the CometBFT app hash through the
+// multistore. Every proof in a types.AccountResult is therefore a list of two
+// hex encoded ICS23 CommitmentProofs:
+//
+//  0. the existence or non-existence proof of the key in the module store
+//     (ProofOpIAVLCommitment), which computes the root of the module store,
+//  1. the existence proof of the module store root under the store name in
+//     the multistore (ProofOpSimpleMerkleCommitment), which computes the app
+//     hash.
+//
+// The proven keys are:
+//
+//   - accountProof: `0x01 | address` in the "acc" store, its value is the
+//     Any encoded account,
+//   - codeHashProof: `0x01 | address` in the "evm" store, its value is the
+//     code hash, absent for accounts without code,
+//   - storageProof: `0x02 | address | key` in the "evm" store, its value is
+//     the 32 bytes slot value, absent for empty slots.
+//
+// The storageHash is the root of the "evm" store, it is shared by all the
+// accounts. The balance is held by the bank module and isn't proven.
+//
+// The proofs of a query at height H are anchored to the app hash of the state
+// committed by block H, which is the AppHash of the header at height H+1.
+package proof
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// opsLength is the number of ICS23 proofs of a key, see the package documentation
+const opsLength = 2
+
+// Verifier checks the proofs of eth_getProof results against an app hash.
+type Verifier struct {
+	cdc codec.Codec
+}
+
+// NewVerifier creates a Verifier decoding the accounts with the given codec,
+// which must have the account types of the chain registered.
+func NewVerifier(cdc codec.Codec) *Verifier {
+	return &Verifier{cdc: cdc}
+}
+
+// NewDefaultVerifier creates a Verifier that decodes the x/auth and the
+// vesting account types.
+func NewDefaultVerifier() *Verifier {
+	registry := codectypes.NewInterfaceRegistry()
+	authtypes.RegisterInterfaces(registry)
+	vestingtypes.RegisterInterfaces(registry)
+	return NewVerifier(codec.NewProtoCodec(registry))
+}
+
+// VerifyAccountResultWithHeader verifies the result against the app hash of
+// the trusted header. The header must be the one following the queried block.
+func (v *Verifier) VerifyAccountResultWithHeader(res *rpctypes.AccountResult, header *cmttypes.Header) error {
+	if header == nil {
+		return errors.New("header is nil")
+	}
+	return v.VerifyAccountResult(res, header.AppHash)
+}
+
+// VerifyAccountResult verifies the nonce, the code hash, the storage hash and
+// the storage slots of the result against the app hash.
+func (v *Verifier) VerifyAccountResult(res *rpctypes.AccountResult, appHash []byte) error {
+	if res == nil {
+		return errors.New("account result is nil")
+	}
+
+	// account
+	value, err := Verify(res.AccountProof, authtypes.StoreKey, AccountKey(res.Address), appHash)
+	if err != nil {
+		return fmt.Errorf("invalid account proof: %w", err)
+	}
+	var nonce uint64
+	if value != nil {
+		var account sdk.AccountI
+		if err := v.cdc.UnmarshalInterface(value, &account); err != nil {
+			return fmt.Errorf("invalid account: %w", err)
+		}
+		if !bytes.Equal(account.GetAddress(), res.Address.Bytes()) {
+			return fmt.Errorf("account address mismatch, expect: %s, got: %s", res.Address, common.BytesToAddress(account.GetAddress()))
+		}
+		nonce = account.GetSequence()
+	}
+	if uint64(res.Nonce) != nonce {
+		return fmt.Errorf("nonce mismatch, proven: %d, got: %d", nonce, uint64(res.Nonce))
+	}
+
+	// code hash
+	value, err = Verify(res.CodeHashProof, evmtypes.StoreKey, CodeHashKey(res.Address), appHash)
+	if err != nil {
+		return fmt.Errorf("invalid code hash proof: %w", err)
+	}
+	codeHash := common.BytesToHash(evmtypes.EmptyCodeHash)
+	if value != nil {
+		codeHash = common.BytesToHash(value)
+	}
+	if res.CodeHash != codeHash {
+		return fmt.Errorf("code hash mismatch, proven: %s, got: %s", codeHash, res.CodeHash)
+	}
+
+	// storage hash
+	root, err := StoreRoot(res.CodeHashProof)
+	if err != nil {
+		return err
+	}
+	if res.StorageHash != common.BytesToHash(root) {
+		return fmt.Errorf("storage hash mismatch, proven: %s, got: %s", common.BytesToHash(root), res.StorageHash)
+	}
+
+	// storage
+	for _, storage := range res.StorageProof {
+		if err := verifyStorage(res.Address, res.StorageHash, storage, appHash); err != nil {
+			return fmt.Errorf("invalid storage proof for key %s: %w", storage.Key, err)
+		}
+	}
+	return nil
+}
+
+func verifyStorage(address common.Address, storageHash common.Hash, storage rpctypes.StorageResult, appHash []byte) error {
+	value, err := Verify(storage.Proof, evmtypes.StoreKey, StorageKey(address, common.HexToHash(storage.Key)), appHash)
+	if err != nil {
+		return err
+	}
+	root, err := StoreRoot(storage.Proof)
+	if err != nil {
+		return err
+	}
+	if storageHash != common.BytesToHash(root) {
+		return fmt.Errorf("storage hash mismatch, proven: %s, got: %s", common.BytesToHash(root), storageHash)
+	}
+
+	proven := new(big.Int).SetBytes(value)
+	if storage.Value == nil || proven.Cmp(storage.Value.ToInt()) != 0 {
+		return fmt.Errorf("value mismatch, proven: %s, got: %s", (*hexutil.Big)(proven), storage.Value)
+	}
+	return nil
+}
+
+// AccountKey returns the key of the account in the x/auth store
+func AccountKey(address common.Address) []byte {
+	return append(append([]byte{}, authtypes.AddressStoreKeyPrefix...), address.Bytes()...)
+}
+
+// CodeHashKey returns the key of the code hash in the x/vm store
+func CodeHashKey(address common.Address) []byte {
+	return append(append([]byte{}, evmtypes.KeyPrefixCodeHash...), address.Bytes()...)
+}
+
+// StorageKey returns the key of the storage slot in the x/vm store
+func StorageKey(address common.Address, key common.Hash) []byte {
+	return evmtypes.StateKey(address, key.Bytes())
+}
+
+// Verify checks the proof of the key in the store against the app hash. It
+// returns the proven value, or nil if the proof is a non-existence proof.
+func Verify(proof []string, storeName string, key, appHash []byte) ([]byte, error) {
+	ops, err := decodeOps(proof)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(ops[0].Key, key) {
+		return nil, fmt.Errorf("key mismatch, expect: %x, got: %x", key, ops[0].Key)
+	}
+	if !bytes.Equal(ops[1].Key, []byte(storeName)) {
+		return nil, fmt.Errorf("store mismatch, expect: %s, got: %s", storeName, ops[1].Key)
+	}
+
+	var args [][]byte
+	if exist := ops[0].Proof.GetExist(); exist != nil {
+		args = [][]byte{exist.Value}
+	}
+	root, err := ops[0].Run(args)
+	if err != nil {
+		return nil, err
+	}
+	root, err = ops[1].Run(root)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(root[0], appHash) {
+		return nil, fmt.Errorf("app hash mismatch, expect: %X, got: %X", appHash, root[0])
+	}
+
+	if len(args) == 0 {
+		return nil, nil
+	}
+	return args[0], nil
+}
+
+// StoreRoot returns the root of the module store computed by the proof.
+func StoreRoot(proof []string) ([]byte, error) {
+	ops, err := decodeOps(proof)
+	if err != nil {
+		return nil, err
+	}
+	root, err := ops[0].Proof.Calculate()
+	if err != nil {
+		return nil, fmt.Errorf("could not calculate root for proof: %w", err)
+	}
+	return root, nil
+}
+
+// decodeOps decodes the hex encoded ICS23 proofs into commitment ops, the
+// keys are taken from the proofs themselves.
+func decodeOps(proof []string) ([]storetypes.CommitmentOp, error) {
+	if len(proof) != opsLength {
+		return nil, fmt.Errorf("invalid proof length, expect: %d, got: %d", opsLength, len(proof))
+	}
+	types := [opsLength]string{storetypes.ProofOpIAVLCommitment, storetypes.ProofOpSimpleMerkleCommitment}
+	ops := make([]storetypes.CommitmentOp, opsLength)
+	for i, p := range proof {
+		bz, err := hexutil.Decode(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proof %d: %w", i, err)
+		}
+		decoded, err := storetypes.CommitmentOpDecoder(cmtprotocrypto.ProofOp{Type: types[i], Data: bz})
+		if err != nil {
+			return nil, fmt.Errorf("invalid proof %d: %w", i, err)
+		}
+		op := decoded.(storetypes.CommitmentOp)
+		op.Key, err = commitmentKey(op)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proof %d: %w", i, err)
+		}
+		ops[i] = op
+	}
+	return ops, nil
+}
+
+// commitmentKey returns the key proven by the commitment proof
+func commitmentKey(op storetypes.CommitmentOp) ([]byte, error) {
+	if exist := op.Proof.GetExist(); exist != nil {
+		return exist.Key, nil
+	}
+	if nonexist := op.Proof.GetNonexist(); nonexist != nil {
+		return nonexist.Key, nil
+	}
+	return nil, errors.New("unsupported commitment proof, expect existence or non-existence proof")
+}
@@ -0,0 +1,241 @@
+package proof_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"github.com/cosmos/evm/rpc/proof"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var (
+	contract = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	eoa      = common.HexToAddress("0x2000000000000000000000000000000000000002")
+	unknown  = common.HexToAddress("0x3000000000000000000000000000000000000003")
+	codeHash = common.HexToHash("0xc0de")
+	slot     = common.HexToHash("0x01")
+)
+
+// setupStore commits the accounts, the code hash and a storage slot into a
+// multistore and returns a function querying proofs and the app hash.
+func setupStore(t *testing.T) (func(storeName string, key []byte) []string, []byte) {
+	t.Helper()
+
+	registry := codectypes.NewInterfaceRegistry()
+	authtypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	accKey := storetypes.NewKVStoreKey(authtypes.StoreKey)
+	evmKey := storetypes.NewKVStoreKey(evmtypes.StoreKey)
+	ms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	ms.MountStoreWithDB(accKey, storetypes.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(evmKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	accStore := ms.GetKVStore(accKey)
+	for i, address := range []common.Address{contract, eoa} {
+		account := authtypes.NewBaseAccount(sdk.AccAddress(address.Bytes()), nil, uint64(i), uint64(i+5)) //#nosec G115
+		bz, err := cdc.MarshalInterface(account)
+		require.NoError(t, err)
+		accStore.Set(proof.AccountKey(address), bz)
+	}
+	evmStore := ms.GetKVStore(evmKey)
+	evmStore.Set(proof.CodeHashKey(contract), codeHash.Bytes())
+	evmStore.Set(proof.StorageKey(contract, slot), common.BigToHash(big.NewInt(42)).Bytes())
+	commitID := ms.Commit()
+
+	query := func(storeName string, key []byte) []string {
+		res, err := ms.Query(&storetypes.RequestQuery{
+			Path:   "/" + storeName + "/key",
+			Data:   key,
+			Height: commitID.Version,
+			Prove:  true,
+		})
+		require.NoError(t, err)
+		return hexProofs(res.ProofOps)
+	}
+	return query, commitID.Hash
+}
+
+func hexProofs(ops *cmtprotocrypto.ProofOps) []string {
+	proofs := make([]string, len(ops.Ops))
+	for i, op := range ops.Ops {
+		proofs[i] = hexutil.Encode(op.Data)
+	}
+	return proofs
+}
+
+func accountResult(t *testing.T, query func(string, []byte) []string, address common.Address, nonce uint64, codeHash common.Hash, slots map[common.Hash]int64) *rpctypes.AccountResult {
+	t.Helper()
+	codeHashProof := query(evmtypes.StoreKey, proof.CodeHashKey(address))
+	root, err := proof.StoreRoot(codeHashProof)
+	require.NoError(t, err)
+
+	res := &rpctypes.AccountResult{
+		Address:       address,
+		AccountProof:  query(authtypes.StoreKey, proof.AccountKey(address)),
+		Balance:       (*hexutil.Big)(big.NewInt(0)),
+		CodeHash:      codeHash,
+		CodeHashProof: codeHashProof,
+		Nonce:         hexutil.Uint64(nonce),
+		StorageHash:   common.BytesToHash(root),
+	}
+	for key, value := range slots {
+		res.StorageProof = append(res.StorageProof, rpctypes.StorageResult{
+			Key:   key.Hex(),
+			Value: (*hexutil.Big)(big.NewInt(value)),
+			Proof: query(evmtypes.StoreKey, proof.StorageKey(address, key)),
+		})
+	}
+	return res
+}
+
+func TestVerifyAccountResult(t *testing.T) {
+	query, appHash := setupStore(t)
+	emptyCodeHash := common.BytesToHash(evmtypes.EmptyCodeHash)
+	verifier := proof.NewDefaultVerifier()
+
+	testCases := []struct {
+		name     string
+		malleate func() *rpctypes.AccountResult
+		appHash  []byte
+		expErr   string
+	}{
+		{
+			"pass - contract with existing and empty slots",
+			func() *rpctypes.AccountResult {
+				return accountResult(t, query, contract, 5, codeHash, map[common.Hash]int64{slot: 42, common.HexToHash("0x02"): 0})
+			},
+			appHash,
+			"",
+		},
+		{
+			"pass - account without code",
+			func() *rpctypes.AccountResult {
+				return accountResult(t, query, eoa, 6, emptyCodeHash, nil)
+			},
+			appHash,
+			"",
+		},
+		{
+			"pass - non-existent account",
+			func() *rpctypes.AccountResult {
+				return accountResult(t, query, unknown, 0, emptyCodeHash, nil)
+			},
+			appHash,
+			"",
+		},
+		{
+			"fail - wrong app hash",
+			func() *rpctypes.AccountResult {
+				return accountResult(t, query, contract, 5, codeHash, nil)
+			},
+			common.HexToHash("0x01").Bytes(),
+			"app hash mismatch",
+		},
+		{
+			"fail - wrong nonce",
+			func() *rpctypes.AccountResult {
+				return accountResult(t, query, contract, 6, codeHash, nil)
+			},
+			appHash,
+			"nonce mismatch",
+		},
+		{
+			"fail - nonce of a non-existent account",
+			func() *rpctypes.AccountResult {
+				return accountResult(t, query, unknown, 1, emptyCodeHash, nil)
+			},
+			appHash,
+			"nonce mismatch",
+		},
+		{
+			"fail - wrong code hash",
+			func() *rpctypes.AccountResult {
+				return accountResult(t, query, eoa, 6, codeHash, nil)
+			},
+			appHash,
+			"code hash mismatch",
+		},
+		{
+			"fail - wrong storage value",
+			func() *rpctypes.AccountResult {
+				return accountResult(t, query, contract, 5, codeHash, map[common.Hash]int64{slot: 43})
+			},
+			appHash,
+			"value mismatch",
+		},
+		{
+			"fail - wrong storage hash",
+			func() *rpctypes.AccountResult {
+				res := accountResult(t, query, contract, 5, codeHash, nil)
+				res.StorageHash = common.HexToHash("0x01")
+				return res
+			},
+			appHash,
+			"storage hash mismatch",
+		},
+		{
+			"fail - proof of another account",
+			func() *rpctypes.AccountResult {
+				res := accountResult(t, query, contract, 5, codeHash, nil)
+				res.AccountProof = query(authtypes.StoreKey, proof.AccountKey(eoa))
+				return res
+			},
+			appHash,
+			"key mismatch",
+		},
+		{
+			"fail - proof of another store",
+			func() *rpctypes.AccountResult {
+				res := accountResult(t, query, contract, 5, codeHash, nil)
+				res.CodeHashProof = query(authtypes.StoreKey, proof.CodeHashKey(contract))
+				return res
+			},
+			appHash,
+			"store mismatch",
+		},
+		{
+			"fail - missing proof",
+			func() *rpctypes.AccountResult {
+				res := accountResult(t, query, contract, 5, codeHash, nil)
+				res.AccountProof = []string{""}
+				return res
+			},
+			appHash,
+			"invalid proof length",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.malleate()
+			err := verifier.VerifyAccountResultWithHeader(res, &cmttypes.Header{AppHash: tc.appHash})
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
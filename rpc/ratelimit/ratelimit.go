@@ -0,0 +1,199 @@
+// Package ratelimit limits the JSON-RPC requests of the clients of the HTTP and
+// WebSocket servers.
+//
+// Every JSON-RPC method costs a number of compute units, and the requests of a
+// client are taken from its token bucket, refilled at a fixed rate. The
+// clients are identified by their API key, when the API key header is
+// configured and set, or else by their remote IP. The HTTP and WebSocket
+// servers have separate budgets. The rejected requests return the JSON-RPC
+// error code -32005.
+package ratelimit
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/cosmos/evm/rpc/middleware"
+	"github.com/cosmos/evm/server/config"
+)
+
+const (
+	// ErrCodeLimitExceeded is the JSON-RPC error code of the rate limited requests
+	ErrCodeLimitExceeded = -32005
+	// ErrMsgLimitExceeded is the JSON-RPC error message of the rate limited requests
+	ErrMsgLimitExceeded = "rate limit exceeded"
+
+	// sweepInterval is the interval between the removals of the full buckets
+	sweepInterval = time.Minute
+	// maxBuckets is the maximum number of buckets of a budget. When it is
+	// reached, the new clients share the overflow bucket until the full buckets
+	// are removed.
+	maxBuckets = 100_000
+	// overflowClient is the client key of the overflow bucket
+	overflowClient = "overflow"
+)
+
+// Limiter rate limits the JSON-RPC requests of the HTTP and WebSocket servers.
+type Limiter struct {
+	http *budget
+	ws   *budget
+
+	costs       map[string]int
+	defaultCost int
+}
+
+// New returns a Limiter enforcing the given rate limits.
+func New(cfg config.RateLimitConfig) *Limiter {
+	// the config keys are lowercased by viper, so the methods are matched
+	// case-insensitively
+	costs := make(map[string]int, len(cfg.MethodCosts))
+	for method, cost := range cfg.MethodCosts {
+		costs[strings.ToLower(method)] = cost
+	}
+
+	return &Limiter{
+		http:        newBudget(cfg.HTTPRate, cfg.HTTPBurst),
+		ws:          newBudget(cfg.WSRate, cfg.WSBurst),
+		costs:       costs,
+		defaultCost: cfg.DefaultCost,
+	}
+}
+
+// ClientKey returns the key identifying the client of the request: the
+// identity set by the auth middleware if the client authenticated, or else its
+// remote IP. The unverified headers are never used, so the clients can't
+// bypass their limit by changing them.
+func (l *Limiter) ClientKey(r *http.Request) string {
+	if client := middleware.Client(r); client != "" {
+		return "auth:" + client
+	}
+	return "ip:" + middleware.RemoteIP(r)
+}
+
+// Cost returns the cost in compute units of the given JSON-RPC message, a
+// single request or a batch. The messages which can't be decoded cost the
+// default cost.
+func (l *Limiter) Cost(msg []byte) int {
+	reqs, _ := middleware.ParseRequests(msg)
+	if len(reqs) == 0 {
+		return l.defaultCost
+	}
+	cost := 0
+	for _, req := range reqs {
+		cost += l.methodCost(req.Method)
+	}
+	return cost
+}
+
+func (l *Limiter) methodCost(method string) int {
+	if cost, ok := l.costs[strings.ToLower(method)]; ok {
+		return cost
+	}
+	return l.defaultCost
+}
+
+// Handler returns an http.Handler charging the JSON-RPC requests to the HTTP
+// budget of the clients before passing them to next. The requests exceeding
+// the budget are rejected with the -32005 error code.
+func (l *Limiter) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := middleware.ReadBody(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if l.http.allow(l.ClientKey(r), l.Cost(body), time.Now()) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		middleware.WriteError(w, http.StatusTooManyRequests, ErrorResponse(body))
+	})
+}
+
+// MethodHandler returns an http.Handler charging the requests which aren't
+// JSON-RPC messages, like the GraphQL queries, to the HTTP budget of the
+// clients, at the cost of the given method. The requests exceeding the budget
+// are rejected with the 429 status.
+func (l *Limiter) MethodHandler(method string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if l.http.allow(l.ClientKey(r), l.methodCost(method), time.Now()) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		http.Error(w, ErrMsgLimitExceeded, http.StatusTooManyRequests)
+	})
+}
+
+// AllowWS charges the JSON-RPC message of a WebSocket client to its budget,
+// and returns false if it exceeds it.
+func (l *Limiter) AllowWS(client string, msg []byte) bool {
+	return l.ws.allow(client, l.Cost(msg), time.Now())
+}
+
+// budget holds the token buckets of the clients of a server
+type budget struct {
+	mu        sync.Mutex
+	rate      rate.Limit
+	burst     int
+	buckets   map[string]*rate.Limiter
+	lastSweep time.Time
+}
+
+func newBudget(r float64, burst int) *budget {
+	return &budget{
+		rate:      rate.Limit(r),
+		burst:     burst,
+		buckets:   make(map[string]*rate.Limiter),
+		lastSweep: time.Now(),
+	}
+}
+
+// allow takes cost compute units from the bucket of the client, it returns
+// false and leaves the bucket untouched if there aren't enough.
+func (b *budget) allow(client string, cost int, now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if now.Sub(b.lastSweep) >= sweepInterval {
+		b.sweep(now)
+	}
+
+	bucket, ok := b.buckets[client]
+	if !ok {
+		if len(b.buckets) >= maxBuckets {
+			b.sweep(now)
+		}
+		if len(b.buckets) >= maxBuckets {
+			client = overflowClient
+		}
+		if bucket, ok = b.buckets[client]; !ok {
+			bucket = rate.NewLimiter(b.rate, b.burst)
+			b.buckets[client] = bucket
+		}
+	}
+	return bucket.AllowN(now, cost)
+}
+
+// sweep removes the full buckets, which are identical to new ones.
+func (b *budget) sweep(now time.Time) {
+	for client, bucket := range b.buckets {
+		if bucket.TokensAt(now) >= float64(b.burst) {
+			delete(b.buckets, client)
+		}
+	}
+	b.lastSweep = now
+}
+
+// ErrorResponse returns the -32005 JSON-RPC error response of the rejected
+// message, with an error for every request of a batch.
+func ErrorResponse(msg []byte) []byte {
+	return middleware.ErrorResponse(msg, func(middleware.Request) middleware.Error {
+		return middleware.Error{Code: ErrCodeLimitExceeded, Message: ErrMsgLimitExceeded}
+	})
+}
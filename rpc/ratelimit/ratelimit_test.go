@@ -0,0 +1,193 @@
+package ratelimit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/rpc/middleware"
+	"github.com/cosmos/evm/server/config"
+)
+
+func newTestLimiter() *Limiter {
+	cfg := config.DefaultRateLimitConfig()
+	cfg.Enable = true
+	cfg.HTTPRate = 1
+	cfg.HTTPBurst = 10
+	cfg.WSRate = 1
+	cfg.WSBurst = 5
+	cfg.DefaultCost = 1
+	cfg.MethodCosts = map[string]int{"eth_call": 4, "web3_clientVersion": 0}
+	return New(cfg)
+}
+
+func TestCost(t *testing.T) {
+	l := newTestLimiter()
+
+	testCases := []struct {
+		name string
+		msg  string
+		exp  int
+	}{
+		{"method with a cost", `{"jsonrpc":"2.0","id":1,"method":"eth_call","params":[]}`, 4},
+		{"method matched case-insensitively", `{"jsonrpc":"2.0","id":1,"method":"ETH_CALL"}`, 4},
+		{"method without a cost", `{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}`, 1},
+		{"free method", `{"jsonrpc":"2.0","id":1,"method":"web3_clientVersion"}`, 0},
+		{"batch", ` [{"id":1,"method":"eth_call"},{"id":2,"method":"eth_chainId"},{"id":3,"method":"eth_call"}]`, 9},
+		{"invalid message", `{"method":`, 1},
+		{"empty batch", `[]`, 1},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.exp, l.Cost([]byte(tc.msg)))
+		})
+	}
+}
+
+func TestBudget(t *testing.T) {
+	b := newBudget(2, 10)
+	now := time.Now()
+
+	require.True(t, b.allow("a", 8, now))
+	require.False(t, b.allow("a", 3, now), "the bucket has only 2 units left")
+	require.True(t, b.allow("b", 10, now), "the clients have separate buckets")
+	require.False(t, b.allow("c", 11, now), "the cost exceeds the burst")
+
+	// 2 units per second are refilled
+	require.True(t, b.allow("a", 4, now.Add(time.Second)))
+	require.False(t, b.allow("a", 1, now.Add(time.Second)))
+
+	// the full buckets are removed
+	b.allow("b", 0, now.Add(sweepInterval))
+	require.NotContains(t, b.buckets, "c")
+	require.NotContains(t, b.buckets, "a")
+	require.Contains(t, b.buckets, "b")
+}
+
+func TestBudgetMaxBuckets(t *testing.T) {
+	b := newBudget(1, 10)
+	now := time.Now()
+
+	for i := 0; i < maxBuckets; i++ {
+		require.True(t, b.allow(fmt.Sprintf("client-%d", i), 1, now))
+	}
+
+	// the new clients share the overflow bucket while the buckets are in use
+	require.True(t, b.allow("new-1", 6, now))
+	require.False(t, b.allow("new-2", 6, now))
+	require.Len(t, b.buckets, maxBuckets+1)
+	require.Contains(t, b.buckets, overflowClient)
+
+	// the full buckets are removed to make room for the new clients
+	later := now.Add(10 * time.Second)
+	require.True(t, b.allow("new-2", 6, later))
+	require.Contains(t, b.buckets, "new-2")
+	require.Len(t, b.buckets, 1)
+}
+
+func TestClientKey(t *testing.T) {
+	l := newTestLimiter()
+
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	req.RemoteAddr = "10.0.0.1:1234"
+	require.Equal(t, "ip:10.0.0.1", l.ClientKey(req))
+
+	// the unverified headers don't identify the client
+	req.Header.Set("X-API-Key", "secret")
+	req.Header.Set("Authorization", "Bearer secret")
+	require.Equal(t, "ip:10.0.0.1", l.ClientKey(req))
+
+	req = middleware.WithClient(req, "key:0123")
+	require.Equal(t, "auth:key:0123", l.ClientKey(req))
+}
+
+func TestHandler(t *testing.T) {
+	l := newTestLimiter()
+
+	var served []string
+	handler := l.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req middleware.Request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		served = append(served, req.Method)
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	post := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.RemoteAddr = "10.0.0.1:1234"
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	for i := 0; i < 2; i++ {
+		rec := post(`{"jsonrpc":"2.0","id":1,"method":"eth_call"}`)
+		require.Equal(t, http.StatusOK, rec.Code)
+	}
+	require.Equal(t, []string{"eth_call", "eth_call"}, served)
+
+	rec := post(`{"jsonrpc":"2.0","id":"abc","method":"eth_call"}`)
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.JSONEq(t, `{"jsonrpc":"2.0","id":"abc","error":{"code":-32005,"message":"rate limit exceeded"}}`, rec.Body.String())
+
+	rec = post(`[{"jsonrpc":"2.0","id":1,"method":"eth_call"},{"jsonrpc":"2.0","id":2,"method":"eth_chainId"}]`)
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.JSONEq(t, `[
+		{"jsonrpc":"2.0","id":1,"error":{"code":-32005,"message":"rate limit exceeded"}},
+		{"jsonrpc":"2.0","id":2,"error":{"code":-32005,"message":"rate limit exceeded"}}
+	]`, rec.Body.String())
+}
+
+func TestMethodHandler(t *testing.T) {
+	l := newTestLimiter()
+
+	served := 0
+	handler := l.MethodHandler("eth_call", http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		served++
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	post := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query":"{ chainID }"}`))
+		req.RemoteAddr = "10.0.0.1:1234"
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// every request costs 4 units
+	require.Equal(t, http.StatusOK, post().Code)
+	require.Equal(t, http.StatusOK, post().Code)
+	rec := post()
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.Equal(t, ErrMsgLimitExceeded+"\n", rec.Body.String())
+	require.Equal(t, 2, served)
+}
+
+func TestAllowWS(t *testing.T) {
+	l := newTestLimiter()
+	msg := []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_call"}`)
+
+	require.True(t, l.AllowWS("ip:10.0.0.1", msg))
+	require.False(t, l.AllowWS("ip:10.0.0.1", msg), "the websocket budget is 5 units")
+
+	// the HTTP budget is separate
+	require.True(t, l.http.allow("ip:10.0.0.1", l.Cost(msg), time.Now()))
+}
+
+func TestErrorResponse(t *testing.T) {
+	require.JSONEq(t,
+		`{"jsonrpc":"2.0","id":null,"error":{"code":-32005,"message":"rate limit exceeded"}}`,
+		string(ErrorResponse([]byte(`not json`))),
+	)
+	require.JSONEq(t,
+		`{"jsonrpc":"2.0","id":null,"error":{"code":-32005,"message":"rate limit exceeded"}}`,
+		string(ErrorResponse([]byte(`{"jsonrpc":"2.0","method":"eth_call"}`))),
+	)
+}
@@ -6,7 +6,9 @@ import (
 	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
 
 	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
@@ -32,6 +34,7 @@ const (
 	txStreamCapacity        = 1024 * 32
 	logStreamSegmentSize    = 2048
 	logStreamCapacity       = 2048 * 32
+	txPoolChanSize          = 4096
 )
 
 var (
@@ -45,6 +48,11 @@ var (
 	NewBlockHeaderEvents = cmtquery.MustCompile(fmt.Sprintf("%s='%s'", cmttypes.EventTypeKey, cmttypes.EventNewBlockHeader))
 )
 
+// TxPool is the source of the pending txs of the EVM mempool.
+type TxPool interface {
+	SubscribeTransactions(ch chan<- core.NewTxsEvent, reorgs bool) event.Subscription
+}
+
 type RPCHeader struct {
 	EthHeader *ethtypes.Header
 	Hash      common.Hash
@@ -62,6 +70,9 @@ type RPCStream struct {
 
 	// pendingTxStream is backed by check-tx ante handler
 	pendingTxStream *Stream[common.Hash]
+	// pendingFullTxStream is backed by the txpool of the EVM mempool, nil if not listening
+	pendingFullTxStream *Stream[*ethtypes.Transaction]
+	txPoolSub           event.Subscription
 
 	wg sync.WaitGroup
 }
@@ -105,6 +116,9 @@ func (s *RPCStream) initSubscriptions() {
 }
 
 func (s *RPCStream) Close() error {
+	if s.txPoolSub != nil {
+		s.txPoolSub.Unsubscribe()
+	}
 	if s.headerStream == nil {
 		// not initialized
 		return nil
@@ -126,6 +140,12 @@ func (s *RPCStream) PendingTxStream() *Stream[common.Hash] {
 	return s.pendingTxStream
 }
 
+// PendingFullTxStream returns the stream of the txs promoted to pending in the
+// txpool, nil if the txpool is not listened.
+func (s *RPCStream) PendingFullTxStream() *Stream[*ethtypes.Transaction] {
+	return s.pendingFullTxStream
+}
+
 func (s *RPCStream) LogStream() *Stream[*ethtypes.Log] {
 	s.initSubscriptions()
 	return s.logStream
@@ -136,6 +156,28 @@ func (s *RPCStream) ListenPendingTx(hash common.Hash) {
 	s.PendingTxStream().Add(hash)
 }
 
+// ListenTxPool streams the txs promoted to pending in the txpool of the EVM
+// mempool. It must be called once, before the streams are used.
+func (s *RPCStream) ListenTxPool(pool TxPool) {
+	txsCh := make(chan core.NewTxsEvent, txPoolChanSize)
+	s.pendingFullTxStream = NewStream[*ethtypes.Transaction](txStreamSegmentSize, txStreamCapacity)
+	s.txPoolSub = pool.SubscribeTransactions(txsCh, false)
+
+	go func() {
+		for {
+			select {
+			case ev := <-txsCh:
+				s.pendingFullTxStream.Add(ev.Txs...)
+			case err := <-s.txPoolSub.Err():
+				if err != nil {
+					s.logger.Error("txpool subscription failed, full pending tx stream will be empty", "err", err)
+				}
+				return
+			}
+		}
+	}()
+}
+
 func (s *RPCStream) start(
 	wg *sync.WaitGroup,
 	chBlocks <-chan coretypes.ResultEvent,
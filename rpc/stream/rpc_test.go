@@ -3,8 +3,13 @@ package stream
 import (
 	"context"
 	"errors"
+	"math/big"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/stretchr/testify/require"
 
 	coretypes "github.com/cometbft/cometbft/rpc/core/types"
@@ -127,3 +132,38 @@ func TestLogStream_GracefulOnSecondSubscribeFailure(t *testing.T) {
 		require.NotNil(t, stream, "C2: LogStream() should return non-nil stream even on second subscribe failure")
 	})
 }
+
+// feedTxPool is a TxPool backed by an event feed.
+type feedTxPool struct {
+	feed event.Feed
+}
+
+func (p *feedTxPool) SubscribeTransactions(ch chan<- core.NewTxsEvent, _ bool) event.Subscription {
+	return p.feed.Subscribe(ch)
+}
+
+func TestPendingFullTxStream(t *testing.T) {
+	s := NewRPCStreams(&failingEventsClient{}, log.NewNopLogger(), nil)
+	require.Nil(t, s.PendingFullTxStream())
+
+	pool := &feedTxPool{}
+	s.ListenTxPool(pool)
+	txStream := s.PendingFullTxStream()
+	require.NotNil(t, txStream)
+
+	txs := []*ethtypes.Transaction{
+		ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: 0, GasPrice: big.NewInt(1)}),
+		ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: 1, GasPrice: big.NewInt(1)}),
+	}
+	pool.feed.Send(core.NewTxsEvent{Txs: txs})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	received, _ := txStream.ReadBlocking(ctx, 0)
+	require.Len(t, received, 2)
+	require.Equal(t, txs[1].Hash(), received[1].Hash())
+
+	// closing the streams unsubscribes from the txpool
+	require.NoError(t, s.Close())
+	require.Eventually(t, func() bool { return pool.feed.Send(core.NewTxsEvent{Txs: txs}) == 0 }, 5*time.Second, 10*time.Millisecond)
+}
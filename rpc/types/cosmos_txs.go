@@ -0,0 +1,172 @@
+package types
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// CosmosTxType is the type of the pseudo-transactions of the Cosmos transactions. No Ethereum
+// transaction type uses it, so the clients can tell the pseudo-transactions apart.
+const CosmosTxType = 0x7f
+
+// CosmosTx is a Cosmos transaction, one without MsgEthereumTx, exposed in the blocks as a
+// pseudo-transaction so that the blocks don't look empty to the EVM explorers.
+type CosmosTx struct {
+	// Hash is the CometBFT hash of the transaction
+	Hash common.Hash
+	// From is the hex address of the first signer, or of the fee payer if the signers can't be resolved
+	From common.Address
+	// Nonce is the account sequence of the first signature
+	Nonce uint64
+	// Gas is the gas limit of the transaction
+	Gas uint64
+	// GasUsed is the gas used by the transaction
+	GasUsed uint64
+	// Fee is the fee paid in the EVM denom, in 18 decimals
+	Fee *big.Int
+	// Messages are the type URLs of the messages of the transaction
+	Messages []string
+	// Failed is true if the transaction failed
+	Failed bool
+	// BlockTxIndex is the position of the transaction in the CometBFT block
+	BlockTxIndex uint
+}
+
+// NewCosmosTx returns the CosmosTx of a decoded transaction of a block and its result. The
+// Ethereum transactions aren't Cosmos transactions and return nil.
+func NewCosmosTx(txBz cmttypes.Tx, tx sdk.Tx, result *abci.ExecTxResult, blockTxIndex uint) (*CosmosTx, error) {
+	msgs := tx.GetMsgs()
+	cosmosTx := &CosmosTx{
+		Hash:         common.BytesToHash(txBz.Hash()),
+		Fee:          new(big.Int),
+		Messages:     make([]string, 0, len(msgs)),
+		Failed:       result.Code != abci.CodeTypeOK,
+		BlockTxIndex: blockTxIndex,
+	}
+	for _, msg := range msgs {
+		if _, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+			return nil, nil
+		}
+		cosmosTx.Messages = append(cosmosTx.Messages, sdk.MsgTypeURL(msg))
+	}
+
+	if result.GasUsed < 0 {
+		return nil, errors.New("negative gas used in tx result")
+	}
+	cosmosTx.GasUsed = uint64(result.GasUsed)
+
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		cosmosTx.Gas = feeTx.GetGas()
+		cosmosTx.Fee = evmtypes.ConvertAmountTo18DecimalsBigInt(feeTx.GetFee().AmountOf(evmtypes.GetEVMCoinDenom()).BigInt())
+		cosmosTx.From = common.BytesToAddress(feeTx.FeePayer())
+	}
+	if sigTx, ok := tx.(authsigning.SigVerifiableTx); ok {
+		if signers, err := sigTx.GetSigners(); err == nil && len(signers) > 0 {
+			cosmosTx.From = common.BytesToAddress(signers[0])
+		}
+		if sigs, err := sigTx.GetSignaturesV2(); err == nil && len(sigs) > 0 {
+			cosmosTx.Nonce = sigs[0].Sequence
+		}
+	}
+	return cosmosTx, nil
+}
+
+// GasPrice returns the fee paid per unit of gas
+func (tx *CosmosTx) GasPrice() *big.Int {
+	if tx.Gas == 0 {
+		return new(big.Int)
+	}
+	return new(big.Int).Div(tx.Fee, new(big.Int).SetUint64(tx.Gas))
+}
+
+// RPCCosmosTransaction is the JSON-RPC representation of a CosmosTx. It has the fields of the
+// RPCTransaction, zeroed when the Cosmos transactions have no equivalent, and the fee and messages
+// of the transaction.
+type RPCCosmosTransaction struct {
+	BlockHash        *common.Hash    `json:"blockHash"`
+	BlockNumber      *hexutil.Big    `json:"blockNumber"`
+	From             common.Address  `json:"from"`
+	Gas              hexutil.Uint64  `json:"gas"`
+	GasPrice         *hexutil.Big    `json:"gasPrice"`
+	Hash             common.Hash     `json:"hash"`
+	Input            hexutil.Bytes   `json:"input"`
+	Nonce            hexutil.Uint64  `json:"nonce"`
+	To               *common.Address `json:"to"`
+	TransactionIndex *hexutil.Uint64 `json:"transactionIndex"`
+	Value            *hexutil.Big    `json:"value"`
+	Type             hexutil.Uint64  `json:"type"`
+	V                *hexutil.Big    `json:"v"`
+	R                *hexutil.Big    `json:"r"`
+	S                *hexutil.Big    `json:"s"`
+	Fee              *hexutil.Big    `json:"fee"`
+	Messages         []string        `json:"messages"`
+}
+
+// NewRPCCosmosTransaction returns the JSON-RPC representation of a CosmosTx at the given index of
+// the transactions of a block.
+func NewRPCCosmosTransaction(tx *CosmosTx, blockHash common.Hash, blockNumber, index uint64) *RPCCosmosTransaction {
+	return &RPCCosmosTransaction{
+		BlockHash:        &blockHash,
+		BlockNumber:      (*hexutil.Big)(new(big.Int).SetUint64(blockNumber)),
+		From:             tx.From,
+		Gas:              hexutil.Uint64(tx.Gas),
+		GasPrice:         (*hexutil.Big)(tx.GasPrice()),
+		Hash:             tx.Hash,
+		Input:            hexutil.Bytes{},
+		Nonce:            hexutil.Uint64(tx.Nonce),
+		TransactionIndex: (*hexutil.Uint64)(&index),
+		Value:            (*hexutil.Big)(new(big.Int)),
+		Type:             hexutil.Uint64(CosmosTxType),
+		V:                (*hexutil.Big)(new(big.Int)),
+		R:                (*hexutil.Big)(new(big.Int)),
+		S:                (*hexutil.Big)(new(big.Int)),
+		Fee:              (*hexutil.Big)(tx.Fee),
+		Messages:         tx.Messages,
+	}
+}
+
+// RPCMarshalCosmosReceipt marshals the receipt of a CosmosTx at the given index of the transactions
+// of a block, in the format of RPCMarshalReceipt. The logs are the synthetic logs of the transaction,
+// if any.
+func RPCMarshalCosmosReceipt(
+	tx *CosmosTx,
+	blockHash common.Hash,
+	blockNumber, index, cumulativeGasUsed uint64,
+	logs []*ethtypes.Log,
+) map[string]interface{} {
+	if logs == nil {
+		logs = []*ethtypes.Log{}
+	}
+	status := ethtypes.ReceiptStatusSuccessful
+	if tx.Failed {
+		status = ethtypes.ReceiptStatusFailed
+	}
+	return map[string]interface{}{
+		"blockHash":         blockHash,
+		"blockNumber":       hexutil.Uint64(blockNumber),
+		"transactionHash":   tx.Hash,
+		"transactionIndex":  hexutil.Uint64(index),
+		"from":              tx.From,
+		"to":                nil,
+		"gasUsed":           hexutil.Uint64(tx.GasUsed),
+		"cumulativeGasUsed": hexutil.Uint64(cumulativeGasUsed),
+		"contractAddress":   nil,
+		"logs":              logs,
+		"logsBloom":         ethtypes.CreateBloom(&ethtypes.Receipt{Logs: logs}),
+		"type":              hexutil.Uint(CosmosTxType),
+		"effectiveGasPrice": (*hexutil.Big)(tx.GasPrice()),
+		"status":            hexutil.Uint(status),
+	}
+}
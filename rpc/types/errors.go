@@ -1,5 +1,36 @@
 package types
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
 
 var ErrProfilingDisabled = errors.New("profiling disabled in the debug namespace")
+
+// ErrCodeTxSyncTimeout is the JSON-RPC error code returned by
+// eth_sendRawTransactionSync when the transaction isn't included before the
+// timeout, as defined by EIP-7966.
+const ErrCodeTxSyncTimeout = 4
+
+// TxSyncTimeoutError is returned by eth_sendRawTransactionSync when the
+// transaction was submitted but its receipt isn't available before the
+// timeout. The hash of the transaction is returned as error data, so the
+// caller can keep polling the receipt.
+type TxSyncTimeoutError struct {
+	Hash    common.Hash
+	Timeout time.Duration
+}
+
+// Error implements the error interface.
+func (e *TxSyncTimeoutError) Error() string {
+	return fmt.Sprintf("the transaction was added to the mempool but wasn't processed within %s", e.Timeout)
+}
+
+// ErrorCode implements the go-ethereum rpc.Error interface.
+func (e *TxSyncTimeoutError) ErrorCode() int { return ErrCodeTxSyncTimeout }
+
+// ErrorData implements the go-ethereum rpc.DataError interface.
+func (e *TxSyncTimeoutError) ErrorData() interface{} { return e.Hash.Hex() }
@@ -5,7 +5,9 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/proto/tendermint/crypto"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
 
+	erc20types "github.com/cosmos/evm/x/erc20/types"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
@@ -17,18 +19,27 @@ import (
 //   - Transaction simulation
 //   - EVM module queries
 //   - Fee market module queries
+//   - ERC-20 module queries
 type QueryClient struct {
 	tx.ServiceClient
 	evmtypes.QueryClient
 	FeeMarket feemarkettypes.QueryClient
+	Erc20     erc20types.QueryClient
 }
 
 // NewQueryClient creates a new gRPC query client
 func NewQueryClient(clientCtx client.Context) *QueryClient {
+	return NewQueryClientFromConn(clientCtx)
+}
+
+// NewQueryClientFromConn creates a new gRPC query client sending the queries
+// to the given connection
+func NewQueryClientFromConn(conn gogogrpc.ClientConn) *QueryClient {
 	return &QueryClient{
-		ServiceClient: tx.NewServiceClient(clientCtx),
-		QueryClient:   evmtypes.NewQueryClient(clientCtx),
-		FeeMarket:     feemarkettypes.NewQueryClient(clientCtx),
+		ServiceClient: tx.NewServiceClient(conn),
+		QueryClient:   evmtypes.NewQueryClient(conn),
+		FeeMarket:     feemarkettypes.NewQueryClient(conn),
+		Erc20:         erc20types.NewQueryClient(conn),
 	}
 }
 
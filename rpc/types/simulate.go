@@ -0,0 +1,93 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// MaxSimulateBlocks is the maximum number of blocks (including the empty
+// blocks used to fill number gaps) that a single eth_simulateV1 request can
+// produce.
+const MaxSimulateBlocks = 256
+
+// Error codes returned by eth_simulateV1, as defined by the execution-apis
+// specification and implemented by go-ethereum.
+const (
+	SimErrCodeNonceTooLow         = -38010
+	SimErrCodeNonceTooHigh        = -38011
+	SimErrCodeBaseFeeTooLow       = -38012
+	SimErrCodeIntrinsicGas        = -38013
+	SimErrCodeInsufficientFunds   = -38014
+	SimErrCodeBlockGasLimit       = -38015
+	SimErrCodeBlockNumberInvalid  = -38020
+	SimErrCodeBlockTimestamp      = -38021
+	SimErrCodeClientLimitExceeded = -38026
+	SimErrCodeInvalidParams       = -32602
+	SimErrCodeReverted            = 3
+	SimErrCodeVMError             = -32015
+)
+
+// SimOpts are the inputs of eth_simulateV1.
+type SimOpts struct {
+	BlockStateCalls        []SimBlock `json:"blockStateCalls"`
+	TraceTransfers         bool       `json:"traceTransfers"`
+	Validation             bool       `json:"validation"`
+	ReturnFullTransactions bool       `json:"returnFullTransactions"`
+}
+
+// SimBlock is a batch of calls to be simulated sequentially on top of the
+// given block and state overrides.
+type SimBlock struct {
+	BlockOverrides *BlockOverrides            `json:"blockOverrides"`
+	StateOverrides *StateOverride             `json:"stateOverrides"`
+	Calls          []evmtypes.TransactionArgs `json:"calls"`
+}
+
+// BlockOverrides is a set of header fields to override for a simulated block.
+type BlockOverrides struct {
+	Number        *hexutil.Big    `json:"number"`
+	Time          *hexutil.Uint64 `json:"time"`
+	GasLimit      *hexutil.Uint64 `json:"gasLimit"`
+	FeeRecipient  *common.Address `json:"feeRecipient"`
+	PrevRandao    *common.Hash    `json:"prevRandao"`
+	BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas"`
+	BlobBaseFee   *hexutil.Big    `json:"blobBaseFee"`
+}
+
+// SimCallResult is the result of a simulated call.
+type SimCallResult struct {
+	ReturnValue hexutil.Bytes   `json:"returnData"`
+	Logs        []*ethtypes.Log `json:"logs"`
+	GasUsed     hexutil.Uint64  `json:"gasUsed"`
+	Status      hexutil.Uint64  `json:"status"`
+	Error       *SimCallError   `json:"error,omitempty"`
+}
+
+// SimCallError is the error of a simulated call that was executed but
+// failed, e.g. because it reverted.
+type SimCallError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+// SimBlockResult is the RPC representation of a simulated block. It contains
+// the header fields, the transactions built from the calls and the call
+// results.
+type SimBlockResult map[string]interface{}
+
+// SimError is returned when the simulation input is invalid. It carries the
+// JSON-RPC error code expected by eth_simulateV1 clients.
+type SimError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Error implements the error interface.
+func (e *SimError) Error() string { return e.Message }
+
+// ErrorCode implements the go-ethereum rpc.Error interface.
+func (e *SimError) ErrorCode() int { return e.Code }
@@ -0,0 +1,239 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var (
+	// NativeTokenAddress is the emitter of the synthetic Transfer logs of the EVM denom. It is the
+	// placeholder address commonly used for the native token of the EVM chains.
+	NativeTokenAddress = common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE")
+
+	// TransferTopic is the topic of the ERC-20 Transfer(address,address,uint256) event
+	TransferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+)
+
+// SyntheticLogs builds the synthetic ERC-20 Transfer logs of the bank transfers made by the
+// Cosmos transactions, which change the EVM balances without emitting any EVM log.
+//
+// The logs are clearly marked as synthetic: the transfers of the EVM denom are emitted by
+// NativeTokenAddress and the ones of the registered x/erc20 token pairs by their ERC-20 contract,
+// their transaction hash and index are the CometBFT hash and the pseudo-transaction index of the
+// Cosmos transaction, and their indexes follow the ones of the EVM logs of the block. The
+// transfers of the other denoms are skipped.
+type SyntheticLogs struct {
+	// emitters maps the denoms to the emitters of their logs
+	emitters map[string]common.Address
+	// addresses is the set of the emitters
+	addresses map[common.Address]bool
+}
+
+// NewSyntheticLogs returns a SyntheticLogs emitting the logs of the EVM denom and of the enabled
+// token pairs.
+func NewSyntheticLogs(evmDenom string, pairs []erc20types.TokenPair) *SyntheticLogs {
+	emitters := make(map[string]common.Address, len(pairs)+1)
+	for _, pair := range pairs {
+		if pair.Enabled {
+			emitters[pair.Denom] = pair.GetERC20Contract()
+		}
+	}
+	emitters[evmDenom] = NativeTokenAddress
+
+	addresses := make(map[common.Address]bool, len(emitters))
+	for _, emitter := range emitters {
+		addresses[emitter] = true
+	}
+	return &SyntheticLogs{emitters: emitters, addresses: addresses}
+}
+
+// CanMatch returns false if none of the synthetic logs can match the addresses and topics of a
+// filter, so that the blocks don't need to be scanned for them. The synthetic logs are Transfer
+// logs with 3 topics, emitted by NativeTokenAddress or the ERC-20 contracts of the token pairs.
+func (s *SyntheticLogs) CanMatch(addresses []common.Address, topics [][]common.Hash) bool {
+	if len(topics) > 3 {
+		return false
+	}
+	if len(topics) > 0 && len(topics[0]) > 0 && !slices.Contains(topics[0], TransferTopic) {
+		return false
+	}
+	if len(addresses) == 0 {
+		return true
+	}
+	for _, address := range addresses {
+		if s.addresses[address] {
+			return true
+		}
+	}
+	return false
+}
+
+// QueryTokenPairs returns all the token pairs registered in x/erc20 at the height of the context
+func QueryTokenPairs(ctx context.Context, client erc20types.QueryClient) ([]erc20types.TokenPair, error) {
+	var (
+		pairs   []erc20types.TokenPair
+		nextKey []byte
+	)
+	for {
+		res, err := client.TokenPairs(ctx, &erc20types.QueryTokenPairsRequest{
+			Pagination: &query.PageRequest{Key: nextKey},
+		})
+		if err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, res.TokenPairs...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return pairs, nil
+		}
+		nextKey = res.Pagination.NextKey
+	}
+}
+
+// TxLogs returns the synthetic logs of the bank transfers of a tx result, without their block
+// and tx fields. The Ethereum txs, which are recognized by their ethereum_tx events, and the
+// failed txs have none.
+func (s *SyntheticLogs) TxLogs(result *abci.ExecTxResult) ([]*ethtypes.Log, error) {
+	if result.Code != abci.CodeTypeOK {
+		return nil, nil
+	}
+	parsed, err := ParseTxResult(result, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(parsed.Txs) > 0 {
+		return nil, nil
+	}
+
+	var logs []*ethtypes.Log
+	for _, event := range result.Events {
+		if event.Type != banktypes.EventTypeTransfer {
+			continue
+		}
+
+		var sender, recipient, amount string
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case banktypes.AttributeKeySender:
+				sender = attr.Value
+			case banktypes.AttributeKeyRecipient:
+				recipient = attr.Value
+			case sdk.AttributeKeyAmount:
+				amount = attr.Value
+			}
+		}
+
+		if sender == "" || recipient == "" {
+			continue
+		}
+
+		coins, err := sdk.ParseCoinsNormalized(amount)
+		if err != nil {
+			return nil, fmt.Errorf("invalid transfer amount %q: %w", amount, err)
+		}
+		from, err := bech32ToAddress(sender)
+		if err != nil {
+			return nil, err
+		}
+		to, err := bech32ToAddress(recipient)
+		if err != nil {
+			return nil, err
+		}
+		for _, coin := range coins {
+			emitter, ok := s.emitters[coin.Denom]
+			if !ok {
+				continue
+			}
+			logs = append(logs, &ethtypes.Log{
+				Address: emitter,
+				Topics:  []common.Hash{TransferTopic, common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())},
+				Data:    common.LeftPadBytes(coin.Amount.BigInt().Bytes(), 32),
+			})
+		}
+	}
+	return logs, nil
+}
+
+// BlockLogs returns the synthetic logs of the txs of a block, one slice per tx with logs. Their
+// indexes follow the ones of the EVM logs of the block.
+//
+// The txIndexes map the positions of the Cosmos txs in the block to their pseudo-transaction
+// index, the number of Ethereum txs of the block plus their position among the Cosmos txs, which
+// is the TxIndex of their logs, so that it never collides with the index of an Ethereum tx. The
+// logs of the txs without pseudo-transaction are skipped. The txIndexes may be nil if the TxIndex
+// isn't used, like by the log index, in which case it is left to zero.
+func (s *SyntheticLogs) BlockLogs(
+	height int64,
+	blockHash common.Hash,
+	txs cmttypes.Txs,
+	results []*abci.ExecTxResult,
+	txIndexes map[int]uint,
+) ([][]*ethtypes.Log, error) {
+	if height < 0 {
+		return nil, fmt.Errorf("negative block height %d", height)
+	}
+	blockNumber := uint64(height)
+
+	var index uint
+	for _, result := range results {
+		evmLogs, err := evmtypes.DecodeTxLogs(result.Data, blockNumber)
+		if err != nil {
+			return nil, err
+		}
+		index += uint(len(evmLogs))
+	}
+
+	var blockLogs [][]*ethtypes.Log
+	for i, result := range results {
+		txIndex, ok := txIndexes[i]
+		if txIndexes != nil && !ok {
+			continue
+		}
+		logs, err := s.TxLogs(result)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build the synthetic logs of tx %d: %w", i, err)
+		}
+		if len(logs) == 0 {
+			continue
+		}
+
+		var txHash common.Hash
+		if i < len(txs) {
+			txHash = common.BytesToHash(txs[i].Hash())
+		}
+		for _, log := range logs {
+			log.BlockNumber = blockNumber
+			log.BlockHash = blockHash
+			log.TxHash = txHash
+			log.TxIndex = txIndex
+			log.Index = index
+			index++
+		}
+		blockLogs = append(blockLogs, logs)
+	}
+	return blockLogs, nil
+}
+
+// bech32ToAddress converts a bech32 account address of any prefix to an EVM address
+func bech32ToAddress(bech string) (common.Address, error) {
+	_, bz, err := bech32.DecodeAndConvert(bech)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("invalid bech32 address %q: %w", bech, err)
+	}
+	return common.BytesToAddress(bz), nil
+}
@@ -0,0 +1,142 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+func transferEvent(t *testing.T, from, to common.Address, amount string) abci.Event {
+	t.Helper()
+	sender, err := bech32.ConvertAndEncode("cosmos", from.Bytes())
+	require.NoError(t, err)
+	recipient, err := bech32.ConvertAndEncode("cosmos", to.Bytes())
+	require.NoError(t, err)
+	return abci.Event{Type: "transfer", Attributes: []abci.EventAttribute{
+		{Key: "recipient", Value: recipient},
+		{Key: "sender", Value: sender},
+		{Key: "amount", Value: amount},
+	}}
+}
+
+func TestSyntheticLogs(t *testing.T) {
+	alice := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	bob := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	token := common.HexToAddress("0x3000000000000000000000000000000000000003")
+
+	synthetic := NewSyntheticLogs("atest", []erc20types.TokenPair{
+		{Erc20Address: token.Hex(), Denom: "ibc/token", Enabled: true},
+		{Erc20Address: alice.Hex(), Denom: "ibc/disabled", Enabled: false},
+	})
+
+	evmLogs := []*evmtypes.Log{{Address: alice.Hex()}, {Address: bob.Hex()}}
+	evmData, err := (&sdk.TxMsgData{MsgResponses: []*codectypes.Any{
+		codectypes.UnsafePackAny(&evmtypes.MsgEthereumTxResponse{Logs: evmLogs}),
+	}}).Marshal()
+	require.NoError(t, err)
+
+	txs := cmttypes.Txs{[]byte("bank send"), []byte("eth tx"), []byte("failed"), []byte("other denom")}
+	results := []*abci.ExecTxResult{
+		{Events: []abci.Event{transferEvent(t, alice, bob, "100atest,7ibc/token,5ibc/disabled")}},
+		{
+			Data: evmData,
+			Events: []abci.Event{
+				// the fees of the Ethereum txs are paid with bank transfers too
+				transferEvent(t, alice, bob, "1atest"),
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: evmtypes.AttributeKeyEthereumTxHash, Value: common.Hash{1}.Hex()},
+					{Key: evmtypes.AttributeKeyTxIndex, Value: "0"},
+				}},
+			},
+		},
+		{Code: 5, Events: []abci.Event{transferEvent(t, alice, bob, "100atest")}},
+		{Events: []abci.Event{transferEvent(t, bob, alice, "3uother")}},
+	}
+
+	blockHash := common.Hash{0xb}
+	// the pseudo-transactions of the Cosmos txs follow the Ethereum tx
+	txIndexes := map[int]uint{0: 1, 2: 2, 3: 3}
+	blockLogs, err := synthetic.BlockLogs(10, blockHash, txs, results, txIndexes)
+	require.NoError(t, err)
+
+	txHash := common.BytesToHash(txs[0].Hash())
+	topics := []common.Hash{TransferTopic, common.BytesToHash(alice.Bytes()), common.BytesToHash(bob.Bytes())}
+	require.Equal(t, [][]*ethtypes.Log{{
+		{
+			Address:     NativeTokenAddress,
+			Topics:      topics,
+			Data:        common.LeftPadBytes(big.NewInt(100).Bytes(), 32),
+			BlockNumber: 10,
+			BlockHash:   blockHash,
+			TxHash:      txHash,
+			TxIndex:     1,
+			Index:       2, // after the logs of the Ethereum tx
+		},
+		{
+			Address:     token,
+			Topics:      topics,
+			Data:        common.LeftPadBytes(big.NewInt(7).Bytes(), 32),
+			BlockNumber: 10,
+			BlockHash:   blockHash,
+			TxHash:      txHash,
+			TxIndex:     1,
+			Index:       3,
+		},
+	}}, blockLogs)
+
+	// the txs without a pseudo-transaction are skipped
+	blockLogs, err = synthetic.BlockLogs(10, blockHash, txs, results, map[int]uint{2: 1, 3: 2})
+	require.NoError(t, err)
+	require.Empty(t, blockLogs)
+}
+
+func TestSyntheticLogsCanMatch(t *testing.T) {
+	token := common.HexToAddress("0x3000000000000000000000000000000000000003")
+	other := common.HexToAddress("0x4000000000000000000000000000000000000004")
+	synthetic := NewSyntheticLogs("atest", []erc20types.TokenPair{
+		{Erc20Address: token.Hex(), Denom: "ibc/token", Enabled: true},
+	})
+
+	testCases := []struct {
+		name      string
+		addresses []common.Address
+		topics    [][]common.Hash
+		expMatch  bool
+	}{
+		{"no criteria", nil, nil, true},
+		{"native token", []common.Address{NativeTokenAddress}, nil, true},
+		{"token pair", []common.Address{other, token}, [][]common.Hash{{TransferTopic}}, true},
+		{"any first topic", nil, [][]common.Hash{{}, {common.Hash{1}}}, true},
+		{"other emitter", []common.Address{other}, nil, false},
+		{"other topic", nil, [][]common.Hash{{common.Hash{1}}}, false},
+		{"too many topics", nil, [][]common.Hash{{}, {}, {}, {}}, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expMatch, synthetic.CanMatch(tc.addresses, tc.topics))
+		})
+	}
+}
+
+func TestSyntheticLogsInvalidEvent(t *testing.T) {
+	synthetic := NewSyntheticLogs("atest", nil)
+	_, err := synthetic.TxLogs(&abci.ExecTxResult{Events: []abci.Event{{Type: "transfer", Attributes: []abci.EventAttribute{
+		{Key: "recipient", Value: "cosmos1invalid"},
+		{Key: "sender", Value: "cosmos1invalid"},
+		{Key: "amount", Value: "1atest"},
+	}}}})
+	require.ErrorContains(t, err, "invalid bech32 address")
+}
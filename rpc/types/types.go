@@ -19,15 +19,18 @@ import (
 // Copied the Account and StorageResult types since they are registered under an
 // internal pkg on geth.
 
-// AccountResult struct for account proof
+// AccountResult struct for account proof. The proofs are lists of hex encoded
+// ICS23 proofs anchored to the app hash, the format is documented in the
+// rpc/proof package.
 type AccountResult struct {
-	Address      common.Address  `json:"address"`
-	AccountProof []string        `json:"accountProof"`
-	Balance      *hexutil.Big    `json:"balance"`
-	CodeHash     common.Hash     `json:"codeHash"`
-	Nonce        hexutil.Uint64  `json:"nonce"`
-	StorageHash  common.Hash     `json:"storageHash"`
-	StorageProof []StorageResult `json:"storageProof"`
+	Address       common.Address  `json:"address"`
+	AccountProof  []string        `json:"accountProof"`
+	Balance       *hexutil.Big    `json:"balance"`
+	CodeHash      common.Hash     `json:"codeHash"`
+	CodeHashProof []string        `json:"codeHashProof"`
+	Nonce         hexutil.Uint64  `json:"nonce"`
+	StorageHash   common.Hash     `json:"storageHash"`
+	StorageProof  []StorageResult `json:"storageProof"`
 }
 
 // StorageResult defines the format for storage proof return
@@ -189,3 +192,72 @@ type TraceConfig struct {
 	evmtypes.TraceConfig
 	TracerConfig json.RawMessage `json:"tracerConfig"`
 }
+
+// Address roles accepted by eth_getTransactionsByAddress.
+const (
+	AddressRoleSender          = "sender"
+	AddressRoleRecipient       = "recipient"
+	AddressRoleContractCreated = "contractCreated"
+	AddressRoleLogEmitter      = "logEmitter"
+)
+
+// TxCursor is the position of a transaction in the chain, used to paginate
+// eth_getTransactionsByAddress.
+type TxCursor struct {
+	BlockNumber      hexutil.Uint64 `json:"blockNumber"`
+	TransactionIndex hexutil.Uint64 `json:"transactionIndex"`
+}
+
+// TransactionsByAddressArgs are the arguments of eth_getTransactionsByAddress.
+type TransactionsByAddressArgs struct {
+	// Roles filters the roles of the address in the txs, all roles if empty.
+	Roles []string `json:"roles,omitempty"`
+	// Cursor is the position to start from, included. It defaults to the
+	// first tx, or the last one if Reverse is set.
+	Cursor   *TxCursor       `json:"cursor,omitempty"`
+	Reverse  bool            `json:"reverse,omitempty"`
+	PageSize *hexutil.Uint64 `json:"pageSize,omitempty"`
+}
+
+// TransactionsByAddressResult is a page of the txs of an address.
+type TransactionsByAddressResult struct {
+	Transactions []*RPCTransaction `json:"transactions"`
+	// NextCursor is the position of the next page, nil if there is none.
+	NextCursor *TxCursor `json:"nextCursor"`
+}
+
+// StorageRangeResult is the result of debug_storageRangeAt. The storage
+// entries are keyed by the keccak256 hash of their key like in geth, NextKey
+// is the raw key of the next entry to pass as keyStart of the next page.
+type StorageRangeResult struct {
+	Storage StorageMap   `json:"storage"`
+	NextKey *common.Hash `json:"nextKey"` // nil if Storage includes the last key in the contract.
+}
+
+// StorageMap maps the hash of the storage keys to their entry.
+type StorageMap map[common.Hash]StorageEntry
+
+// StorageEntry is a storage slot and its value.
+type StorageEntry struct {
+	Key   *common.Hash `json:"key"`
+	Value common.Hash  `json:"value"`
+}
+
+// AccountRangeResult is the result of debug_accountRange, in the format of
+// geth's state dump. Next is the address of the next account to pass as start
+// of the next page.
+type AccountRangeResult struct {
+	Root     common.Hash                    `json:"root"`
+	Accounts map[common.Address]DumpAccount `json:"accounts"`
+	Next     hexutil.Bytes                  `json:"next,omitempty"` // nil if no more accounts
+}
+
+// DumpAccount is an account of the debug_accountRange result.
+type DumpAccount struct {
+	Balance  string                 `json:"balance"`
+	Nonce    uint64                 `json:"nonce"`
+	CodeHash hexutil.Bytes          `json:"codeHash"`
+	Code     hexutil.Bytes          `json:"code,omitempty"`
+	Storage  map[common.Hash]string `json:"storage,omitempty"`
+	Address  *common.Address        `json:"address,omitempty"`
+}
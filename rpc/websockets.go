@@ -14,6 +14,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -23,8 +24,14 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/pkg/errors"
 
+	evmmempool "github.com/cosmos/evm/mempool"
+	"github.com/cosmos/evm/rpc/audit"
+	"github.com/cosmos/evm/rpc/auth"
+	"github.com/cosmos/evm/rpc/middleware"
 	rpcfilters "github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters"
+	"github.com/cosmos/evm/rpc/ratelimit"
 	"github.com/cosmos/evm/rpc/stream"
+	rpctypes "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/server/config"
 
 	"cosmossdk.io/log"
@@ -76,9 +83,28 @@ type websocketsServer struct {
 	allowedOrigins []string // allowed origins for WebSocket connections
 	api            *pubSubAPI
 	logger         log.Logger
+	// limiter rate limits the requests, nil if rate limiting is disabled
+	limiter *ratelimit.Limiter
+	// auth authorizes the requests, nil if authorization is disabled
+	auth *auth.Authenticator
+	// forwardToken marks the requests forwarded to the HTTP server, which
+	// were already checked by the limiter and auth
+	forwardToken middleware.ForwardToken
+	// audit logs the requests, nil if the audit log is disabled
+	audit *audit.Logger
 }
 
-func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, stream *stream.RPCStream, cfg *config.Config) WebsocketsServer {
+func NewWebsocketsServer(
+	clientCtx client.Context,
+	logger log.Logger,
+	stream *stream.RPCStream,
+	cfg *config.Config,
+	mempool *evmmempool.ExperimentalEVMMempool,
+	limiter *ratelimit.Limiter,
+	authenticator *auth.Authenticator,
+	forwardToken middleware.ForwardToken,
+	auditLogger *audit.Logger,
+) WebsocketsServer {
 	logger = logger.With("api", "websocket-server")
 	return &websocketsServer{
 		rpcAddr:        cfg.JSONRPC.Address,
@@ -86,8 +112,12 @@ func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, stream *st
 		certFile:       cfg.TLS.CertificatePath,
 		keyFile:        cfg.TLS.KeyPath,
 		allowedOrigins: cfg.JSONRPC.WSOrigins,
-		api:            newPubSubAPI(clientCtx, logger, stream),
+		api:            newPubSubAPI(clientCtx, logger, stream, mempool),
 		logger:         logger,
+		limiter:        limiter,
+		auth:           authenticator,
+		forwardToken:   forwardToken,
+		audit:          auditLogger,
 	}
 }
 
@@ -181,6 +211,21 @@ func (s *websocketsServer) checkOrigin(r *http.Request) bool {
 }
 
 func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// the credentials are checked once, when the connection is opened
+	var perms auth.Permissions
+	if s.auth != nil {
+		var (
+			identity string
+			err      error
+		)
+		perms, identity, err = s.auth.Authorize(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		r = middleware.WithClient(r, identity)
+	}
+
 	upgrader := websocket.Upgrader{
 		CheckOrigin: s.checkOrigin,
 	}
@@ -198,10 +243,15 @@ func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		conn: conn,
 	}
 
-	s.readLoop(ws)
+	client := wsClient{ip: middleware.RemoteIP(r), perms: perms}
+	if s.limiter != nil {
+		client.key = s.limiter.ClientKey(r)
+	}
+
+	s.readLoop(ws, client)
 }
 
-func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
+func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) []byte {
 	res := &ErrorResponseJSON{
 		Jsonrpc: "2.0",
 		Error: &ErrorMessageJSON{
@@ -211,7 +261,8 @@ func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
 		ID: nil,
 	}
 
-	_ = wsConn.WriteJSON(res) // #nosec G703
+	bz, _ := wsConn.writeResponse(res) // #nosec G703
+	return bz
 }
 
 type wsConn struct {
@@ -226,6 +277,15 @@ func (w *wsConn) WriteJSON(v any) error {
 	return w.conn.WriteJSON(v)
 }
 
+// writeResponse writes the response and returns its JSON encoding
+func (w *wsConn) writeResponse(v any) ([]byte, error) {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return bz, w.WriteJSON(json.RawMessage(bz))
+}
+
 func (w *wsConn) Close() error {
 	w.mux.Lock()
 	defer w.mux.Unlock()
@@ -239,7 +299,18 @@ func (w *wsConn) ReadMessage() (messageType int, p []byte, err error) {
 	return w.conn.ReadMessage()
 }
 
-func (s *websocketsServer) readLoop(wsConn *wsConn) {
+// wsClient is the client of a connection
+type wsClient struct {
+	// key is the key of the client in the rate limiter
+	key string
+	// ip is the remote IP of the client
+	ip string
+	// perms are the permissions of the client
+	perms auth.Permissions
+}
+
+// readLoop handles the messages of the connection until it is closed
+func (s *websocketsServer) readLoop(wsConn *wsConn, client wsClient) {
 	// subscriptions of current connection
 	subscriptions := make(map[rpc.ID]context.CancelFunc)
 	defer func() {
@@ -250,7 +321,6 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 		}
 	}()
 
-readLoop:
 	for {
 		_, mb, err := wsConn.ReadMessage()
 		if err != nil {
@@ -259,155 +329,190 @@ readLoop:
 			return
 		}
 
-		if isBatch(mb) {
-			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
-				s.sendErrResponse(wsConn, err.Error())
-			}
-			continue
+		start := time.Now()
+		res, err := s.handleMessage(wsConn, client, subscriptions, mb)
+		if s.audit != nil && s.audit.Sample() {
+			s.audit.Log(audit.TransportWS, client.ip, mb, res, len(res), time.Since(start))
 		}
-
-		var msg map[string]any
-		if err = json.Unmarshal(mb, &msg); err != nil {
-			s.sendErrResponse(wsConn, err.Error())
-			continue
+		if err != nil {
+			s.logger.Error("error writing response, breaking read loop", "error", err.Error())
+			return
 		}
+	}
+}
 
-		// check if method == eth_subscribe or eth_unsubscribe
-		method, ok := msg["method"].(string)
-		if !ok {
-			// otherwise, call the usual rpc server to respond
-			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
-				s.sendErrResponse(wsConn, err.Error())
+// handleMessage handles a message of the connection and returns the response
+// written to the client. It returns an error if the response can't be written.
+func (s *websocketsServer) handleMessage(
+	wsConn *wsConn,
+	client wsClient,
+	subscriptions map[rpc.ID]context.CancelFunc,
+	mb []byte,
+) ([]byte, error) {
+	if s.auth != nil {
+		if res, ok := client.perms.Check(mb); !ok {
+			if err := wsConn.WriteJSON(json.RawMessage(res)); err != nil {
+				return res, errors.Wrap(err, "error writing unauthorized response")
 			}
-
-			continue
+			return res, nil
 		}
+	}
 
-		var connID float64
-		switch id := msg["id"].(type) {
-		case string:
-			connID, err = strconv.ParseFloat(id, 64)
-		case float64:
-			connID = id
-		default:
-			err = fmt.Errorf("unknown type")
+	if s.limiter != nil && !s.limiter.AllowWS(client.key, mb) {
+		res := ratelimit.ErrorResponse(mb)
+		if err := wsConn.WriteJSON(json.RawMessage(res)); err != nil {
+			return res, errors.Wrap(err, "error writing rate limit response")
 		}
+		return res, nil
+	}
+
+	if isBatch(mb) {
+		res, err := s.tcpGetAndSendResponse(wsConn, mb)
 		if err != nil {
-			s.sendErrResponse(
-				wsConn,
-				fmt.Errorf("invalid type for connection ID: %T", msg["id"]).Error(),
-			)
-			continue
+			return s.sendErrResponse(wsConn, err.Error()), nil
 		}
+		return res, nil
+	}
 
-		switch method {
-		case "eth_subscribe":
-			params, ok := s.getParamsAndCheckValid(msg, wsConn)
-			if !ok {
-				continue
-			}
+	var msg map[string]any
+	if err := json.Unmarshal(mb, &msg); err != nil {
+		return s.sendErrResponse(wsConn, err.Error()), nil
+	}
 
-			subID := rpc.NewID()
-			unsubFn, err := s.api.subscribe(wsConn, subID, params)
-			if err != nil {
-				s.sendErrResponse(wsConn, err.Error())
-				continue
-			}
-			subscriptions[subID] = unsubFn
+	// check if method == eth_subscribe or eth_unsubscribe
+	method, ok := msg["method"].(string)
+	if !ok {
+		// otherwise, call the usual rpc server to respond
+		res, err := s.tcpGetAndSendResponse(wsConn, mb)
+		if err != nil {
+			return s.sendErrResponse(wsConn, err.Error()), nil
+		}
+		return res, nil
+	}
 
-			res := &SubscriptionResponseJSON{
-				Jsonrpc: "2.0",
-				ID:      connID,
-				Result:  subID,
-			}
+	var connID float64
+	var err error
+	switch id := msg["id"].(type) {
+	case string:
+		connID, err = strconv.ParseFloat(id, 64)
+	case float64:
+		connID = id
+	default:
+		err = fmt.Errorf("unknown type")
+	}
+	if err != nil {
+		return s.sendErrResponse(
+			wsConn,
+			fmt.Errorf("invalid type for connection ID: %T", msg["id"]).Error(),
+		), nil
+	}
 
-			if err := wsConn.WriteJSON(res); err != nil {
-				s.logger.Error("error writing subscription response", "error", err.Error())
-				break readLoop
-			}
-		case "eth_unsubscribe":
-			params, ok := s.getParamsAndCheckValid(msg, wsConn)
-			if !ok {
-				continue
-			}
+	switch method {
+	case "eth_subscribe":
+		params, res, ok := s.getParamsAndCheckValid(msg, wsConn)
+		if !ok {
+			return res, nil
+		}
 
-			id, ok := params[0].(string)
-			if !ok {
-				s.sendErrResponse(wsConn, "invalid parameters")
-				continue
-			}
+		subID := rpc.NewID()
+		unsubFn, err := s.api.subscribe(wsConn, subID, params)
+		if err != nil {
+			return s.sendErrResponse(wsConn, err.Error()), nil
+		}
+		subscriptions[subID] = unsubFn
 
-			subID := rpc.ID(id)
-			unsubFn, ok := subscriptions[subID]
-			if ok {
-				delete(subscriptions, subID)
-				unsubFn()
-			}
+		res, err = wsConn.writeResponse(&SubscriptionResponseJSON{
+			Jsonrpc: "2.0",
+			ID:      connID,
+			Result:  subID,
+		})
+		if err != nil {
+			return res, errors.Wrap(err, "error writing subscription response")
+		}
+		return res, nil
+	case "eth_unsubscribe":
+		params, res, ok := s.getParamsAndCheckValid(msg, wsConn)
+		if !ok {
+			return res, nil
+		}
 
-			res := &SubscriptionResponseJSON{
-				Jsonrpc: "2.0",
-				ID:      connID,
-				Result:  ok,
-			}
+		id, ok := params[0].(string)
+		if !ok {
+			return s.sendErrResponse(wsConn, "invalid parameters"), nil
+		}
 
-			if err := wsConn.WriteJSON(res); err != nil {
-				s.logger.Error("error writing unsubscribe response", "error", err.Error())
-				break readLoop
-			}
-		default:
-			// otherwise, call the usual rpc server to respond
-			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
-				s.sendErrResponse(wsConn, err.Error())
-			}
+		subID := rpc.ID(id)
+		unsubFn, ok := subscriptions[subID]
+		if ok {
+			delete(subscriptions, subID)
+			unsubFn()
+		}
+
+		res, err = wsConn.writeResponse(&SubscriptionResponseJSON{
+			Jsonrpc: "2.0",
+			ID:      connID,
+			Result:  ok,
+		})
+		if err != nil {
+			return res, errors.Wrap(err, "error writing unsubscribe response")
+		}
+		return res, nil
+	default:
+		// otherwise, call the usual rpc server to respond
+		res, err := s.tcpGetAndSendResponse(wsConn, mb)
+		if err != nil {
+			return s.sendErrResponse(wsConn, err.Error()), nil
 		}
+		return res, nil
 	}
 }
 
-// tcpGetAndSendResponse sends error response to client if params is invalid
-func (s *websocketsServer) getParamsAndCheckValid(msg map[string]any, wsConn *wsConn) ([]any, bool) {
+// getParamsAndCheckValid sends error response to client if params is invalid, and returns it
+func (s *websocketsServer) getParamsAndCheckValid(msg map[string]any, wsConn *wsConn) ([]any, []byte, bool) {
 	params, ok := msg["params"].([]any)
 	if !ok {
-		s.sendErrResponse(wsConn, "invalid parameters")
-		return nil, false
+		return nil, s.sendErrResponse(wsConn, "invalid parameters"), false
 	}
 
 	if len(params) == 0 {
-		s.sendErrResponse(wsConn, "empty parameters")
-		return nil, false
+		return nil, s.sendErrResponse(wsConn, "empty parameters"), false
 	}
 
-	return params, true
+	return params, nil, true
 }
 
 // tcpGetAndSendResponse connects to the rest-server over tcp, posts a JSON-RPC request, and sends the response
-// to the client over websockets
-func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) error {
+// to the client over websockets. It returns the response.
+func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) ([]byte, error) {
 	req, err := http.NewRequestWithContext(context.Background(), "POST", "http://"+s.rpcAddr, bytes.NewBuffer(mb))
 	if err != nil {
-		return errors.Wrap(err, "Could not build request")
+		return nil, errors.Wrap(err, "Could not build request")
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	if s.forwardToken != "" {
+		s.forwardToken.Mark(req)
+	}
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
-		return errors.Wrap(err, "Could not perform request")
+		return nil, errors.Wrap(err, "Could not perform request")
 	}
 
 	defer resp.Body.Close()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return errors.Wrap(err, "could not read body from response")
+		return nil, errors.Wrap(err, "could not read body from response")
 	}
 
 	var wsSend any
 	err = json.Unmarshal(body, &wsSend)
 	if err != nil {
-		return errors.Wrap(err, "failed to unmarshal rest-server response")
+		return nil, errors.Wrap(err, "failed to unmarshal rest-server response")
 	}
 
-	return wsConn.WriteJSON(wsSend)
+	return body, wsConn.WriteJSON(wsSend)
 }
 
 // pubSubAPI is the eth_ prefixed set of APIs in the Web3 JSON-RPC spec
@@ -415,15 +520,18 @@ type pubSubAPI struct {
 	events    *stream.RPCStream
 	logger    log.Logger
 	clientCtx client.Context
+	// mempool is the source of the full pending txs, nil if the EVM mempool is disabled
+	mempool *evmmempool.ExperimentalEVMMempool
 }
 
 // newPubSubAPI creates an instance of the ethereum PubSub API.
-func newPubSubAPI(clientCtx client.Context, logger log.Logger, stream *stream.RPCStream) *pubSubAPI {
+func newPubSubAPI(clientCtx client.Context, logger log.Logger, stream *stream.RPCStream, mempool *evmmempool.ExperimentalEVMMempool) *pubSubAPI {
 	logger = logger.With("module", "websocket-client")
 	return &pubSubAPI{
 		events:    stream,
 		logger:    logger,
 		clientCtx: clientCtx,
+		mempool:   mempool,
 	}
 }
 
@@ -443,7 +551,10 @@ func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []any) (con
 		}
 		return api.subscribeLogs(wsConn, subID, nil)
 	case "newPendingTransactions":
-		return api.subscribePendingTransactions(wsConn, subID)
+		if len(params) > 1 {
+			return api.subscribePendingTransactions(wsConn, subID, params[1])
+		}
+		return api.subscribePendingTransactions(wsConn, subID, nil)
 	case "syncing":
 		return api.subscribeSyncing(wsConn, subID)
 	default:
@@ -626,7 +737,107 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra any) (co
 	return cancel, nil
 }
 
-func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID) (context.CancelFunc, error) {
+// pendingTxCriteria are the options of the newPendingTransactions subscription.
+// They are either the geth full tx flag, or an object with the fields:
+//
+//	{"fullTx": bool, "from": address or [address], "to": address or [address]}
+type pendingTxCriteria struct {
+	FullTx bool
+	From   []common.Address
+	To     []common.Address
+}
+
+// parsePendingTxCriteria parses the extra param of the newPendingTransactions
+// subscription.
+func parsePendingTxCriteria(extra any) (pendingTxCriteria, error) {
+	var crit pendingTxCriteria
+	switch params := extra.(type) {
+	case nil:
+	case bool:
+		crit.FullTx = params
+	case map[string]any:
+		if params["fullTx"] != nil {
+			fullTx, ok := params["fullTx"].(bool)
+			if !ok {
+				return crit, errors.New("invalid fullTx; must be a boolean")
+			}
+			crit.FullTx = fullTx
+		}
+
+		var err error
+		if crit.From, err = parseAddresses(params["from"]); err != nil {
+			return crit, errors.Wrap(err, "invalid from")
+		}
+		if crit.To, err = parseAddresses(params["to"]); err != nil {
+			return crit, errors.Wrap(err, "invalid to")
+		}
+	default:
+		return crit, errors.New("invalid parameters; must be a boolean or an object")
+	}
+	return crit, nil
+}
+
+// parseAddresses parses an address or an array of addresses.
+func parseAddresses(v any) ([]common.Address, error) {
+	switch addresses := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		if !common.IsHexAddress(addresses) {
+			return nil, errors.Errorf("invalid address %s", addresses)
+		}
+		return []common.Address{common.HexToAddress(addresses)}, nil
+	case []any:
+		res := make([]common.Address, 0, len(addresses))
+		for _, addr := range addresses {
+			address, ok := addr.(string)
+			if !ok || !common.IsHexAddress(address) {
+				return nil, errors.New("invalid address")
+			}
+			res = append(res, common.HexToAddress(address))
+		}
+		return res, nil
+	default:
+		return nil, errors.New("must be address or array of addresses")
+	}
+}
+
+// hasFilters returns true if the criteria filter the txs by address.
+func (c pendingTxCriteria) hasFilters() bool {
+	return len(c.From) > 0 || len(c.To) > 0
+}
+
+// match returns true if the tx matches the address filters. Each non empty
+// filter must match one of its addresses.
+func (c pendingTxCriteria) match(tx *rpctypes.RPCTransaction) bool {
+	if len(c.From) > 0 && !containsAddress(c.From, tx.From) {
+		return false
+	}
+	if len(c.To) > 0 && (tx.To == nil || !containsAddress(c.To, *tx.To)) {
+		return false
+	}
+	return true
+}
+
+func containsAddress(addresses []common.Address, address common.Address) bool {
+	for _, addr := range addresses {
+		if addr == address {
+			return true
+		}
+	}
+	return false
+}
+
+func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID, extra any) (context.CancelFunc, error) {
+	crit, err := parsePendingTxCriteria(extra)
+	if err != nil {
+		api.logger.Debug("invalid pending tx criteria", "type", fmt.Sprintf("%T", extra), "error", err.Error())
+		return nil, err
+	}
+	if crit.FullTx || crit.hasFilters() {
+		return api.subscribeTxPoolTransactions(wsConn, subID, crit)
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	//nolint: errcheck
 	go api.events.PendingTxStream().Subscribe(ctx, func(items []common.Hash, _ int) error {
@@ -659,6 +870,58 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 	return cancel, nil
 }
 
+// subscribeTxPoolTransactions streams the txs promoted to pending in the EVM
+// mempool, as full txs or hashes, filtered by the criteria.
+func (api *pubSubAPI) subscribeTxPoolTransactions(wsConn *wsConn, subID rpc.ID, crit pendingTxCriteria) (context.CancelFunc, error) {
+	txStream := api.events.PendingFullTxStream()
+	if txStream == nil || api.mempool == nil {
+		return nil, errors.New("full and filtered pending transactions require the EVM mempool")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	//nolint: errcheck
+	go txStream.Subscribe(ctx, func(txs []*ethtypes.Transaction, _ int) error {
+		blockchain := api.mempool.GetBlockchain()
+		header := blockchain.CurrentBlock()
+		for _, tx := range txs {
+			rpcTx := rpctypes.NewRPCPendingTransaction(tx, header, blockchain.Config())
+			if !crit.match(rpcTx) {
+				continue
+			}
+
+			var result any = rpcTx
+			if !crit.FullTx {
+				result = rpcTx.Hash
+			}
+
+			// write to ws conn
+			res := &SubscriptionNotification{
+				Jsonrpc: "2.0",
+				Method:  "eth_subscription",
+				Params: &SubscriptionResult{
+					Subscription: subID,
+					Result:       result,
+				},
+			}
+
+			err := wsConn.WriteJSON(res)
+			if err != nil {
+				api.logger.Debug("error writing pending tx, will drop peer", "error", err.Error())
+
+				try(func() {
+					if err != websocket.ErrCloseSent {
+						_ = wsConn.Close()
+					}
+				}, api.logger, "closing websocket peer sub")
+				return err
+			}
+		}
+		return nil
+	})
+
+	return cancel, nil
+}
+
 func (api *pubSubAPI) subscribeSyncing(_ *wsConn, _ rpc.ID) (context.CancelFunc, error) {
 	return nil, errors.New("syncing subscription is not implemented")
 }
@@ -1,18 +1,28 @@
 package rpc
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/evm/rpc/audit"
+	"github.com/cosmos/evm/rpc/auth"
+	"github.com/cosmos/evm/rpc/ratelimit"
 	"github.com/cosmos/evm/rpc/stream"
+	rpctypes "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/server/config"
 
 	"cosmossdk.io/log"
@@ -33,7 +43,7 @@ func newTestWebsocketServer() *websocketsServer {
 		wsAddr:         cfg.JSONRPC.WsAddress,
 		certFile:       cfg.TLS.CertificatePath,
 		keyFile:        cfg.TLS.KeyPath,
-		api:            newPubSubAPI(client.Context{}, log.NewNopLogger(), &stream.RPCStream{}),
+		api:            newPubSubAPI(client.Context{}, log.NewNopLogger(), &stream.RPCStream{}, nil),
 		logger:         log.NewNopLogger(),
 		allowedOrigins: []string{"*"},
 	}
@@ -64,6 +74,184 @@ func TestWebsocketPayloadLimit(t *testing.T) {
 	require.Error(t, readErr, "expected connection to close on oversized message")
 }
 
+func TestWebsocketRateLimit(t *testing.T) {
+	srv := newTestWebsocketServer()
+	cfg := config.DefaultRateLimitConfig()
+	cfg.Enable = true
+	cfg.WSBurst = 2
+	cfg.DefaultCost = 1
+	srv.limiter = ratelimit.New(cfg)
+
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	u.Scheme = "ws"
+
+	conn, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	unsubscribe := map[string]any{"jsonrpc": "2.0", "id": 1, "method": "eth_unsubscribe", "params": []string{"0x1"}}
+	for i := 0; i < 2; i++ {
+		require.NoError(t, conn.WriteJSON(unsubscribe))
+		var res SubscriptionResponseJSON
+		require.NoError(t, conn.ReadJSON(&res))
+		require.Equal(t, false, res.Result)
+	}
+
+	require.NoError(t, conn.WriteJSON(unsubscribe))
+	var res map[string]any
+	require.NoError(t, conn.ReadJSON(&res))
+	require.Equal(t, map[string]any{
+		"jsonrpc": "2.0",
+		"id":      float64(1),
+		"error":   map[string]any{"code": float64(ratelimit.ErrCodeLimitExceeded), "message": ratelimit.ErrMsgLimitExceeded},
+	}, res)
+}
+
+func TestWebsocketAuth(t *testing.T) {
+	srv := newTestWebsocketServer()
+	cfg := config.DefaultAuthConfig()
+	cfg.Enable = true
+	cfg.PublicAllow = []string{"eth"}
+	cfg.APIKeys = []config.APIKeyConfig{{Key: "tooling", Allow: []string{"*"}}}
+	authenticator, err := auth.New(cfg)
+	require.NoError(t, err)
+	srv.auth = authenticator
+
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	u.Scheme = "ws"
+
+	// invalid credentials are rejected when the connection is opened
+	_, httpResp, err := websocket.DefaultDialer.Dial(u.String(), http.Header{"Authorization": []string{"Bearer unknown"}})
+	require.Error(t, err)
+	require.Equal(t, http.StatusUnauthorized, httpResp.StatusCode)
+	httpResp.Body.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	require.NoError(t, conn.WriteJSON(map[string]any{"jsonrpc": "2.0", "id": 1, "method": "eth_unsubscribe", "params": []string{"0x1"}}))
+	var unsubRes SubscriptionResponseJSON
+	require.NoError(t, conn.ReadJSON(&unsubRes))
+	require.Equal(t, false, unsubRes.Result)
+
+	require.NoError(t, conn.WriteJSON(map[string]any{"jsonrpc": "2.0", "id": 2, "method": "debug_traceTransaction", "params": []string{"0x1"}}))
+	var res map[string]any
+	require.NoError(t, conn.ReadJSON(&res))
+	require.Equal(t, map[string]any{
+		"jsonrpc": "2.0",
+		"id":      float64(2),
+		"error":   map[string]any{"code": float64(auth.ErrCodeUnauthorized), "message": "method debug_traceTransaction is not allowed"},
+	}, res)
+}
+
+func TestWebsocketAuthRateLimit(t *testing.T) {
+	srv := newTestWebsocketServer()
+	authCfg := config.DefaultAuthConfig()
+	authCfg.Enable = true
+	authCfg.PublicAllow = []string{"eth"}
+	authCfg.APIKeys = []config.APIKeyConfig{{Key: "tooling", Allow: []string{"*"}}}
+	authenticator, err := auth.New(authCfg)
+	require.NoError(t, err)
+	srv.auth = authenticator
+	limitCfg := config.DefaultRateLimitConfig()
+	limitCfg.Enable = true
+	limitCfg.WSBurst = 1
+	limitCfg.DefaultCost = 1
+	srv.limiter = ratelimit.New(limitCfg)
+
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	u.Scheme = "ws"
+
+	call := func(conn *websocket.Conn, method string) map[string]any {
+		require.NoError(t, conn.WriteJSON(map[string]any{"jsonrpc": "2.0", "id": 1, "method": method, "params": []string{"0x1"}}))
+		var res map[string]any
+		require.NoError(t, conn.ReadJSON(&res))
+		return res
+	}
+
+	public, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
+	require.NoError(t, err)
+	defer public.Close()
+
+	// the unauthorized calls are rejected before being charged to the budget
+	res := call(public, "debug_traceTransaction")
+	require.Equal(t, float64(auth.ErrCodeUnauthorized), res["error"].(map[string]any)["code"])
+	res = call(public, "eth_unsubscribe")
+	require.Equal(t, false, res["result"])
+	res = call(public, "eth_unsubscribe")
+	require.Equal(t, float64(ratelimit.ErrCodeLimitExceeded), res["error"].(map[string]any)["code"])
+
+	// the authenticated client has its own budget, though it has the same IP
+	keyed, _, err := websocket.DefaultDialer.Dial(u.String(), http.Header{"Authorization": []string{"Bearer tooling"}})
+	require.NoError(t, err)
+	defer keyed.Close()
+	res = call(keyed, "eth_unsubscribe")
+	require.Equal(t, false, res["result"])
+}
+
+// syncBuffer is a bytes.Buffer safe for concurrent use
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestWebsocketAuditLog(t *testing.T) {
+	srv := newTestWebsocketServer()
+	cfg := config.DefaultAuditLogConfig()
+	cfg.Enable = true
+	cfg.RecordResponses = true
+	buf := &syncBuffer{}
+	srv.audit = audit.New(cfg, slog.NewJSONHandler(buf, nil))
+
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	u.Scheme = "ws"
+
+	conn, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
+	require.NoError(t, err)
+
+	require.NoError(t, conn.WriteJSON(map[string]any{"jsonrpc": "2.0", "id": 1, "method": "eth_unsubscribe", "params": []string{"0x1"}}))
+	var res SubscriptionResponseJSON
+	require.NoError(t, conn.ReadJSON(&res))
+	require.NoError(t, conn.Close())
+
+	// the record is logged once the response is written
+	var records []audit.Record
+	require.Eventually(t, func() bool {
+		records, err = audit.ReadRecords(strings.NewReader(buf.String()))
+		return err == nil && len(records) > 0
+	}, time.Second, 10*time.Millisecond)
+	require.Equal(t, []audit.Record{{
+		Method:   "eth_unsubscribe",
+		Params:   json.RawMessage(`["0x1"]`),
+		Response: json.RawMessage(`{"jsonrpc":"2.0","result":false,"id":1}`),
+	}}, records)
+}
+
 func TestCheckOrigin(t *testing.T) {
 	logger := log.NewNopLogger()
 	tests := []struct {
@@ -261,3 +449,85 @@ func TestSanitizeOriginForLogging(t *testing.T) {
 		})
 	}
 }
+
+func TestPendingTxCriteria(t *testing.T) {
+	var (
+		alice = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		bob   = common.HexToAddress("0x2000000000000000000000000000000000000002")
+		carol = common.HexToAddress("0x3000000000000000000000000000000000000003")
+	)
+
+	testCases := []struct {
+		name    string
+		extra   any
+		exp     pendingTxCriteria
+		expErr  bool
+		matches []*rpctypes.RPCTransaction
+		misses  []*rpctypes.RPCTransaction
+	}{
+		{
+			name:    "no params streams hashes",
+			extra:   nil,
+			exp:     pendingTxCriteria{},
+			matches: []*rpctypes.RPCTransaction{{From: alice, To: &bob}, {From: bob}},
+		},
+		{
+			name:  "geth full tx flag",
+			extra: true,
+			exp:   pendingTxCriteria{FullTx: true},
+		},
+		{
+			name:    "from filter",
+			extra:   map[string]any{"fullTx": true, "from": alice.Hex()},
+			exp:     pendingTxCriteria{FullTx: true, From: []common.Address{alice}},
+			matches: []*rpctypes.RPCTransaction{{From: alice, To: &bob}, {From: alice}},
+			misses:  []*rpctypes.RPCTransaction{{From: bob, To: &alice}},
+		},
+		{
+			name:    "from and to filters",
+			extra:   map[string]any{"from": []any{alice.Hex(), bob.Hex()}, "to": []any{carol.Hex()}},
+			exp:     pendingTxCriteria{From: []common.Address{alice, bob}, To: []common.Address{carol}},
+			matches: []*rpctypes.RPCTransaction{{From: bob, To: &carol}},
+			misses:  []*rpctypes.RPCTransaction{{From: alice, To: &bob}, {From: alice}, {From: carol, To: &carol}},
+		},
+		{
+			name:   "invalid flag",
+			extra:  "true",
+			expErr: true,
+		},
+		{
+			name:   "invalid fullTx",
+			extra:  map[string]any{"fullTx": "yes"},
+			expErr: true,
+		},
+		{
+			name:   "invalid address",
+			extra:  map[string]any{"to": []any{"0x01"}},
+			expErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			crit, err := parsePendingTxCriteria(tc.extra)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, crit)
+			for _, tx := range tc.matches {
+				require.True(t, crit.match(tx))
+			}
+			for _, tx := range tc.misses {
+				require.False(t, crit.match(tx))
+			}
+		})
+	}
+}
+
+func TestSubscribeFullPendingTxsWithoutMempool(t *testing.T) {
+	api := newPubSubAPI(client.Context{}, log.NewNopLogger(), stream.NewRPCStreams(nil, log.NewNopLogger(), nil), nil)
+	_, err := api.subscribePendingTransactions(nil, "0x1", true)
+	require.ErrorContains(t, err, "require the EVM mempool")
+}
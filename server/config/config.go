@@ -11,6 +11,8 @@ import (
 
 	"github.com/cometbft/cometbft/libs/strings"
 
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
 	errorsmod "cosmossdk.io/errors"
 
 	"github.com/cosmos/cosmos-sdk/server/config"
@@ -70,6 +72,9 @@ const (
 	// DefaultGethMetricsAddress is the default port for the geth metrics server.
 	DefaultGethMetricsAddress = "127.0.0.1:8100"
 
+	// DefaultParallelExecutionWorkers is the default number of workers of the parallel execution (0=disabled)
+	DefaultParallelExecutionWorkers = 0
+
 	// DefaultGasCap is the default cap on gas that can be used in eth_call/estimateGas
 	DefaultGasCap uint64 = 25_000_000
 
@@ -85,12 +90,15 @@ const (
 	// DefaultLogsCap is the default cap of results returned from single 'eth_getLogs' query
 	DefaultLogsCap int32 = 10000
 
-	// DefaultBlockRangeCap is the default cap of block range allowed for 'eth_getLogs' query
+	// DefaultBlockRangeCap is the default cap of block range allowed for 'eth_getLogs' and 'trace_filter' queries
 	DefaultBlockRangeCap int32 = 10000
 
 	// DefaultEVMTimeout is the default timeout for eth_call
 	DefaultEVMTimeout = 5 * time.Second
 
+	// DefaultTxSyncTimeout is the default timeout for eth_sendRawTransactionSync
+	DefaultTxSyncTimeout = 10 * time.Second
+
 	// DefaultTxFeeCap is the default tx-fee cap for sending a transaction
 	DefaultTxFeeCap float64 = 1.0
 
@@ -122,6 +130,12 @@ const (
 
 	// DefaultEnableProfiling toggles whether profiling is enabled in the `debug` namespace
 	DefaultEnableProfiling = false
+
+	// DefaultEnableGraphQL toggles whether the GraphQL endpoint is served by the JSON-RPC server
+	DefaultEnableGraphQL = false
+
+	// DefaultEnableStreaming toggles whether the ndjson receipts and logs streams are served by the JSON-RPC server
+	DefaultEnableStreaming = false
 )
 
 var evmTracers = []string{"json", "markdown", "struct", "access_list"}
@@ -151,6 +165,9 @@ type EVMConfig struct {
 	MinTip uint64 `mapstructure:"min-tip"`
 	// GethMetricsAddress is the address the geth metrics server will bind to. Default 127.0.0.1:8100
 	GethMetricsAddress string `mapstructure:"geth-metrics-address"`
+	// ParallelExecutionWorkers defines the number of workers executing the blocks of Ethereum txs in
+	// parallel with block-STM. The parallel execution is disabled if it is 0.
+	ParallelExecutionWorkers int `mapstructure:"parallel-execution-workers"`
 	// Mempool defines the EVM mempool configuration
 	Mempool MempoolConfig `mapstructure:"mempool"`
 }
@@ -226,6 +243,8 @@ type JSONRPCConfig struct {
 	AllowInsecureUnlock bool `mapstructure:"allow-insecure-unlock"`
 	// EVMTimeout is the global timeout for eth-call.
 	EVMTimeout time.Duration `mapstructure:"evm-timeout"`
+	// TxSyncTimeout is the maximum time eth_sendRawTransactionSync waits for the receipt of the transaction.
+	TxSyncTimeout time.Duration `mapstructure:"tx-sync-timeout"`
 	// TxFeeCap is the global tx-fee cap for send transaction
 	TxFeeCap float64 `mapstructure:"txfee-cap"`
 	// FilterCap is the global cap for total number of filters that can be created.
@@ -236,7 +255,7 @@ type JSONRPCConfig struct {
 	Enable bool `mapstructure:"enable"`
 	// LogsCap defines the max number of results can be returned from single `eth_getLogs` query.
 	LogsCap int32 `mapstructure:"logs-cap"`
-	// BlockRangeCap defines the max block range allowed for `eth_getLogs` query.
+	// BlockRangeCap defines the max block range allowed for `eth_getLogs` and `trace_filter` queries.
 	BlockRangeCap int32 `mapstructure:"block-range-cap"`
 	// HTTPTimeout is the read/write timeout of http json-rpc server.
 	HTTPTimeout time.Duration `mapstructure:"http-timeout"`
@@ -254,12 +273,324 @@ type JSONRPCConfig struct {
 	MaxOpenConnections int `mapstructure:"max-open-connections"`
 	// EnableIndexer defines if enable the custom indexer service.
 	EnableIndexer bool `mapstructure:"enable-indexer"`
+	// EnableAddressIndex defines if the custom indexer also builds the sender, recipient, created
+	// contract and log emitter address indexes, required by eth_getTransactionsByAddress and the
+	// address history queries of the `ots` namespace.
+	// Use `index-eth-tx addresses` to build them on an existing indexer db.
+	EnableAddressIndex bool `mapstructure:"enable-address-index"`
+	// EnableLogIndex defines if the custom indexer also builds the log index, used by eth_getLogs
+	// to find the matching blocks instead of scanning the whole range.
+	// Use `index-eth-tx logs` to build it on an existing indexer db.
+	EnableLogIndex bool `mapstructure:"enable-log-index"`
+	// EnableSyntheticLogs defines if the logs of the blocks, and the log index of the custom indexer,
+	// include synthetic ERC-20 Transfer logs for the bank transfers of the Cosmos transactions.
+	EnableSyntheticLogs bool `mapstructure:"enable-synthetic-logs"`
+	// EnableCosmosTxs defines if the blocks and the block receipts include the Cosmos transactions
+	// as pseudo-transactions of type 0x7f, following the Ethereum transactions of the blocks.
+	EnableCosmosTxs bool `mapstructure:"enable-cosmos-txs"`
 	// MetricsAddress defines the metrics server to listen on
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// WSOrigins defines the allowed origins for WebSocket connections
 	WSOrigins []string `mapstructure:"ws-origins"`
 	// EnableProfiling enables the profiling in the `debug` namespace. SHOULD NOT be used on public tracing nodes
 	EnableProfiling bool `mapstructure:"enable-profiling"`
+	// EnableGraphQL serves the EIP-1767 GraphQL schema on the /graphql path of the JSON-RPC server
+	EnableGraphQL bool `mapstructure:"enable-graphql"`
+	// EnableStreaming serves the ndjson streams of receipts and logs on the /stream path of the JSON-RPC server
+	EnableStreaming bool `mapstructure:"enable-streaming"`
+	// RateLimit defines the rate limits of the JSON-RPC clients
+	RateLimit RateLimitConfig `mapstructure:"rate-limit"`
+	// Auth defines the authentication and authorization of the JSON-RPC clients
+	Auth AuthConfig `mapstructure:"auth"`
+	// Cache defines the cache of the responses of the queries for committed heights
+	Cache CacheConfig `mapstructure:"cache"`
+	// AuditLog defines the audit log of the JSON-RPC requests
+	AuditLog AuditLogConfig `mapstructure:"audit-log"`
+	// Archive defines the archive nodes serving the state queries of the historical heights
+	Archive ArchiveConfig `mapstructure:"archive"`
+}
+
+// ArchiveConfig defines the routing of the state queries of the JSON-RPC backend by height: the
+// queries of the recent heights are served by the local node, and the ones of the older heights
+// by the archive gRPC endpoint whose height range contains them. It allows pruned nodes to answer
+// the historical EVM queries.
+type ArchiveConfig struct {
+	// Enable defines if the historical queries should be routed to the archive endpoints
+	Enable bool `mapstructure:"enable"`
+	// RecentHeights is the number of heights below the latest one which are served by the local node
+	RecentHeights int64 `mapstructure:"recent-heights"`
+	// Endpoints defines the archive gRPC endpoints and the heights they serve
+	Endpoints []ArchiveEndpointConfig `mapstructure:"endpoints"`
+}
+
+// ArchiveEndpointConfig defines an archive gRPC endpoint and the range of heights it serves
+type ArchiveEndpointConfig struct {
+	// Address is the gRPC address of the archive node
+	Address string `mapstructure:"address"`
+	// MinHeight is the lowest height served by the endpoint
+	MinHeight int64 `mapstructure:"min-height"`
+	// MaxHeight is the highest height served by the endpoint (0=unbounded)
+	MaxHeight int64 `mapstructure:"max-height"`
+	// TLS defines if the connection to the endpoint uses TLS, verified with the system root certificates
+	TLS bool `mapstructure:"tls"`
+}
+
+// DefaultArchiveConfig returns the default archive configuration
+func DefaultArchiveConfig() ArchiveConfig {
+	return ArchiveConfig{
+		Enable:        false,
+		RecentHeights: 100,
+		Endpoints:     nil,
+	}
+}
+
+// Validate returns an error if the archive configuration is invalid
+func (c ArchiveConfig) Validate() error {
+	if !c.Enable {
+		return nil
+	}
+	if c.RecentHeights < 0 {
+		return fmt.Errorf("recent heights cannot be negative, got %d", c.RecentHeights)
+	}
+	if len(c.Endpoints) == 0 {
+		return errors.New("no archive endpoint")
+	}
+	for i, endpoint := range c.Endpoints {
+		if endpoint.Address == "" {
+			return fmt.Errorf("address of endpoint %d is empty", i)
+		}
+		if endpoint.MinHeight < 0 {
+			return fmt.Errorf("min height of endpoint %d cannot be negative, got %d", i, endpoint.MinHeight)
+		}
+		if endpoint.MaxHeight != 0 && endpoint.MaxHeight < endpoint.MinHeight {
+			return fmt.Errorf("max height of endpoint %d is lower than its min height: %d < %d", i, endpoint.MaxHeight, endpoint.MinHeight)
+		}
+	}
+	return nil
+}
+
+// AuditLogConfig defines the audit log of the HTTP and WebSocket JSON-RPC requests, recording
+// their method, params, latency, caller IP and response size. The logs can be replayed against
+// a node with the `rpc-replay` command.
+type AuditLogConfig struct {
+	// Enable defines if the requests should be logged
+	Enable bool `mapstructure:"enable"`
+	// Path is the file the records are appended to, relative to the node home directory.
+	// The records are logged by the node logger if empty.
+	Path string `mapstructure:"path"`
+	// SampleRate is the fraction of the JSON-RPC messages which are logged, between 0 and 1
+	SampleRate float64 `mapstructure:"sample-rate"`
+	// Redact defines the namespaces and methods whose params are not logged
+	Redact []string `mapstructure:"redact"`
+	// RecordResponses defines if the responses are logged as well, to be compared by `rpc-replay`
+	RecordResponses bool `mapstructure:"record-responses"`
+}
+
+// DefaultAuditLogConfig returns the default audit log configuration
+func DefaultAuditLogConfig() AuditLogConfig {
+	return AuditLogConfig{
+		Enable:          false,
+		Path:            "",
+		SampleRate:      1,
+		Redact:          []string{"personal"},
+		RecordResponses: false,
+	}
+}
+
+// Validate returns an error if the audit log configuration is invalid
+func (c AuditLogConfig) Validate() error {
+	if !c.Enable {
+		return nil
+	}
+	if c.SampleRate <= 0 || c.SampleRate > 1 {
+		return fmt.Errorf("sample rate must be in (0, 1], got %f", c.SampleRate)
+	}
+	if err := validateAllowList(c.Redact); err != nil {
+		return fmt.Errorf("invalid redact list: %w", err)
+	}
+	return nil
+}
+
+// CacheConfig defines the LRU cache of the JSON-RPC responses which never change: the blocks,
+// receipts, logs and traces of the committed heights.
+type CacheConfig struct {
+	// Enable defines if the responses should be cached
+	Enable bool `mapstructure:"enable"`
+	// Size is the maximum number of cached blocks, receipts and block logs, each
+	Size int `mapstructure:"size"`
+	// TraceSize is the maximum number of cached transaction traces
+	TraceSize int `mapstructure:"trace-size"`
+}
+
+// DefaultCacheConfig returns the default response cache configuration
+func DefaultCacheConfig() CacheConfig {
+	return CacheConfig{
+		Enable:    false,
+		Size:      1024,
+		TraceSize: 128,
+	}
+}
+
+// Validate returns an error if the response cache configuration is invalid
+func (c CacheConfig) Validate() error {
+	if !c.Enable {
+		return nil
+	}
+	if c.Size < 1 {
+		return fmt.Errorf("size must be at least 1, got %d", c.Size)
+	}
+	if c.TraceSize < 1 {
+		return fmt.Errorf("trace size must be at least 1, got %d", c.TraceSize)
+	}
+	return nil
+}
+
+// RateLimitConfig defines the rate limits of the HTTP and WebSocket JSON-RPC servers. Every
+// request costs a number of compute units, which are taken from a token bucket of the client,
+// identified by its authenticated identity or remote IP.
+type RateLimitConfig struct {
+	// Enable defines if the requests should be rate limited
+	Enable bool `mapstructure:"enable"`
+	// HTTPRate is the number of compute units per second refilled in the HTTP bucket of a client
+	HTTPRate float64 `mapstructure:"http-rate"`
+	// HTTPBurst is the maximum number of compute units of the HTTP bucket of a client
+	HTTPBurst int `mapstructure:"http-burst"`
+	// WSRate is the number of compute units per second refilled in the WebSocket bucket of a client
+	WSRate float64 `mapstructure:"ws-rate"`
+	// WSBurst is the maximum number of compute units of the WebSocket bucket of a client
+	WSBurst int `mapstructure:"ws-burst"`
+	// DefaultCost is the cost in compute units of the methods without a cost in MethodCosts
+	DefaultCost int `mapstructure:"default-cost"`
+	// MethodCosts defines the cost in compute units of the JSON-RPC methods
+	MethodCosts map[string]int `mapstructure:"method-costs"`
+}
+
+// AuthConfig defines the authentication of the JSON-RPC clients and the namespaces and methods
+// they are allowed to call. The clients authenticate with an "Authorization: Bearer <token>"
+// header, where the token is either an API key or a JWT signed with the HS256 secret.
+// The entries of the allow lists are either namespaces ("debug"), methods
+// ("debug_traceTransaction") or "*" for all the methods.
+type AuthConfig struct {
+	// Enable defines if the JSON-RPC clients should be authorized
+	Enable bool `mapstructure:"enable"`
+	// PublicAllow defines the namespaces and methods allowed to the clients without credentials
+	PublicAllow []string `mapstructure:"public-allow"`
+	// JWTSecret is the path of the file holding the hex encoded HS256 secret of the JWTs
+	JWTSecret string `mapstructure:"jwt-secret"`
+	// JWTAllow defines the namespaces and methods allowed to the clients with a valid JWT
+	JWTAllow []string `mapstructure:"jwt-allow"`
+	// APIKeys defines the API keys and the namespaces and methods they are allowed to call
+	APIKeys []APIKeyConfig `mapstructure:"api-keys"`
+}
+
+// APIKeyConfig defines an API key and the namespaces and methods it is allowed to call
+type APIKeyConfig struct {
+	// Key is the API key
+	Key string `mapstructure:"key"`
+	// Allow defines the namespaces and methods allowed to the key
+	Allow []string `mapstructure:"allow"`
+}
+
+// DefaultAuthConfig returns the default auth configuration
+func DefaultAuthConfig() AuthConfig {
+	return AuthConfig{
+		Enable:      false,
+		PublicAllow: GetDefaultAPINamespaces(),
+		JWTSecret:   "",
+		JWTAllow:    []string{"*"},
+		APIKeys:     nil,
+	}
+}
+
+// Validate returns an error if the auth configuration is invalid
+func (c AuthConfig) Validate() error {
+	if !c.Enable {
+		return nil
+	}
+	if err := validateAllowList(c.PublicAllow); err != nil {
+		return fmt.Errorf("invalid public allow list: %w", err)
+	}
+	if err := validateAllowList(c.JWTAllow); err != nil {
+		return fmt.Errorf("invalid jwt allow list: %w", err)
+	}
+	seenKeys := make(map[string]bool, len(c.APIKeys))
+	for i, apiKey := range c.APIKeys {
+		if apiKey.Key == "" {
+			return fmt.Errorf("api key %d is empty", i)
+		}
+		if seenKeys[apiKey.Key] {
+			return fmt.Errorf("api key %d is repeated", i)
+		}
+		seenKeys[apiKey.Key] = true
+		if err := validateAllowList(apiKey.Allow); err != nil {
+			return fmt.Errorf("invalid allow list of api key %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// validateAllowList returns an error if an entry of the allow list is empty
+func validateAllowList(allow []string) error {
+	for _, entry := range allow {
+		if entry == "" {
+			return errors.New("empty entry")
+		}
+	}
+	return nil
+}
+
+// DefaultRateLimitConfig returns the default rate limit configuration
+func DefaultRateLimitConfig() RateLimitConfig {
+	return RateLimitConfig{
+		Enable:      false,
+		HTTPRate:    100,
+		HTTPBurst:   500,
+		WSRate:      100,
+		WSBurst:     500,
+		DefaultCost: 1,
+		MethodCosts: map[string]int{
+			"eth_call":                 10,
+			"eth_estimateGas":          10,
+			"eth_createAccessList":     10,
+			"eth_simulateV1":           20,
+			"eth_getLogs":              20,
+			"eth_getProof":             10,
+			"eth_sendRawTransaction":   10,
+			"debug_traceTransaction":   50,
+			"debug_traceCall":          50,
+			"debug_traceBlockByNumber": 100,
+			"debug_traceBlockByHash":   100,
+			"trace_filter":             100,
+		},
+	}
+}
+
+// Validate returns an error if the rate limit configuration is invalid
+func (c RateLimitConfig) Validate() error {
+	if !c.Enable {
+		return nil
+	}
+	if c.HTTPRate <= 0 {
+		return fmt.Errorf("http rate must be positive, got %f", c.HTTPRate)
+	}
+	if c.HTTPBurst < 1 {
+		return fmt.Errorf("http burst must be at least 1, got %d", c.HTTPBurst)
+	}
+	if c.WSRate <= 0 {
+		return fmt.Errorf("ws rate must be positive, got %f", c.WSRate)
+	}
+	if c.WSBurst < 1 {
+		return fmt.Errorf("ws burst must be at least 1, got %d", c.WSBurst)
+	}
+	if c.DefaultCost < 0 {
+		return fmt.Errorf("default cost cannot be negative, got %d", c.DefaultCost)
+	}
+	for method, cost := range c.MethodCosts {
+		if cost < 0 {
+			return fmt.Errorf("cost of method %s cannot be negative, got %d", method, cost)
+		}
+	}
+	return nil
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -273,19 +604,20 @@ type TLSConfig struct {
 // DefaultEVMConfig returns the default EVM configuration
 func DefaultEVMConfig() *EVMConfig {
 	return &EVMConfig{
-		Tracer:                  DefaultEVMTracer,
-		MaxTxGasWanted:          DefaultMaxTxGasWanted,
-		EVMChainID:              DefaultEVMChainID,
-		EnablePreimageRecording: DefaultEnablePreimageRecording,
-		MinTip:                  DefaultEVMMinTip,
-		GethMetricsAddress:      DefaultGethMetricsAddress,
-		Mempool:                 DefaultMempoolConfig(),
+		Tracer:                   DefaultEVMTracer,
+		MaxTxGasWanted:           DefaultMaxTxGasWanted,
+		EVMChainID:               DefaultEVMChainID,
+		EnablePreimageRecording:  DefaultEnablePreimageRecording,
+		MinTip:                   DefaultEVMMinTip,
+		GethMetricsAddress:       DefaultGethMetricsAddress,
+		ParallelExecutionWorkers: DefaultParallelExecutionWorkers,
+		Mempool:                  DefaultMempoolConfig(),
 	}
 }
 
 // Validate returns an error if the tracer type is invalid.
 func (c EVMConfig) Validate() error {
-	if c.Tracer != "" && !strings.StringInSlice(c.Tracer, evmTracers) {
+	if c.Tracer != "" && !strings.StringInSlice(c.Tracer, evmTracers) && !evmtypes.IsRegisteredTracer(c.Tracer) {
 		return fmt.Errorf("invalid tracer type %s, available types: %v", c.Tracer, evmTracers)
 	}
 
@@ -293,6 +625,10 @@ func (c EVMConfig) Validate() error {
 		return fmt.Errorf("invalid geth metrics address %q: %w", c.GethMetricsAddress, err)
 	}
 
+	if c.ParallelExecutionWorkers < 0 {
+		return fmt.Errorf("parallel execution workers cannot be negative: %d", c.ParallelExecutionWorkers)
+	}
+
 	if err := c.Mempool.Validate(); err != nil {
 		return fmt.Errorf("invalid mempool config: %w", err)
 	}
@@ -307,7 +643,7 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "trace", "ots"}
 }
 
 // GetDefaultWSOrigins returns the default WebSocket origins.
@@ -325,6 +661,7 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		GasCap:               DefaultGasCap,
 		AllowInsecureUnlock:  DefaultJSONRPCAllowInsecureUnlock,
 		EVMTimeout:           DefaultEVMTimeout,
+		TxSyncTimeout:        DefaultTxSyncTimeout,
 		TxFeeCap:             DefaultTxFeeCap,
 		FilterCap:            DefaultFilterCap,
 		FeeHistoryCap:        DefaultFeeHistoryCap,
@@ -337,9 +674,20 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		BatchResponseMaxSize: DefaultBatchResponseMaxSize,
 		MaxOpenConnections:   DefaultMaxOpenConnections,
 		EnableIndexer:        false,
+		EnableAddressIndex:   false,
+		EnableLogIndex:       false,
+		EnableSyntheticLogs:  false,
+		EnableCosmosTxs:      false,
 		MetricsAddress:       DefaultJSONRPCMetricsAddress,
 		WSOrigins:            GetDefaultWSOrigins(),
 		EnableProfiling:      DefaultEnableProfiling,
+		EnableGraphQL:        DefaultEnableGraphQL,
+		EnableStreaming:      DefaultEnableStreaming,
+		RateLimit:            DefaultRateLimitConfig(),
+		Auth:                 DefaultAuthConfig(),
+		Cache:                DefaultCacheConfig(),
+		AuditLog:             DefaultAuditLogConfig(),
+		Archive:              DefaultArchiveConfig(),
 	}
 }
 
@@ -365,6 +713,10 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC EVM timeout duration cannot be negative")
 	}
 
+	if c.TxSyncTimeout < 0 {
+		return errors.New("JSON-RPC tx sync timeout duration cannot be negative")
+	}
+
 	if c.LogsCap < 0 {
 		return errors.New("JSON-RPC logs cap cannot be negative")
 	}
@@ -389,6 +741,26 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC batch response max size cannot be negative")
 	}
 
+	if err := c.RateLimit.Validate(); err != nil {
+		return fmt.Errorf("invalid JSON-RPC rate limit config: %w", err)
+	}
+
+	if err := c.Auth.Validate(); err != nil {
+		return fmt.Errorf("invalid JSON-RPC auth config: %w", err)
+	}
+
+	if err := c.Cache.Validate(); err != nil {
+		return fmt.Errorf("invalid JSON-RPC cache config: %w", err)
+	}
+
+	if err := c.AuditLog.Validate(); err != nil {
+		return fmt.Errorf("invalid JSON-RPC audit log config: %w", err)
+	}
+
+	if err := c.Archive.Validate(); err != nil {
+		return fmt.Errorf("invalid JSON-RPC archive config: %w", err)
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -10,7 +10,7 @@ const DefaultEVMConfigTemplate = `
 
 # Tracer defines the 'vm.Tracer' type that the EVM will use when the node is run in
 # debug mode. To enable tracing use the '--evm.tracer' flag when starting your node.
-# Valid types are: json|struct|access_list|markdown
+# Valid types are: json|struct|access_list|markdown|precompileTracer
 tracer = "{{ .EVM.Tracer }}"
 
 # MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
@@ -28,6 +28,10 @@ min-tip = {{ .EVM.MinTip }}
 # GethMetricsAddress defines the addr to bind the geth metrics server to. Default 127.0.0.1:8100.
 geth-metrics-address = "{{ .EVM.GethMetricsAddress }}"
 
+# ParallelExecutionWorkers defines the number of workers executing the blocks of Ethereum txs in parallel
+# with block-STM (0=disabled). The blocks with other txs are executed sequentially.
+parallel-execution-workers = {{ .EVM.ParallelExecutionWorkers }}
+
 # Mempool configuration for EVM transactions
 [evm.mempool]
 
@@ -84,6 +88,9 @@ allow-insecure-unlock = {{ .JSONRPC.AllowInsecureUnlock }}
 # EVMTimeout is the global timeout for eth_call. Default: 5s.
 evm-timeout = "{{ .JSONRPC.EVMTimeout }}"
 
+# TxSyncTimeout is the maximum time eth_sendRawTransactionSync waits for the transaction receipt. Default: 10s.
+tx-sync-timeout = "{{ .JSONRPC.TxSyncTimeout }}"
+
 # TxFeeCap is the global tx-fee cap for send transaction. Default: 1eth.
 txfee-cap = {{ .JSONRPC.TxFeeCap }}
 
@@ -96,7 +103,7 @@ feehistory-cap = {{ .JSONRPC.FeeHistoryCap }}
 # LogsCap defines the max number of results can be returned from single 'eth_getLogs' query.
 logs-cap = {{ .JSONRPC.LogsCap }}
 
-# BlockRangeCap defines the max block range allowed for 'eth_getLogs' query.
+# BlockRangeCap defines the max block range allowed for 'eth_getLogs' and 'trace_filter' queries.
 block-range-cap = {{ .JSONRPC.BlockRangeCap }}
 
 # HTTPTimeout is the read/write timeout of http json-rpc server.
@@ -116,6 +123,27 @@ max-open-connections = {{ .JSONRPC.MaxOpenConnections }}
 # EnableIndexer enables the custom transaction indexer for the EVM (ethereum transactions).
 enable-indexer = {{ .JSONRPC.EnableIndexer }}
 
+# EnableAddressIndex enables the sender, recipient, created contract and log emitter address indexes
+# of the custom transaction indexer, required by eth_getTransactionsByAddress and the address history
+# queries of the ots namespace. Run "index-eth-tx addresses" to build them on an existing indexer db.
+enable-address-index = {{ .JSONRPC.EnableAddressIndex }}
+
+# EnableLogIndex enables the log index of the custom transaction indexer, eth_getLogs uses it to find
+# the matching blocks instead of scanning the whole range, falling back to scanning the blocks it doesn't cover.
+# Run "index-eth-tx logs" to build it on an existing indexer db.
+enable-log-index = {{ .JSONRPC.EnableLogIndex }}
+
+# EnableSyntheticLogs adds synthetic ERC-20 Transfer logs for the bank transfers of the Cosmos transactions
+# to the logs of the blocks and to the log index. The transfers of the EVM denom are emitted by
+# 0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE and the ones of the x/erc20 token pairs by their ERC-20 contract,
+# and the transaction hash of the logs is the CometBFT hash of the Cosmos transaction.
+enable-synthetic-logs = {{ .JSONRPC.EnableSyntheticLogs }}
+
+# EnableCosmosTxs includes the Cosmos transactions in the blocks and the block receipts as pseudo-transactions
+# of type 0x7f, following the Ethereum transactions of the blocks. They carry the CometBFT hash of the transaction,
+# the hex address of its signer, its fee and gas, and the type URLs of its messages.
+enable-cosmos-txs = {{ .JSONRPC.EnableCosmosTxs }}
+
 # MetricsAddress defines the EVM Metrics server address to bind to. Pass --metrics in CLI to enable
 # Prometheus metrics path: /debug/metrics/prometheus
 metrics-address = "{{ .JSONRPC.MetricsAddress }}"
@@ -129,6 +157,135 @@ batch-response-max-size = {{ .JSONRPC.BatchResponseMaxSize }}
 # Enabled profiling in the debug namespace
 enable-profiling = {{ .JSONRPC.EnableProfiling }}
 
+# Serves the GraphQL schema of EIP-1767 on the /graphql path of the JSON-RPC server.
+# The clients must be allowed the whole eth namespace, and every query costs the compute units
+# of the "graphql" method of the rate limits.
+enable-graphql = {{ .JSONRPC.EnableGraphQL }}
+
+# Serves the receipts and logs of block ranges as newline delimited JSON on the /stream/receipts
+# and /stream/logs paths of the JSON-RPC server, without the logs and block range caps.
+# The clients must be allowed the whole eth namespace, and every stream costs the compute units
+# of the "stream_receipts" or "stream_logs" method of the rate limits.
+enable-streaming = {{ .JSONRPC.EnableStreaming }}
+
+# Rate limits of the JSON-RPC clients. Every request costs a number of compute units, taken from
+# the token bucket of the client, identified by its authenticated API key or JWT, or else by its
+# remote IP. The HTTP and WebSocket servers have separate buckets. The rejected requests return
+# the JSON-RPC error code -32005.
+[json-rpc.rate-limit]
+
+# Enable defines if the JSON-RPC requests should be rate limited.
+enable = {{ .JSONRPC.RateLimit.Enable }}
+
+# HTTPRate is the number of compute units per second refilled in the HTTP bucket of a client.
+http-rate = {{ .JSONRPC.RateLimit.HTTPRate }}
+
+# HTTPBurst is the maximum number of compute units of the HTTP bucket of a client.
+http-burst = {{ .JSONRPC.RateLimit.HTTPBurst }}
+
+# WSRate is the number of compute units per second refilled in the WebSocket bucket of a client.
+ws-rate = {{ .JSONRPC.RateLimit.WSRate }}
+
+# WSBurst is the maximum number of compute units of the WebSocket bucket of a client.
+ws-burst = {{ .JSONRPC.RateLimit.WSBurst }}
+
+# DefaultCost is the cost in compute units of the methods not listed in method-costs.
+default-cost = {{ .JSONRPC.RateLimit.DefaultCost }}
+
+# MethodCosts defines the cost in compute units of the JSON-RPC methods, matched case-insensitively.
+[json-rpc.rate-limit.method-costs]
+{{range $method, $cost := .JSONRPC.RateLimit.MethodCosts}}{{$method}} = {{$cost}}
+{{end}}
+# LRU cache of the JSON-RPC responses which never change: the blocks, receipts, logs and
+# transaction traces of the committed heights. The hits and misses are reported by the
+# rpc/cache/<query>/hit and rpc/cache/<query>/miss geth metrics.
+[json-rpc.cache]
+
+# Enable defines if the responses should be cached.
+enable = {{ .JSONRPC.Cache.Enable }}
+
+# Size is the maximum number of cached blocks, receipts and block logs, each.
+size = {{ .JSONRPC.Cache.Size }}
+
+# TraceSize is the maximum number of cached transaction traces.
+trace-size = {{ .JSONRPC.Cache.TraceSize }}
+
+# Audit log of the HTTP and WebSocket JSON-RPC requests, recording their method, params, latency,
+# caller IP and response size. The logs can be replayed against a node with the rpc-replay command.
+[json-rpc.audit-log]
+
+# Enable defines if the JSON-RPC requests should be logged.
+enable = {{ .JSONRPC.AuditLog.Enable }}
+
+# Path is the file the records are appended to, relative to the node home directory.
+# The records are logged by the node logger if empty.
+path = "{{ .JSONRPC.AuditLog.Path }}"
+
+# SampleRate is the fraction of the JSON-RPC messages which are logged, between 0 and 1.
+sample-rate = {{ .JSONRPC.AuditLog.SampleRate }}
+
+# Redact defines the namespaces and methods whose params are not logged.
+redact = [{{range $index, $elmt := .JSONRPC.AuditLog.Redact}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# RecordResponses defines if the responses are logged as well, to be compared by rpc-replay.
+record-responses = {{ .JSONRPC.AuditLog.RecordResponses }}
+
+# Authentication and authorization of the JSON-RPC clients of the HTTP and WebSocket servers.
+# The clients authenticate with an "Authorization: Bearer <token>" header, where the token is
+# either an API key or a JWT signed with the HS256 secret and an "iat" claim within 60 seconds.
+# The entries of the allow lists are namespaces ("debug"), methods ("debug_traceTransaction")
+# or "*" for all the methods. The calls to the other methods return the JSON-RPC error code -32001.
+[json-rpc.auth]
+
+# Enable defines if the JSON-RPC clients should be authorized.
+enable = {{ .JSONRPC.Auth.Enable }}
+
+# PublicAllow defines the namespaces and methods allowed to the clients without credentials.
+public-allow = [{{range $index, $elmt := .JSONRPC.Auth.PublicAllow}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# JWTSecret is the path of the file holding the hex encoded HS256 secret of the JWTs (empty=disabled).
+jwt-secret = "{{ .JSONRPC.Auth.JWTSecret }}"
+
+# JWTAllow defines the namespaces and methods allowed to the clients with a valid JWT.
+jwt-allow = [{{range $index, $elmt := .JSONRPC.Auth.JWTAllow}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# APIKeys defines the API keys and the namespaces and methods they are allowed to call, e.g.
+#
+# [[json-rpc.auth.api-keys]]
+# key = "my-secret-key"
+# allow = ["eth", "debug", "txpool_content"]
+{{range .JSONRPC.Auth.APIKeys}}
+[[json-rpc.auth.api-keys]]
+key = "{{ .Key }}"
+allow = [{{range $index, $elmt := .Allow}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+{{end}}
+
+# Routing of the state queries of the JSON-RPC backend by height. The queries of the recent
+# heights are served by the local node, and the ones of the older heights by the first archive
+# gRPC endpoint whose height range contains them, or by the local node if there is none.
+[json-rpc.archive]
+
+# Enable defines if the historical queries should be routed to the archive endpoints.
+enable = {{ .JSONRPC.Archive.Enable }}
+
+# RecentHeights is the number of heights below the latest one which are served by the local node.
+recent-heights = {{ .JSONRPC.Archive.RecentHeights }}
+
+# Endpoints defines the archive gRPC endpoints and the heights they serve (max-height 0=unbounded).
+# The connections use TLS, verified with the system root certificates, if tls is true, e.g.
+#
+# [[json-rpc.archive.endpoints]]
+# address = "archive-0:9090"
+# min-height = 1
+# max-height = 1000000
+# tls = true
+{{range .JSONRPC.Archive.Endpoints}}
+[[json-rpc.archive.endpoints]]
+address = "{{ .Address }}"
+min-height = {{ .MinHeight }}
+max-height = {{ .MaxHeight }}
+tls = {{ .TLS }}
+{{end}}
 ###############################################################################
 ###                             TLS Configuration                           ###
 ###############################################################################
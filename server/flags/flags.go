@@ -45,6 +45,7 @@ const (
 	JSONRPCGasCap               = "json-rpc.gas-cap"
 	JSONRPCAllowInsecureUnlock  = "json-rpc.allow-insecure-unlock"
 	JSONRPCEVMTimeout           = "json-rpc.evm-timeout"
+	JSONRPCTxSyncTimeout        = "json-rpc.tx-sync-timeout"
 	JSONRPCTxFeeCap             = "json-rpc.txfee-cap"
 	JSONRPCFilterCap            = "json-rpc.filter-cap"
 	JSONRPCLogsCap              = "json-rpc.logs-cap"
@@ -54,9 +55,42 @@ const (
 	JSONRPCAllowUnprotectedTxs  = "json-rpc.allow-unprotected-txs"
 	JSONRPCMaxOpenConnections   = "json-rpc.max-open-connections"
 	JSONRPCEnableIndexer        = "json-rpc.enable-indexer"
+	JSONRPCEnableAddressIndex   = "json-rpc.enable-address-index"
+	JSONRPCEnableLogIndex       = "json-rpc.enable-log-index"
+	JSONRPCEnableSyntheticLogs  = "json-rpc.enable-synthetic-logs"
+	JSONRPCEnableCosmosTxs      = "json-rpc.enable-cosmos-txs"
 	JSONRPCBatchRequestLimit    = "json-rpc.batch-request-limit"
 	JSONRPCBatchResponseMaxSize = "json-rpc.batch-response-max-size"
 	JSONRPCEnableProfiling      = "json-rpc.enable-profiling"
+	JSONRPCEnableGraphQL        = "json-rpc.enable-graphql"
+	JSONRPCEnableStreaming      = "json-rpc.enable-streaming"
+
+	JSONRPCRateLimitEnable      = "json-rpc.rate-limit.enable"
+	JSONRPCRateLimitHTTPRate    = "json-rpc.rate-limit.http-rate"
+	JSONRPCRateLimitHTTPBurst   = "json-rpc.rate-limit.http-burst"
+	JSONRPCRateLimitWSRate      = "json-rpc.rate-limit.ws-rate"
+	JSONRPCRateLimitWSBurst     = "json-rpc.rate-limit.ws-burst"
+	JSONRPCRateLimitDefaultCost = "json-rpc.rate-limit.default-cost"
+	JSONRPCRateLimitMethodCosts = "json-rpc.rate-limit.method-costs"
+
+	JSONRPCAuthEnable      = "json-rpc.auth.enable"
+	JSONRPCAuthPublicAllow = "json-rpc.auth.public-allow"
+	JSONRPCAuthJWTSecret   = "json-rpc.auth.jwt-secret"
+	JSONRPCAuthJWTAllow    = "json-rpc.auth.jwt-allow"
+
+	JSONRPCCacheEnable    = "json-rpc.cache.enable"
+	JSONRPCCacheSize      = "json-rpc.cache.size"
+	JSONRPCCacheTraceSize = "json-rpc.cache.trace-size"
+
+	JSONRPCAuditLogEnable          = "json-rpc.audit-log.enable"
+	JSONRPCAuditLogPath            = "json-rpc.audit-log.path"
+	JSONRPCAuditLogSampleRate      = "json-rpc.audit-log.sample-rate"
+	JSONRPCAuditLogRedact          = "json-rpc.audit-log.redact"
+	JSONRPCAuditLogRecordResponses = "json-rpc.audit-log.record-responses"
+
+	JSONRPCArchiveEnable        = "json-rpc.archive.enable"
+	JSONRPCArchiveRecentHeights = "json-rpc.archive.recent-heights"
+
 	// JSONRPCEnableMetrics enables EVM RPC metrics server.
 	// Set to `metrics` which is hardcoded flag from go-ethereum.
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
@@ -65,12 +99,13 @@ const (
 
 // EVM flags
 const (
-	EVMTracer                  = "evm.tracer"
-	EVMMaxTxGasWanted          = "evm.max-tx-gas-wanted"
-	EVMEnablePreimageRecording = "evm.cache-preimage"
-	EVMChainID                 = "evm.evm-chain-id"
-	EVMMinTip                  = "evm.min-tip"
-	EvmGethMetricsAddress      = "evm.geth-metrics-address"
+	EVMTracer                   = "evm.tracer"
+	EVMMaxTxGasWanted           = "evm.max-tx-gas-wanted"
+	EVMEnablePreimageRecording  = "evm.cache-preimage"
+	EVMChainID                  = "evm.evm-chain-id"
+	EVMMinTip                   = "evm.min-tip"
+	EvmGethMetricsAddress       = "evm.geth-metrics-address"
+	EVMParallelExecutionWorkers = "evm.parallel-execution-workers"
 
 	EVMMempoolPriceLimit   = "evm.mempool.price-limit"
 	EVMMempoolPriceBump    = "evm.mempool.price-bump"
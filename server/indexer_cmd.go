@@ -10,6 +10,7 @@ import (
 	cmtstore "github.com/cometbft/cometbft/store"
 
 	"github.com/cosmos/evm/indexer"
+	srvflags "github.com/cosmos/evm/server/flags"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
@@ -18,11 +19,13 @@ import (
 // NewIndexTxCmd creates a new Cobra command to index historical Ethereum transactions.
 func NewIndexTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "index-eth-tx [backward|forward]",
+		Use:   "index-eth-tx [backward|forward|addresses|logs]",
 		Short: "Index historical eth txs",
 		Long: `Index historical eth txs, it only support two traverse direction to avoid creating gaps in the indexer db if using arbitrary block ranges:
 		- backward: index the blocks from the first indexed block to the earliest block in the chain, if indexer db is empty, start from the latest block.
 		- forward: index the blocks from the latest indexed block to latest block in the chain.
+		- addresses: build the address indexes of the already indexed blocks, to enable them on an existing indexer db.
+		- logs: build the log index backward from the first block it covers, or the latest indexed block, to the first indexed block.
 
 		When start the node, the indexer start from the latest indexed block to avoid creating gap.
         Backward mode should be used most of the time, so the latest indexed block is always up-to-date.
@@ -36,8 +39,8 @@ func NewIndexTxCmd() *cobra.Command {
 			}
 
 			direction := args[0]
-			if direction != "backward" && direction != "forward" {
-				return fmt.Errorf("unknown index direction, expect: backward|forward, got: %s", direction)
+			if direction != "backward" && direction != "forward" && direction != "addresses" && direction != "logs" {
+				return fmt.Errorf("unknown index direction, expect: backward|forward|addresses|logs, got: %s", direction)
 			}
 
 			cfg := serverCtx.Config
@@ -49,6 +52,9 @@ func NewIndexTxCmd() *cobra.Command {
 				return err
 			}
 			idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx)
+			idxer.SetAddressIndex(serverCtx.Viper.GetBool(srvflags.JSONRPCEnableAddressIndex))
+			idxer.SetLogIndex(serverCtx.Viper.GetBool(srvflags.JSONRPCEnableLogIndex))
+			idxer.SetSyntheticLogs(serverCtx.Viper.GetBool(srvflags.JSONRPCEnableSyntheticLogs))
 
 			// open local CometBFT db, because the local rpc won't be available.
 			tmdb, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "blockstore", Config: cfg})
@@ -74,7 +80,15 @@ func NewIndexTxCmd() *cobra.Command {
 				if err != nil {
 					return err
 				}
-				if err := idxer.IndexBlock(blk, resBlk.TxResults); err != nil {
+				switch direction {
+				case "addresses":
+					err = idxer.IndexBlockAddresses(blk, resBlk.TxResults)
+				case "logs":
+					err = idxer.IndexBlockLogs(blk, resBlk.TxResults)
+				default:
+					err = idxer.IndexBlock(blk, resBlk.TxResults)
+				}
+				if err != nil {
 					return err
 				}
 				fmt.Println(height)
@@ -110,6 +124,59 @@ func NewIndexTxCmd() *cobra.Command {
 						return err
 					}
 				}
+			case "addresses":
+				first, err := idxer.FirstIndexedBlock()
+				if err != nil {
+					return err
+				}
+				latest, err := idxer.LastIndexedBlock()
+				if err != nil {
+					return err
+				}
+				if first == -1 {
+					// nothing indexed yet
+					return nil
+				}
+				for i := first; i <= latest; i++ {
+					if err := indexBlock(i); err != nil {
+						return err
+					}
+				}
+			case "logs":
+				first, err := idxer.FirstIndexedBlock()
+				if err != nil {
+					return err
+				}
+				if first == -1 {
+					// nothing indexed yet
+					return nil
+				}
+				// extend the log index backward, skipping the ranges which are
+				// already covered
+				start, _, err := idxer.LogIndexedRange()
+				if err != nil {
+					return err
+				}
+				if start == -1 {
+					latest, err := idxer.LastIndexedBlock()
+					if err != nil {
+						return err
+					}
+					start = latest + 1
+				}
+				for i := start - 1; i >= first; i-- {
+					covered, _, err := idxer.LogIndexedRangeAt(i)
+					if err != nil {
+						return err
+					}
+					if covered != -1 {
+						i = covered
+						continue
+					}
+					if err := indexBlock(i); err != nil {
+						return err
+					}
+				}
 			default:
 				return fmt.Errorf("unknown direction %s", args[0])
 			}
@@ -18,7 +18,8 @@ const (
 	NewBlockWaitTimeout = 60 * time.Second
 )
 
-// EVMIndexerService indexes transactions for json-rpc service.
+// EVMIndexerService indexes transactions for json-rpc service, together with the
+// optional address and log indexes if they are enabled on the indexer.
 type EVMIndexerService struct {
 	service.BaseService
 
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -17,6 +19,13 @@ import (
 
 	evmmempool "github.com/cosmos/evm/mempool"
 	"github.com/cosmos/evm/rpc"
+	"github.com/cosmos/evm/rpc/audit"
+	"github.com/cosmos/evm/rpc/auth"
+	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/graphql"
+	"github.com/cosmos/evm/rpc/middleware"
+	"github.com/cosmos/evm/rpc/ndjson"
+	"github.com/cosmos/evm/rpc/ratelimit"
 	"github.com/cosmos/evm/rpc/stream"
 	serverconfig "github.com/cosmos/evm/server/config"
 	"github.com/cosmos/evm/server/types"
@@ -25,7 +34,24 @@ import (
 	"github.com/cosmos/cosmos-sdk/server"
 )
 
-const shutdownTimeout = 200 * time.Millisecond
+const (
+	shutdownTimeout = 200 * time.Millisecond
+
+	// graphqlNamespace is the namespace the clients must be allowed to query
+	// the GraphQL endpoint
+	graphqlNamespace = "eth"
+	// graphqlMethod is the method whose rate limit cost is charged for every
+	// GraphQL query
+	graphqlMethod = "graphql"
+
+	// streamNamespace is the namespace the clients must be allowed to query
+	// the ndjson streams
+	streamNamespace = "eth"
+	// streamReceiptsMethod and streamLogsMethod are the methods whose rate
+	// limit costs are charged for every receipts and logs stream
+	streamReceiptsMethod = "stream_receipts"
+	streamLogsMethod     = "stream_logs"
+)
 
 type AppWithPendingTxStream interface {
 	RegisterPendingTxListener(listener func(common.Hash))
@@ -51,6 +77,9 @@ func StartJSONRPC(
 
 	stream := stream.NewRPCStreams(evtClient, logger, clientCtx.TxConfig.TxDecoder())
 	app.RegisterPendingTxListener(stream.ListenPendingTx)
+	if mempool != nil {
+		stream.ListenTxPool(mempool.GetTxPool())
+	}
 
 	// Set Geth's global logger to use this handler
 	handler := &CustomSlogHandler{logger: logger}
@@ -62,7 +91,17 @@ func StartJSONRPC(
 	allowUnprotectedTxs := config.JSONRPC.AllowUnprotectedTxs
 	rpcAPIArr := config.JSONRPC.API
 
-	apis := rpc.GetRPCAPIs(srvCtx, clientCtx, stream, allowUnprotectedTxs, indexer, rpcAPIArr, mempool)
+	// the backends of all the namespaces share the same response cache and
+	// archive connections, closed on shutdown
+	shared, err := backend.NewShared(*config, clientCtx)
+	if err != nil {
+		return nil, err
+	}
+	g.Go(func() error {
+		<-ctx.Done()
+		return shared.Close()
+	})
+	apis := rpc.GetRPCAPIs(srvCtx, clientCtx, stream, allowUnprotectedTxs, indexer, rpcAPIArr, mempool, shared)
 
 	for _, api := range apis {
 		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
@@ -75,8 +114,92 @@ func StartJSONRPC(
 		}
 	}
 
+	var limiter *ratelimit.Limiter
+	if config.JSONRPC.RateLimit.Enable {
+		limiter = ratelimit.New(config.JSONRPC.RateLimit)
+	}
+
+	var authenticator *auth.Authenticator
+	if config.JSONRPC.Auth.Enable {
+		var err error
+		if authenticator, err = auth.New(config.JSONRPC.Auth); err != nil {
+			return nil, err
+		}
+	}
+
+	var auditLogger *audit.Logger
+	if config.JSONRPC.AuditLog.Enable {
+		auditHandler, closeAuditLog, err := newAuditLogHandler(srvCtx, config.JSONRPC.AuditLog)
+		if err != nil {
+			return nil, err
+		}
+		auditLogger = audit.New(config.JSONRPC.AuditLog, auditHandler)
+		g.Go(func() error {
+			<-ctx.Done()
+			return closeAuditLog()
+		})
+	}
+
+	// the requests forwarded by the websocket server were already checked by
+	// its authenticator and rate limiter, and logged by its audit logger. The
+	// requests are authorized before being rate limited, so the limiter keys
+	// the buckets on the identity of the authenticated clients.
+	forwardToken := middleware.NewForwardToken()
+	var rpcHandler http.Handler = rpcServer
+	if limiter != nil {
+		rpcHandler = limiter.Handler(rpcHandler)
+	}
+	if authenticator != nil {
+		rpcHandler = authenticator.Handler(rpcHandler)
+	}
+	if auditLogger != nil {
+		rpcHandler = auditLogger.Handler(rpcHandler)
+	}
+
 	r := mux.NewRouter()
-	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+	r.Handle("/", forwardToken.Handler(rpcServer, rpcHandler)).Methods("POST")
+
+	if config.JSONRPC.EnableGraphQL {
+		evmBackend, err := backend.NewBackend(srvCtx, srvCtx.Logger, clientCtx, allowUnprotectedTxs, indexer, mempool, shared)
+		if err != nil {
+			return nil, err
+		}
+		graphqlHandler, err := graphql.NewHandler(srvCtx.Logger, evmBackend, config.JSONRPC.HTTPTimeout)
+		if err != nil {
+			return nil, err
+		}
+		if limiter != nil {
+			graphqlHandler = limiter.MethodHandler(graphqlMethod, graphqlHandler)
+		}
+		if authenticator != nil {
+			graphqlHandler = authenticator.NamespaceHandler(graphqlNamespace, graphqlHandler)
+		}
+		r.Handle("/graphql", graphqlHandler).Methods("POST")
+	}
+
+	if config.JSONRPC.EnableStreaming {
+		evmBackend, err := backend.NewBackend(srvCtx, srvCtx.Logger, clientCtx, allowUnprotectedTxs, indexer, mempool, shared)
+		if err != nil {
+			return nil, err
+		}
+		streamServer := ndjson.NewServer(srvCtx.Logger, evmBackend, config.JSONRPC.HTTPTimeout)
+		for path, route := range map[string]struct {
+			method  string
+			handler http.Handler
+		}{
+			"/stream/receipts": {streamReceiptsMethod, streamServer.ReceiptsHandler()},
+			"/stream/logs":     {streamLogsMethod, streamServer.LogsHandler()},
+		} {
+			handler := route.handler
+			if limiter != nil {
+				handler = limiter.MethodHandler(route.method, handler)
+			}
+			if authenticator != nil {
+				handler = authenticator.NamespaceHandler(streamNamespace, handler)
+			}
+			r.Handle(path, handler).Methods("POST")
+		}
+	}
 
 	handlerWithCors := cors.Default()
 	if config.API.EnableUnsafeCORS {
@@ -131,7 +254,27 @@ func StartJSONRPC(
 
 	srvCtx.Logger.Info("Starting JSON WebSocket server", "address", config.JSONRPC.WsAddress)
 
-	wsSrv := rpc.NewWebsocketsServer(clientCtx, logger, stream, config)
+	wsSrv := rpc.NewWebsocketsServer(clientCtx, logger, stream, config, mempool, limiter, authenticator, forwardToken, auditLogger)
 	wsSrv.Start()
 	return httpSrv, nil
 }
+
+// newAuditLogHandler returns the slog handler of the JSON-RPC audit log and the
+// function closing it. The records are appended in JSON to the audit log file,
+// or logged by the node logger if the path is empty.
+func newAuditLogHandler(srvCtx *server.Context, cfg serverconfig.AuditLogConfig) (slog.Handler, func() error, error) {
+	if cfg.Path == "" {
+		handler := &CustomSlogHandler{logger: srvCtx.Logger.With("module", "rpc-audit")}
+		return handler, func() error { return nil }, nil
+	}
+
+	path := cfg.Path
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(srvCtx.Config.RootDir, path)
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600) //#nosec G304 -- the path is set by the node operator
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open the JSON-RPC audit log: %w", err)
+	}
+	return slog.NewJSONHandler(f, nil), f.Close, nil
+}
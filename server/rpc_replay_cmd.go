@@ -0,0 +1,66 @@
+package server
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/evm/rpc/audit"
+)
+
+const (
+	flagReplayRPCURL     = "rpc-url"
+	flagReplayCompareURL = "compare-url"
+)
+
+// NewRPCReplayCmd creates a Cobra command to replay a JSON-RPC audit log against a node.
+func NewRPCReplayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rpc-replay [audit-log]",
+		Short: "Replay a JSON-RPC audit log against a node and diff the responses",
+		Long: `Replay the requests of a JSON-RPC audit log against the node at --rpc-url and diff the responses.
+
+		The responses are compared to the ones of the node at --compare-url if it is set, or else to
+		the responses recorded by the audit log, with record-responses enabled. The audit log is either
+		the audit log file, or the node log with the json log format. The redacted requests and the
+		subscriptions are skipped.
+		`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			rpcURL, err := cmd.Flags().GetString(flagReplayRPCURL)
+			if err != nil {
+				return err
+			}
+			compareURL, err := cmd.Flags().GetString(flagReplayCompareURL)
+			if err != nil {
+				return err
+			}
+
+			f, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			records, err := audit.ReadRecords(f)
+			if err != nil {
+				return fmt.Errorf("failed to read the audit log: %w", err)
+			}
+
+			summary, err := audit.Replay(cmd.Context(), records, rpcURL, compareURL, cmd.OutOrStdout())
+			if err != nil {
+				return err
+			}
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "replayed %d requests: %d mismatches, %d skipped\n", summary.Replayed, summary.Mismatched, summary.Skipped)
+			if summary.Mismatched > 0 {
+				return fmt.Errorf("%d responses differ", summary.Mismatched)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagReplayRPCURL, "http://localhost:8545", "the JSON-RPC endpoint of the node the requests are replayed against")
+	cmd.Flags().String(flagReplayCompareURL, "", "the JSON-RPC endpoint of the node whose responses are compared (empty=the recorded responses)")
+	return cmd
+}
@@ -210,24 +210,58 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Float64(srvflags.JSONRPCTxFeeCap, cosmosevmserverconfig.DefaultTxFeeCap, "Sets a cap on transaction fee that can be sent via the RPC APIs (1 = default 1 evmos)")                    //nolint:lll
 	cmd.Flags().Int32(srvflags.JSONRPCFilterCap, cosmosevmserverconfig.DefaultFilterCap, "Sets the global cap for total number of filters that can be created")
 	cmd.Flags().Duration(srvflags.JSONRPCEVMTimeout, cosmosevmserverconfig.DefaultEVMTimeout, "Sets a timeout used for eth_call (0=infinite)")
+	cmd.Flags().Duration(srvflags.JSONRPCTxSyncTimeout, cosmosevmserverconfig.DefaultTxSyncTimeout, "Sets the maximum time eth_sendRawTransactionSync waits for the transaction receipt")
 	cmd.Flags().Duration(srvflags.JSONRPCHTTPTimeout, cosmosevmserverconfig.DefaultHTTPTimeout, "Sets a read/write timeout for json-rpc http server (0=infinite)")
 	cmd.Flags().Duration(srvflags.JSONRPCHTTPIdleTimeout, cosmosevmserverconfig.DefaultHTTPIdleTimeout, "Sets a idle timeout for json-rpc http server (0=infinite)")
 	cmd.Flags().Bool(srvflags.JSONRPCAllowUnprotectedTxs, cosmosevmserverconfig.DefaultAllowUnprotectedTxs, "Allow for unprotected (non EIP155 signed) transactions to be submitted via the node's RPC when the global parameter is disabled") //nolint:lll
 	cmd.Flags().Int(srvflags.JSONRPCBatchRequestLimit, cosmosevmserverconfig.DefaultBatchRequestLimit, "Maximum number of requests in a batch")
 	cmd.Flags().Int(srvflags.JSONRPCBatchResponseMaxSize, cosmosevmserverconfig.DefaultBatchResponseMaxSize, "Maximum size of server response")
 	cmd.Flags().Int32(srvflags.JSONRPCLogsCap, cosmosevmserverconfig.DefaultLogsCap, "Sets the max number of results can be returned from single `eth_getLogs` query")
-	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, cosmosevmserverconfig.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
+	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, cosmosevmserverconfig.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` and `trace_filter` queries")
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, cosmosevmserverconfig.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableAddressIndex, false, "Enable the address appearance index of the custom tx indexer")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableLogIndex, false, "Enable the log index of the custom tx indexer, used by eth_getLogs")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableSyntheticLogs, false, "Enable the synthetic ERC-20 Transfer logs of the bank transfers of the Cosmos txs")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableCosmosTxs, false, "Include the Cosmos txs in the blocks and block receipts as pseudo-transactions")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableProfiling, false, "Enables the profiling in the debug namespace")
-
-	cmd.Flags().String(srvflags.EVMTracer, cosmosevmserverconfig.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
-	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, cosmosevmserverconfig.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
-	cmd.Flags().Bool(srvflags.EVMEnablePreimageRecording, cosmosevmserverconfig.DefaultEnablePreimageRecording, "Enables tracking of SHA3 preimages in the EVM (not implemented yet)")                      //nolint:lll
+	cmd.Flags().Bool(srvflags.JSONRPCEnableGraphQL, cosmosevmserverconfig.DefaultEnableGraphQL, "Serves the EIP-1767 GraphQL endpoint on the /graphql path of the JSON-RPC server")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableStreaming, cosmosevmserverconfig.DefaultEnableStreaming, "Serves the ndjson streams of receipts and logs on the /stream path of the JSON-RPC server")
+
+	cmd.Flags().Bool(srvflags.JSONRPCRateLimitEnable, cosmosevmserverconfig.DefaultRateLimitConfig().Enable, "Enables the rate limiting of the JSON-RPC requests")
+	cmd.Flags().Float64(srvflags.JSONRPCRateLimitHTTPRate, cosmosevmserverconfig.DefaultRateLimitConfig().HTTPRate, "the compute units per second refilled in the HTTP bucket of a client")
+	cmd.Flags().Int(srvflags.JSONRPCRateLimitHTTPBurst, cosmosevmserverconfig.DefaultRateLimitConfig().HTTPBurst, "the maximum compute units of the HTTP bucket of a client")
+	cmd.Flags().Float64(srvflags.JSONRPCRateLimitWSRate, cosmosevmserverconfig.DefaultRateLimitConfig().WSRate, "the compute units per second refilled in the WebSocket bucket of a client")
+	cmd.Flags().Int(srvflags.JSONRPCRateLimitWSBurst, cosmosevmserverconfig.DefaultRateLimitConfig().WSBurst, "the maximum compute units of the WebSocket bucket of a client")
+	cmd.Flags().Int(srvflags.JSONRPCRateLimitDefaultCost, cosmosevmserverconfig.DefaultRateLimitConfig().DefaultCost, "the compute units cost of the methods without a configured cost")
+	cmd.Flags().StringToInt(srvflags.JSONRPCRateLimitMethodCosts, cosmosevmserverconfig.DefaultRateLimitConfig().MethodCosts, "the compute units cost of the JSON-RPC methods (method=cost)")
+
+	cmd.Flags().Bool(srvflags.JSONRPCAuthEnable, cosmosevmserverconfig.DefaultAuthConfig().Enable, "Enables the authentication and per-namespace authorization of the JSON-RPC clients")
+	cmd.Flags().StringSlice(srvflags.JSONRPCAuthPublicAllow, cosmosevmserverconfig.DefaultAuthConfig().PublicAllow, "the JSON-RPC namespaces and methods allowed to the clients without credentials")
+	cmd.Flags().String(srvflags.JSONRPCAuthJWTSecret, cosmosevmserverconfig.DefaultAuthConfig().JWTSecret, "the path of the file holding the hex encoded HS256 secret of the JSON-RPC JWTs")
+	cmd.Flags().StringSlice(srvflags.JSONRPCAuthJWTAllow, cosmosevmserverconfig.DefaultAuthConfig().JWTAllow, "the JSON-RPC namespaces and methods allowed to the clients with a valid JWT")
+
+	cmd.Flags().Bool(srvflags.JSONRPCCacheEnable, cosmosevmserverconfig.DefaultCacheConfig().Enable, "Enables the cache of the JSON-RPC blocks, receipts, logs and traces of the committed heights")
+	cmd.Flags().Int(srvflags.JSONRPCCacheSize, cosmosevmserverconfig.DefaultCacheConfig().Size, "the maximum number of cached blocks, receipts and block logs, each")
+	cmd.Flags().Int(srvflags.JSONRPCCacheTraceSize, cosmosevmserverconfig.DefaultCacheConfig().TraceSize, "the maximum number of cached transaction traces")
+
+	cmd.Flags().Bool(srvflags.JSONRPCAuditLogEnable, cosmosevmserverconfig.DefaultAuditLogConfig().Enable, "Enables the audit log of the JSON-RPC requests")
+	cmd.Flags().String(srvflags.JSONRPCAuditLogPath, cosmosevmserverconfig.DefaultAuditLogConfig().Path, "the file the JSON-RPC audit records are appended to, relative to the node home (empty=node logger)")
+	cmd.Flags().Float64(srvflags.JSONRPCAuditLogSampleRate, cosmosevmserverconfig.DefaultAuditLogConfig().SampleRate, "the fraction of the JSON-RPC messages which are audit logged")
+	cmd.Flags().StringSlice(srvflags.JSONRPCAuditLogRedact, cosmosevmserverconfig.DefaultAuditLogConfig().Redact, "the JSON-RPC namespaces and methods whose params are not audit logged")
+	cmd.Flags().Bool(srvflags.JSONRPCAuditLogRecordResponses, cosmosevmserverconfig.DefaultAuditLogConfig().RecordResponses, "Enables the audit logging of the JSON-RPC responses")
+
+	cmd.Flags().Bool(srvflags.JSONRPCArchiveEnable, cosmosevmserverconfig.DefaultArchiveConfig().Enable, "Enables the routing of the historical JSON-RPC state queries to the archive gRPC endpoints")
+	cmd.Flags().Int64(srvflags.JSONRPCArchiveRecentHeights, cosmosevmserverconfig.DefaultArchiveConfig().RecentHeights, "the number of heights below the latest one whose JSON-RPC state queries are served by the local node")
+
+	cmd.Flags().String(srvflags.EVMTracer, cosmosevmserverconfig.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown|precompileTracer)") //nolint:lll
+	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, cosmosevmserverconfig.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                                  //nolint:lll
+	cmd.Flags().Bool(srvflags.EVMEnablePreimageRecording, cosmosevmserverconfig.DefaultEnablePreimageRecording, "Enables tracking of SHA3 preimages in the EVM (not implemented yet)")                                       //nolint:lll
 	cmd.Flags().Uint64(srvflags.EVMChainID, cosmosevmserverconfig.DefaultEVMChainID, "the EIP-155 compatible replay protection chain ID")
 	cmd.Flags().Uint64(srvflags.EVMMinTip, cosmosevmserverconfig.DefaultEVMMinTip, "the minimum priority fee for the mempool")
 	cmd.Flags().String(srvflags.EvmGethMetricsAddress, cosmosevmserverconfig.DefaultGethMetricsAddress, "the address to bind the geth metrics server to")
+	cmd.Flags().Int(srvflags.EVMParallelExecutionWorkers, cosmosevmserverconfig.DefaultParallelExecutionWorkers, "the number of workers executing the blocks of Ethereum txs in parallel (0=disabled)")
 
 	cmd.Flags().Uint64(srvflags.EVMMempoolPriceLimit, cosmosevmserverconfig.DefaultMempoolConfig().PriceLimit, "the minimum gas price to enforce for acceptance into the pool (in wei)")
 	cmd.Flags().Uint64(srvflags.EVMMempoolPriceBump, cosmosevmserverconfig.DefaultMempoolConfig().PriceBump, "the minimum price bump percentage to replace an already existing transaction (nonce)")
@@ -489,7 +523,11 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 		}
 
 		idxLogger := svrCtx.Logger.With("indexer", "evm")
-		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
+		kvIdxer := indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
+		kvIdxer.SetAddressIndex(config.JSONRPC.EnableAddressIndex)
+		kvIdxer.SetLogIndex(config.JSONRPC.EnableLogIndex)
+		kvIdxer.SetSyntheticLogs(config.JSONRPC.EnableSyntheticLogs)
+		idxer = kvIdxer
 		indexerService := NewEVMIndexerService(idxer, clientCtx.Client.(rpcclient.Client))
 		indexerService.SetLogger(servercmtlog.CometLoggerWrapper{Logger: idxLogger})
 
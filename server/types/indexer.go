@@ -17,4 +17,56 @@ type EVMTxIndexer interface {
 	GetByTxHash(common.Hash) (*TxResult, error)
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
+
+	// AddressIndexEnabled returns false if the optional address indexes are not built,
+	// in which case the address lookups return no results.
+	AddressIndexEnabled() bool
+	// GetByAddress returns up to limit txs in which the address appears with one of
+	// the given roles, starting from the given position, included, in the given
+	// direction. It also returns the position of the next page, nil if there is none.
+	GetByAddress(address common.Address, roles AddressRole, start TxPosition, reverse bool, limit int) ([]*AddressTxResult, *TxPosition, error)
+	// GetTxHashBySenderAndNonce returns nil if tx not found.
+	GetTxHashBySenderAndNonce(sender common.Address, nonce uint64) (*common.Hash, error)
+
+	// LogIndexEnabled returns false if the optional log index is not built.
+	LogIndexEnabled() bool
+	// LogIndexedRange returns the latest contiguous range of blocks covered by
+	// the log index, -1, -1 if it's empty.
+	LogIndexedRange() (int64, int64, error)
+	// LogIndexedRangeAt returns the contiguous range of blocks covered by the log
+	// index which contains the block, -1, -1 if the block isn't covered.
+	LogIndexedRangeAt(height int64) (int64, int64, error)
+	// GetLogBlocks returns the sorted numbers of the blocks in [from, to] which contain
+	// logs matching the addresses and topics, with eth_getLogs semantics.
+	GetLogBlocks(addresses []common.Address, topics [][]common.Hash, from, to int64) ([]int64, error)
+}
+
+// AddressRole is the set of roles of an address in an eth tx.
+type AddressRole uint8
+
+const (
+	// AddressRoleSender is the sender of the tx.
+	AddressRoleSender AddressRole = 1 << iota
+	// AddressRoleRecipient is the recipient of the tx.
+	AddressRoleRecipient
+	// AddressRoleContractCreated is the contract created by the tx.
+	AddressRoleContractCreated
+	// AddressRoleLogEmitter is a contract emitting logs in the tx.
+	AddressRoleLogEmitter
+
+	// AddressRoleAll matches any role.
+	AddressRoleAll = AddressRoleSender | AddressRoleRecipient | AddressRoleContractCreated | AddressRoleLogEmitter
+)
+
+// TxPosition is the position of an eth tx in the chain.
+type TxPosition struct {
+	Height     int64
+	EthTxIndex int32
+}
+
+// AddressTxResult is an eth tx in which an address appears.
+type AddressTxResult struct {
+	TxHash common.Hash
+	Roles  AddressRole
+	Result *TxResult
 }
@@ -55,6 +55,7 @@ func AddCommands(
 
 		// custom tx indexer command
 		NewIndexTxCmd(),
+		NewRPCReplayCmd(),
 	)
 }
 
@@ -0,0 +1,172 @@
+package indexer
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/indexer"
+	servertypes "github.com/cosmos/evm/server/types"
+	"github.com/cosmos/evm/testutil/constants"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/vm/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestKVIndexerAddressIndex(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := utiltx.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	nw := network.New(create, options...)
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	to := common.BigToAddress(big.NewInt(1))
+	token := common.BigToAddress(big.NewInt(3))
+	buildBlock := func(height int64, nonce uint64) (*cmttypes.Block, []*abci.ExecTxResult, common.Hash) {
+		tx := types.NewTx(&types.EvmTxArgs{
+			Nonce:    nonce,
+			To:       &to,
+			Amount:   big.NewInt(1000),
+			GasLimit: 21000,
+		})
+		tx.From = from.Bytes()
+		require.NoError(t, tx.Sign(ethSigner, signer))
+		txHash := tx.AsTransaction().Hash()
+
+		tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), constants.ExampleAttoDenom)
+		require.NoError(t, err)
+		txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+		require.NoError(t, err)
+
+		// the tx emits a log from the token contract
+		txData := &sdk.TxMsgData{
+			MsgResponses: []*codectypes.Any{codectypes.UnsafePackAny(&types.MsgEthereumTxResponse{
+				Hash: txHash.Hex(),
+				Logs: []*types.Log{{Address: token.Hex(), TxHash: txHash.Hex()}},
+			})},
+		}
+		dataBz, err := proto.Marshal(txData)
+		require.NoError(t, err)
+
+		block := &cmttypes.Block{Header: cmttypes.Header{Height: height}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}}
+		results := []*abci.ExecTxResult{
+			{
+				Code: 0,
+				Data: dataBz,
+				Events: []abci.Event{
+					{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "ethereumTxHash", Value: txHash.Hex()},
+						{Key: "txIndex", Value: "0"},
+						{Key: "amount", Value: "1000"},
+						{Key: "txGasUsed", Value: "21000"},
+						{Key: "txHash", Value: ""},
+						{Key: "recipient", Value: to.Hex()},
+					}},
+				},
+			},
+		}
+		return block, results, txHash
+	}
+
+	latest := servertypes.TxPosition{Height: math.MaxInt64, EthTxIndex: math.MaxInt32}
+	first := servertypes.TxPosition{Height: 1}
+
+	t.Run("disabled", func(t *testing.T) {
+		idxer := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx)
+		block, results, _ := buildBlock(1, 0)
+		require.NoError(t, idxer.IndexBlock(block, results))
+		require.False(t, idxer.AddressIndexEnabled())
+
+		txs, next, err := idxer.GetByAddress(from, servertypes.AddressRoleAll, latest, true, 10)
+		require.NoError(t, err)
+		require.Empty(t, txs)
+		require.Nil(t, next)
+
+		// backfill the address indexes of the existing db
+		require.NoError(t, idxer.IndexBlockAddresses(block, results))
+		txs, _, err = idxer.GetByAddress(from, servertypes.AddressRoleAll, latest, true, 10)
+		require.NoError(t, err)
+		require.Len(t, txs, 1)
+		require.Equal(t, int64(1), txs[0].Result.Height)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		idxer := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx)
+		idxer.SetAddressIndex(true)
+		require.True(t, idxer.AddressIndexEnabled())
+
+		hashes := make([]common.Hash, 3)
+		for i := range hashes {
+			block, results, txHash := buildBlock(int64(i+1), uint64(i))
+			require.NoError(t, idxer.IndexBlock(block, results))
+			hashes[i] = txHash
+		}
+
+		// newest first, paginated
+		txs, next, err := idxer.GetByAddress(from, servertypes.AddressRoleAll, latest, true, 2)
+		require.NoError(t, err)
+		require.Len(t, txs, 2)
+		require.Equal(t, hashes[2], txs[0].TxHash)
+		require.Equal(t, servertypes.AddressRoleSender, txs[0].Roles)
+		require.Equal(t, int64(2), txs[1].Result.Height)
+		require.Equal(t, &servertypes.TxPosition{Height: 1}, next)
+
+		txs, next, err = idxer.GetByAddress(from, servertypes.AddressRoleAll, *next, true, 2)
+		require.NoError(t, err)
+		require.Nil(t, next)
+		require.Len(t, txs, 1)
+		require.Equal(t, int64(1), txs[0].Result.Height)
+
+		// oldest first for the recipient, starting from the second block
+		txs, next, err = idxer.GetByAddress(to, servertypes.AddressRoleRecipient, servertypes.TxPosition{Height: 2}, false, 10)
+		require.NoError(t, err)
+		require.Nil(t, next)
+		require.Len(t, txs, 2)
+		require.Equal(t, int64(2), txs[0].Result.Height)
+		require.Equal(t, int64(3), txs[1].Result.Height)
+
+		// the roles are filtered
+		txs, _, err = idxer.GetByAddress(to, servertypes.AddressRoleSender|servertypes.AddressRoleLogEmitter, first, false, 10)
+		require.NoError(t, err)
+		require.Empty(t, txs)
+
+		txs, _, err = idxer.GetByAddress(token, servertypes.AddressRoleLogEmitter, first, false, 10)
+		require.NoError(t, err)
+		require.Len(t, txs, 3)
+		require.Equal(t, servertypes.AddressRoleLogEmitter, txs[0].Roles)
+
+		txs, _, err = idxer.GetByAddress(common.BigToAddress(big.NewInt(2)), servertypes.AddressRoleAll, first, false, 10)
+		require.NoError(t, err)
+		require.Empty(t, txs)
+
+		for nonce, hash := range hashes {
+			res, err := idxer.GetTxHashBySenderAndNonce(from, uint64(nonce))
+			require.NoError(t, err)
+			require.NotNil(t, res)
+			require.Equal(t, hash, *res)
+		}
+		res, err := idxer.GetTxHashBySenderAndNonce(from, uint64(len(hashes)))
+		require.NoError(t, err)
+		require.Nil(t, res)
+	})
+}
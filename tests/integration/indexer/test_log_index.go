@@ -0,0 +1,211 @@
+package indexer
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/indexer"
+	"github.com/cosmos/evm/testutil/constants"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/vm/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestKVIndexerLogIndex(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := utiltx.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	nw := network.New(create, options...)
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	var (
+		to       = common.BigToAddress(big.NewInt(1))
+		tokenA   = common.BigToAddress(big.NewInt(3))
+		tokenB   = common.BigToAddress(big.NewInt(4))
+		transfer = common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+		approval = common.HexToHash("0x8c5be1e5ebec7d5bd14f71427b1e84f3dd0314c0f7b2291e5b200ac8c7c3b925")
+		alice    = common.BytesToHash(from.Bytes())
+	)
+
+	// buildBlock builds a block with an eth tx emitting the given logs, or an
+	// empty block if there are none.
+	buildBlock := func(height int64, nonce uint64, logs []*types.Log) (*cmttypes.Block, []*abci.ExecTxResult) {
+		block := &cmttypes.Block{Header: cmttypes.Header{Height: height}}
+		if logs == nil {
+			return block, nil
+		}
+
+		tx := types.NewTx(&types.EvmTxArgs{
+			Nonce:    nonce,
+			To:       &to,
+			Amount:   big.NewInt(1000),
+			GasLimit: 21000,
+		})
+		tx.From = from.Bytes()
+		require.NoError(t, tx.Sign(ethSigner, signer))
+		txHash := tx.AsTransaction().Hash()
+
+		tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), constants.ExampleAttoDenom)
+		require.NoError(t, err)
+		txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+		require.NoError(t, err)
+
+		for i, l := range logs {
+			l.TxHash = txHash.Hex()
+			l.Index = uint64(i)
+		}
+		txData := &sdk.TxMsgData{
+			MsgResponses: []*codectypes.Any{codectypes.UnsafePackAny(&types.MsgEthereumTxResponse{
+				Hash: txHash.Hex(),
+				Logs: logs,
+			})},
+		}
+		dataBz, err := proto.Marshal(txData)
+		require.NoError(t, err)
+
+		block.Data = cmttypes.Data{Txs: []cmttypes.Tx{txBz}}
+		results := []*abci.ExecTxResult{
+			{
+				Code: 0,
+				Data: dataBz,
+				Events: []abci.Event{
+					{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "ethereumTxHash", Value: txHash.Hex()},
+						{Key: "txIndex", Value: "0"},
+						{Key: "amount", Value: "1000"},
+						{Key: "txGasUsed", Value: "21000"},
+						{Key: "txHash", Value: ""},
+						{Key: "recipient", Value: to.Hex()},
+					}},
+				},
+			},
+		}
+		return block, results
+	}
+
+	// logs of the blocks 1 to 5, block 3 is empty
+	blockLogs := [][]*types.Log{
+		{{Address: tokenA.Hex(), Topics: []string{transfer.Hex(), alice.Hex()}}},
+		{{Address: tokenB.Hex(), Topics: []string{transfer.Hex()}}},
+		nil,
+		{{Address: tokenA.Hex(), Topics: []string{approval.Hex(), alice.Hex()}}, {Address: tokenB.Hex()}},
+		{{Address: tokenB.Hex(), Topics: []string{approval.Hex()}}},
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		idxer := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx)
+		block, results := buildBlock(1, 0, blockLogs[0])
+		require.NoError(t, idxer.IndexBlock(block, results))
+		require.False(t, idxer.LogIndexEnabled())
+
+		first, last, err := idxer.LogIndexedRange()
+		require.NoError(t, err)
+		require.Equal(t, int64(-1), first)
+		require.Equal(t, int64(-1), last)
+
+		// backfill the log index of the existing db
+		require.NoError(t, idxer.IndexBlockLogs(block, results))
+		first, last, err = idxer.LogIndexedRange()
+		require.NoError(t, err)
+		require.Equal(t, int64(1), first)
+		require.Equal(t, int64(1), last)
+
+		blocks, err := idxer.GetLogBlocks([]common.Address{tokenA}, nil, 1, 1)
+		require.NoError(t, err)
+		require.Equal(t, []int64{1}, blocks)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		idxer := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx)
+		idxer.SetLogIndex(true)
+		require.True(t, idxer.LogIndexEnabled())
+
+		var nonce uint64
+		for i, logs := range blockLogs {
+			block, results := buildBlock(int64(i+1), nonce, logs)
+			require.NoError(t, idxer.IndexBlock(block, results))
+			if logs != nil {
+				nonce++
+			}
+		}
+
+		first, last, err := idxer.LogIndexedRange()
+		require.NoError(t, err)
+		require.Equal(t, int64(1), first)
+		require.Equal(t, int64(5), last)
+
+		testCases := []struct {
+			name      string
+			addresses []common.Address
+			topics    [][]common.Hash
+			from, to  int64
+			expBlocks []int64
+		}{
+			{"address", []common.Address{tokenA}, nil, 1, 5, []int64{1, 4}},
+			{"addresses", []common.Address{tokenA, tokenB}, nil, 1, 5, []int64{1, 2, 4, 5}},
+			{"address in range", []common.Address{tokenB}, nil, 3, 4, []int64{4}},
+			{"first topic", nil, [][]common.Hash{{transfer}}, 1, 5, []int64{1, 2}},
+			{"second topic", nil, [][]common.Hash{{}, {alice}}, 1, 5, []int64{1, 4}},
+			{"topic at another position", nil, [][]common.Hash{{alice}}, 1, 5, []int64{}},
+			{"address and topics", []common.Address{tokenB}, [][]common.Hash{{transfer, approval}}, 1, 5, []int64{2, 5}},
+			{"no match", []common.Address{to}, nil, 1, 5, []int64{}},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				blocks, err := idxer.GetLogBlocks(tc.addresses, tc.topics, tc.from, tc.to)
+				require.NoError(t, err)
+				require.Equal(t, tc.expBlocks, blocks)
+			})
+		}
+
+		_, err = idxer.GetLogBlocks(nil, [][]common.Hash{{}}, 1, 5)
+		require.Error(t, err)
+
+		// a gap starts a new range, the previous one is still covered
+		block, results := buildBlock(8, nonce, blockLogs[0])
+		require.NoError(t, idxer.IndexBlock(block, results))
+		nonce++
+		first, last, err = idxer.LogIndexedRange()
+		require.NoError(t, err)
+		require.Equal(t, int64(8), first)
+		require.Equal(t, int64(8), last)
+		first, last, err = idxer.LogIndexedRangeAt(3)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), first)
+		require.Equal(t, int64(5), last)
+		first, last, err = idxer.LogIndexedRangeAt(6)
+		require.NoError(t, err)
+		require.Equal(t, int64(-1), first)
+		require.Equal(t, int64(-1), last)
+
+		// filling the gap joins the ranges
+		for height := int64(7); height >= 6; height-- {
+			block, results := buildBlock(height, nonce, nil)
+			require.NoError(t, idxer.IndexBlock(block, results))
+		}
+		first, last, err = idxer.LogIndexedRange()
+		require.NoError(t, err)
+		require.Equal(t, int64(1), first)
+		require.Equal(t, int64(8), last)
+	})
+}
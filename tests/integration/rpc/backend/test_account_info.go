@@ -150,15 +150,23 @@ func (s *TestSuite) TestGetProof() {
 					bytes.HexBytes(append(authtypes.AddressStoreKeyPrefix, address1.Bytes()...)),
 					cmtrpcclient.ABCIQueryOptions{Height: iavlHeight, Prove: true},
 				)
+				RegisterABCIQueryWithOptions(
+					client,
+					bn.Int64(),
+					"store/evm/key",
+					bytes.HexBytes(append(evmtypes.KeyPrefixCodeHash, address1.Bytes()...)),
+					cmtrpcclient.ABCIQueryOptions{Height: iavlHeight, Prove: true},
+				)
 			},
 			true,
 			&rpctypes.AccountResult{
-				Address:      address1,
-				AccountProof: []string{""},
-				Balance:      (*hexutil.Big)(big.NewInt(0)),
-				CodeHash:     common.HexToHash(""),
-				Nonce:        0x0,
-				StorageHash:  common.Hash{},
+				Address:       address1,
+				AccountProof:  []string{""},
+				Balance:       (*hexutil.Big)(big.NewInt(0)),
+				CodeHash:      common.HexToHash(""),
+				CodeHashProof: []string{""},
+				Nonce:         0x0,
+				StorageHash:   common.Hash{},
 				StorageProof: []rpctypes.StorageResult{
 					{
 						Key:   "0x0",
@@ -199,15 +207,23 @@ func (s *TestSuite) TestGetProof() {
 					bytes.HexBytes(append(authtypes.AddressStoreKeyPrefix, address1.Bytes()...)),
 					cmtrpcclient.ABCIQueryOptions{Height: iavlHeight, Prove: true},
 				)
+				RegisterABCIQueryWithOptions(
+					client,
+					iavlHeight,
+					"store/evm/key",
+					bytes.HexBytes(append(evmtypes.KeyPrefixCodeHash, address1.Bytes()...)),
+					cmtrpcclient.ABCIQueryOptions{Height: iavlHeight, Prove: true},
+				)
 			},
 			true,
 			&rpctypes.AccountResult{
-				Address:      address1,
-				AccountProof: []string{""},
-				Balance:      (*hexutil.Big)(big.NewInt(0)),
-				CodeHash:     common.HexToHash(""),
-				Nonce:        0x0,
-				StorageHash:  common.Hash{},
+				Address:       address1,
+				AccountProof:  []string{""},
+				Balance:       (*hexutil.Big)(big.NewInt(0)),
+				CodeHash:      common.HexToHash(""),
+				CodeHashProof: []string{""},
+				Nonce:         0x0,
+				StorageHash:   common.Hash{},
 				StorageProof: []rpctypes.StorageResult{
 					{
 						Key:   "0x0",
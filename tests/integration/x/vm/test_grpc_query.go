@@ -18,6 +18,7 @@ import (
 	"github.com/holiman/uint256"
 	"github.com/stretchr/testify/require"
 
+	rpctypes "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/server/config"
 	testconstants "github.com/cosmos/evm/testutil/constants"
 	"github.com/cosmos/evm/testutil/integration/evm/factory"
@@ -382,6 +383,151 @@ func (s *KeeperTestSuite) TestQueryCode() {
 	}
 }
 
+func (s *KeeperTestSuite) TestQueryStorageRangeAt() {
+	addr := common.HexToAddress("0x1000000000000000000000000000000000000fff")
+	keys := []common.Hash{common.HexToHash("0x01"), common.HexToHash("0x02"), common.HexToHash("0x03")}
+
+	testCases := []struct {
+		msg     string
+		req     *types.QueryStorageRangeAtRequest
+		expResp *types.QueryStorageRangeAtResponse
+		expPass bool
+	}{
+		{
+			"invalid address",
+			&types.QueryStorageRangeAtRequest{Address: invalidAddress},
+			nil,
+			false,
+		},
+		{
+			"success - all the storage",
+			&types.QueryStorageRangeAtRequest{Address: addr.Hex()},
+			&types.QueryStorageRangeAtResponse{
+				Storage: types.Storage{
+					types.NewState(keys[0], common.BigToHash(big.NewInt(1))),
+					types.NewState(keys[1], common.BigToHash(big.NewInt(2))),
+					types.NewState(keys[2], common.BigToHash(big.NewInt(3))),
+				},
+			},
+			true,
+		},
+		{
+			"success - paginated from start key",
+			&types.QueryStorageRangeAtRequest{Address: addr.Hex(), StartKey: keys[1].Hex(), MaxResult: 1},
+			&types.QueryStorageRangeAtResponse{
+				Storage: types.Storage{types.NewState(keys[1], common.BigToHash(big.NewInt(2)))},
+				NextKey: keys[2].Hex(),
+			},
+			true,
+		},
+		{
+			"success - no storage",
+			&types.QueryStorageRangeAtRequest{Address: common.HexToAddress("0x2000000000000000000000000000000000000fff").Hex()},
+			&types.QueryStorageRangeAtResponse{Storage: types.Storage{}},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			s.SetupTest()
+			for i, key := range keys {
+				s.Network.App.GetEVMKeeper().SetState(s.Network.GetContext(), addr, key, common.BigToHash(big.NewInt(int64(i+1))).Bytes())
+			}
+
+			ctx := s.Network.GetContext()
+			res, err := s.Network.GetEvmClient().StorageRangeAt(ctx, tc.req)
+
+			s.Require().Equal(tc.expResp, res)
+			if tc.expPass {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryAccountRange() {
+	// the addresses are greater than the ones of the genesis contracts
+	addrs := []common.Address{
+		common.HexToAddress("0xfffffffffffffffffffffffffffffffffffffff1"),
+		common.HexToAddress("0xfffffffffffffffffffffffffffffffffffffff2"),
+	}
+	start := common.HexToAddress("0xfffffffffffffffffffffffffffffffffffffff0")
+	code := []byte("code")
+	key := common.HexToHash("0x01")
+	value := common.HexToHash("0x02")
+
+	dumpAccount := func(addr common.Address, withCode, withStorage bool) types.DumpAccount {
+		account := types.DumpAccount{
+			Address:  addr.Hex(),
+			Balance:  "0",
+			CodeHash: crypto.Keccak256Hash(code).Hex(),
+		}
+		if withCode {
+			account.Code = hexutil.Encode(code)
+		}
+		if withStorage {
+			account.Storage = types.Storage{types.NewState(key, value)}
+		}
+		return account
+	}
+
+	testCases := []struct {
+		msg     string
+		req     *types.QueryAccountRangeRequest
+		expResp *types.QueryAccountRangeResponse
+		expPass bool
+	}{
+		{
+			"invalid start address",
+			&types.QueryAccountRangeRequest{Start: invalidAddress},
+			nil,
+			false,
+		},
+		{
+			"success - code and storage",
+			&types.QueryAccountRangeRequest{Start: start.Hex()},
+			&types.QueryAccountRangeResponse{
+				Accounts: []types.DumpAccount{dumpAccount(addrs[0], true, true), dumpAccount(addrs[1], true, true)},
+			},
+			true,
+		},
+		{
+			"success - paginated without code and storage",
+			&types.QueryAccountRangeRequest{Start: start.Hex(), MaxResult: 1, NoCode: true, NoStorage: true},
+			&types.QueryAccountRangeResponse{
+				Accounts: []types.DumpAccount{dumpAccount(addrs[0], false, false)},
+				Next:     addrs[1].Hex(),
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			s.SetupTest()
+			stateDB := s.Network.GetStateDB()
+			for _, addr := range addrs {
+				stateDB.SetCode(addr, code)
+				stateDB.SetState(addr, key, value)
+			}
+			s.Require().NoError(stateDB.Commit())
+
+			ctx := s.Network.GetContext()
+			res, err := s.Network.GetEvmClient().AccountRange(ctx, tc.req)
+
+			s.Require().Equal(tc.expResp, res)
+			if tc.expPass {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
 // TODO: Fix this one
 func (s *KeeperTestSuite) TestQueryTxLogs() {
 	expLogs := []*types.Log{}
@@ -2212,6 +2358,147 @@ func (s *KeeperTestSuite) TestEthCall() {
 	}
 }
 
+func (s *KeeperTestSuite) TestSimulateV1() {
+	s.SetupTest()
+
+	sender := tx.GenerateAddress()
+	recipient := tx.GenerateAddress()
+	balance := (*hexutil.Big)(big.NewInt(1e18))
+	value := (*hexutil.Big)(big.NewInt(1000))
+	tooHighNonce := hexutil.Uint64(5)
+	outOfRangeTime := hexutil.Uint64(math.MaxInt64 + 1)
+
+	funded := rpctypes.StateOverride{
+		sender: rpctypes.OverrideAccount{Balance: &balance},
+	}
+	transfer := types.TransactionArgs{From: &sender, To: &recipient, Value: value}
+
+	testCases := []struct {
+		name       string
+		opts       interface{}
+		expErrCode int64
+		expBlocks  int
+		expLogs    int
+	}{
+		{
+			"fail - empty input",
+			rpctypes.SimOpts{},
+			rpctypes.SimErrCodeInvalidParams,
+			0,
+			0,
+		},
+		{
+			"fail - block numbers not in order",
+			rpctypes.SimOpts{
+				BlockStateCalls: []rpctypes.SimBlock{
+					{BlockOverrides: &rpctypes.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(1))}},
+				},
+			},
+			rpctypes.SimErrCodeBlockNumberInvalid,
+			0,
+			0,
+		},
+		{
+			"fail - block timestamp out of range",
+			rpctypes.SimOpts{
+				BlockStateCalls: []rpctypes.SimBlock{
+					{BlockOverrides: &rpctypes.BlockOverrides{Time: &outOfRangeTime}},
+				},
+			},
+			rpctypes.SimErrCodeBlockTimestamp,
+			0,
+			0,
+		},
+		{
+			"fail - nonce too high in validation mode",
+			rpctypes.SimOpts{
+				Validation: true,
+				BlockStateCalls: []rpctypes.SimBlock{
+					{
+						StateOverrides: &funded,
+						Calls:          []types.TransactionArgs{{From: &sender, To: &recipient, Nonce: &tooHighNonce}},
+					},
+				},
+			},
+			rpctypes.SimErrCodeNonceTooHigh,
+			0,
+			0,
+		},
+		{
+			"pass - transfer without traced transfers",
+			rpctypes.SimOpts{
+				BlockStateCalls: []rpctypes.SimBlock{
+					{StateOverrides: &funded, Calls: []types.TransactionArgs{transfer}},
+				},
+			},
+			0,
+			1,
+			0,
+		},
+		{
+			"pass - traced transfers and gap filled with empty blocks",
+			rpctypes.SimOpts{
+				TraceTransfers: true,
+				BlockStateCalls: []rpctypes.SimBlock{
+					{StateOverrides: &funded, Calls: []types.TransactionArgs{transfer}},
+					{
+						BlockOverrides: &rpctypes.BlockOverrides{
+							Number: (*hexutil.Big)(big.NewInt(s.Network.GetContext().BlockHeight() + 4)),
+						},
+					},
+				},
+			},
+			0,
+			4,
+			1,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			opts, err := json.Marshal(tc.opts)
+			s.Require().NoError(err)
+
+			res, err := s.Network.GetEvmClient().SimulateV1(s.Network.GetContext(), &types.SimulateV1Request{
+				Opts:   opts,
+				GasCap: config.DefaultGasCap,
+			})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expErrCode, res.ErrorCode, res.Error)
+			if tc.expErrCode != 0 {
+				return
+			}
+
+			var blocks []struct {
+				Number hexutil.Big              `json:"number"`
+				Calls  []rpctypes.SimCallResult `json:"calls"`
+				Txs    []common.Hash            `json:"transactions"`
+			}
+			s.Require().NoError(json.Unmarshal(res.Data, &blocks))
+			s.Require().Len(blocks, tc.expBlocks)
+
+			calls := blocks[0].Calls
+			s.Require().Len(calls, 1)
+			s.Require().Len(blocks[0].Txs, 1)
+			s.Require().Nil(calls[0].Error)
+			s.Require().Equal(hexutil.Uint64(1), calls[0].Status)
+			s.Require().Len(calls[0].Logs, tc.expLogs)
+			if tc.expLogs > 0 {
+				log := calls[0].Logs[0]
+				s.Require().Equal(common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE"), log.Address)
+				s.Require().Equal(common.BytesToHash(sender.Bytes()), log.Topics[1])
+				s.Require().Equal(common.BytesToHash(recipient.Bytes()), log.Topics[2])
+				s.Require().Equal(blocks[0].Txs[0], log.TxHash)
+			}
+			for i := 1; i < len(blocks); i++ {
+				s.Require().Equal(blocks[i-1].Number.ToInt().Int64()+1, blocks[i].Number.ToInt().Int64())
+			}
+
+			// the simulation doesn't persist any state
+			s.Require().Equal(uint64(0), s.Network.App.GetEVMKeeper().GetNonce(s.Network.GetContext(), sender))
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestBalance() {
 	testCases := []struct {
 		name        string
@@ -2409,6 +2696,24 @@ func (s *KeeperTestSuite) TestEmptyRequest() {
 				return k.EstimateGas(s.Network.GetContext(), nil)
 			},
 		},
+		{
+			"SimulateV1 method",
+			func() (interface{}, error) {
+				return k.SimulateV1(s.Network.GetContext(), nil)
+			},
+		},
+		{
+			"StorageRangeAt method",
+			func() (interface{}, error) {
+				return k.StorageRangeAt(s.Network.GetContext(), nil)
+			},
+		},
+		{
+			"AccountRange method",
+			func() (interface{}, error) {
+				return k.AccountRange(s.Network.GetContext(), nil)
+			},
+		},
 		{
 			"TraceTx method",
 			func() (interface{}, error) {
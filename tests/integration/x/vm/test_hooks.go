@@ -132,3 +132,86 @@ func (s *KeeperTestSuite) TestPostTxProcessingFailureLogReversion() {
 	// Critical test: Verify logs are completely cleared
 	s.Require().Nil(res.Logs, "res.Logs should be nil after PostTxProcessing failure")
 }
+
+// RejectRecipientHook rejects the txs and the calls to a recipient
+type RejectRecipientHook struct {
+	Recipient common.Address
+}
+
+func (rh *RejectRecipientHook) PostTxProcessing(_ sdk.Context, _ common.Address, _ core.Message, _ *ethtypes.Receipt) error {
+	return nil
+}
+
+func (rh *RejectRecipientHook) PreTxProcessing(_ sdk.Context, _ common.Address, msg core.Message) error {
+	if msg.To != nil && *msg.To == rh.Recipient {
+		return errors.New("recipient rejected")
+	}
+	return nil
+}
+
+// RejectCallHook rejects the calls to a recipient
+type RejectCallHook struct {
+	Recipient common.Address
+}
+
+func (rh *RejectCallHook) PostTxProcessing(_ sdk.Context, _ common.Address, _ core.Message, _ *ethtypes.Receipt) error {
+	return nil
+}
+
+func (rh *RejectCallHook) PreCallProcessing(_ sdk.Context, _ common.Address, recipient common.Address) error {
+	if recipient == rh.Recipient {
+		return errors.New("call rejected")
+	}
+	return nil
+}
+
+func (s *KeeperTestSuite) TestPreProcessingHooksRejection() {
+	testCases := []struct {
+		msg       string
+		setupHook func(recipient common.Address) types.EvmHooks
+		expErr    string
+	}{
+		{
+			"pre tx processing rejection",
+			func(recipient common.Address) types.EvmHooks {
+				return &RejectRecipientHook{Recipient: recipient}
+			},
+			"failed to execute pre transaction processing",
+		},
+		{
+			"pre call processing rejection",
+			func(recipient common.Address) types.EvmHooks {
+				return &RejectCallHook{Recipient: recipient}
+			},
+			"call rejected",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.msg, func() {
+			s.SetupTest()
+			sender := s.Keyring.GetKey(0)
+			recipient := s.Keyring.GetAddr(1)
+			s.Network.App.GetEVMKeeper().SetHooks(keeper.NewMultiEvmHooks(tc.setupHook(recipient)))
+
+			k := s.Network.App.GetEVMKeeper()
+			ctx := s.Network.GetContext()
+			balance := s.Network.App.GetBankKeeper().GetBalance(ctx, recipient.Bytes(), types.GetEVMCoinDenom())
+
+			tx, err := s.Factory.GenerateSignedEthTx(sender.Priv, types.EvmTxArgs{
+				To:       &recipient,
+				Amount:   big.NewInt(100),
+				GasLimit: 21000,
+				GasPrice: big.NewInt(1000000000),
+			})
+			s.Require().NoError(err)
+			res, err := k.EthereumTx(ctx, tx.GetMsgs()[0].(*types.MsgEthereumTx))
+
+			// the rejected tx fails without transferring the amount
+			s.Require().NoError(err)
+			s.Require().Contains(res.VmError, tc.expErr)
+			s.Require().Equal(uint64(21000), res.GasUsed)
+			s.Require().Equal(balance, s.Network.App.GetBankKeeper().GetBalance(ctx, recipient.Bytes(), types.GetEVMCoinDenom()))
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package vm
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/contracts"
+	"github.com/cosmos/evm/testutil/integration/evm/factory"
+	"github.com/cosmos/evm/testutil/integration/evm/grpc"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	testKeyring "github.com/cosmos/evm/testutil/keyring"
+	testutiltypes "github.com/cosmos/evm/testutil/types"
+	"github.com/cosmos/evm/x/vm/types"
+)
+
+// TestParallelExecution executes the same blocks of Ethereum txs on a network executing them sequentially
+// and on a network executing them in parallel with block-STM, and checks that the results of the txs and
+// the final state are identical.
+func TestParallelExecution(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	keyring := testKeyring.New(4)
+	opts := []network.ConfigOption{
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	}
+	opts = append(opts, options...)
+	sequential := network.NewUnitTestNetwork(create, opts...)
+	parallel := network.NewUnitTestNetwork(create, append(opts, network.WithParallelExecution(4))...)
+	// the parallel execution enables the virtual fee collection, as in the apps
+	require.True(t, parallel.App.GetEVMKeeper().VirtualFeeCollectionEnabled())
+
+	// the txs are signed once with the sequential network, both networks have the same accounts
+	seqHandler, parHandler := grpc.NewIntegrationHandler(sequential), grpc.NewIntegrationHandler(parallel)
+	seqFactory, parFactory := factory.New(sequential, seqHandler), factory.New(parallel, parHandler)
+
+	nonces := make([]uint64, len(keyring.GetKeys()))
+	for i := range nonces {
+		res, err := seqHandler.GetEvmAccount(keyring.GetAddr(i))
+		require.NoError(t, err)
+		nonces[i] = res.Nonce
+	}
+	signTx := func(index int, txArgs types.EvmTxArgs) []byte {
+		txArgs.Nonce = nonces[index]
+		nonces[index]++
+		tx, err := seqFactory.GenerateSignedEthTx(keyring.GetPrivKey(index), txArgs)
+		require.NoError(t, err)
+		bz, err := sequential.App.GetTxConfig().TxEncoder()(tx)
+		require.NoError(t, err)
+		return bz
+	}
+	transfer := func(from, to int, amount int64) []byte {
+		recipient := keyring.GetAddr(to)
+		return signTx(from, types.EvmTxArgs{To: &recipient, Amount: big.NewInt(amount), GasLimit: 21000})
+	}
+
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract
+	deployArgs, err := seqFactory.GenerateDeployContractArgs(keyring.GetAddr(0), types.EvmTxArgs{GasLimit: 3_000_000}, testutiltypes.ContractDeploymentData{
+		Contract:        erc20,
+		ConstructorArgs: []interface{}{"TestToken", "TTK", uint8(18)},
+	})
+	require.NoError(t, err)
+	contractAddr := crypto.CreateAddress(keyring.GetAddr(0), nonces[0])
+	call := func(from int, method string, args ...interface{}) []byte {
+		input, err := factory.GenerateContractCallArgs(testutiltypes.CallArgs{ContractABI: erc20.ABI, MethodName: method, Args: args})
+		require.NoError(t, err)
+		return signTx(from, types.EvmTxArgs{To: &contractAddr, Input: input, GasLimit: 200_000})
+	}
+
+	executeBlock := func(txs ...[]byte) {
+		seqRes, err := sequential.NextBlockWithTxs(txs...)
+		require.NoError(t, err)
+		parRes, err := parallel.NextBlockWithTxs(txs...)
+		require.NoError(t, err)
+
+		require.Len(t, parRes.TxResults, len(txs))
+		for i, res := range seqRes.TxResults {
+			require.Equal(t, res.Code, parRes.TxResults[i].Code, "tx %d", i)
+			require.Equal(t, res.Log, parRes.TxResults[i].Log, "tx %d", i)
+			require.Equal(t, res.Data, parRes.TxResults[i].Data, "tx %d", i)
+			require.Equal(t, res.GasUsed, parRes.TxResults[i].GasUsed, "tx %d", i)
+			require.Equal(t, res.Events, parRes.TxResults[i].Events, "tx %d", i)
+		}
+	}
+
+	// contract deployment
+	executeBlock(signTx(0, deployArgs))
+
+	executeBlock(
+		// txs of the same sender
+		transfer(0, 1, 100),
+		transfer(0, 2, 200),
+		// transfers to the same recipient
+		transfer(1, 3, 300),
+		transfer(2, 3, 400),
+		// transfer from the recipient of the previous txs
+		transfer(3, 0, 500),
+		// contract calls transferring the tokens received by the previous ones
+		call(0, "mint", keyring.GetAddr(1), big.NewInt(1000)),
+		call(1, "transfer", keyring.GetAddr(2), big.NewInt(400)),
+		call(2, "transfer", keyring.GetAddr(3), big.NewInt(300)),
+		// reverted call: only the deployer can mint
+		call(3, "mint", keyring.GetAddr(3), big.NewInt(1000)),
+	)
+
+	for i := range keyring.GetKeys() {
+		addr := keyring.GetAddr(i)
+		seqAccount, err := seqHandler.GetEvmAccount(addr)
+		require.NoError(t, err)
+		parAccount, err := parHandler.GetEvmAccount(addr)
+		require.NoError(t, err)
+		require.Equal(t, seqAccount, parAccount, "account %s", addr)
+
+		balanceOf := testutiltypes.CallArgs{ContractABI: erc20.ABI, MethodName: "balanceOf", Args: []interface{}{addr}}
+		seqBalance, err := seqFactory.QueryContract(types.EvmTxArgs{To: &contractAddr}, balanceOf, 0)
+		require.NoError(t, err)
+		parBalance, err := parFactory.QueryContract(types.EvmTxArgs{To: &contractAddr}, balanceOf, 0)
+		require.NoError(t, err)
+		require.Equal(t, common.BytesToHash(seqBalance.Ret), common.BytesToHash(parBalance.Ret), "token balance of %s", addr)
+	}
+}
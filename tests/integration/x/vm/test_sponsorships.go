@@ -0,0 +1,151 @@
+package vm
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/params"
+
+	testcontracts "github.com/cosmos/evm/precompiles/testutil/contracts"
+	"github.com/cosmos/evm/testutil/integration/base/factory"
+	"github.com/cosmos/evm/testutil/integration/evm/utils"
+	testKeyring "github.com/cosmos/evm/testutil/keyring"
+	testutiltypes "github.com/cosmos/evm/testutil/types"
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// TestSponsoredTx checks that the fees of the txs calling a sponsored contract are paid by the
+// sponsor, so that a sender without funds can call it, and that the leftover gas is refunded to
+// the sponsorship within the limits of the sponsorship.
+func (s *KeeperTestSuite) TestSponsoredTx() {
+	s.SetupTest()
+
+	sponsor := s.Keyring.GetKey(0)
+	contract := s.Keyring.GetAddr(1)
+	user := testKeyring.NewKey()
+	budget := sdkmath.NewInt(1e18)
+
+	txArgs := types.EvmTxArgs{
+		To:       &contract,
+		GasLimit: params.TxGas * 2,
+		GasPrice: big.NewInt(1e9),
+	}
+
+	// the user can't pay the fees of the tx
+	_, err := s.Factory.ExecuteEthTx(user.Priv, txArgs)
+	s.Require().ErrorContains(err, "insufficient funds")
+	s.Require().NoError(s.Network.NextBlock())
+
+	setSponsorship := &types.MsgSetSponsorship{
+		Sponsor:          sponsor.AccAddr.String(),
+		Contract:         contract.Hex(),
+		MaxGasPerTx:      params.TxGas * 2,
+		Budget:           budget,
+		MaxFeePerGas:     sdkmath.NewIntFromBigInt(txArgs.GasPrice),
+		MaxFeesPerSender: sdkmath.NewIntFromUint64(params.TxGas * 2).Mul(sdkmath.NewIntFromBigInt(txArgs.GasPrice)),
+	}
+
+	// the contract, which has no owner, can only be sponsored once allowed by governance
+	_, err = s.Factory.ExecuteCosmosTx(sponsor.Priv, factory.CosmosTxArgs{Msgs: []sdk.Msg{setSponsorship}})
+	s.Require().ErrorContains(err, "nor allowed to sponsor it")
+	s.Require().NoError(s.Network.NextBlock())
+
+	proposalID, err := utils.SubmitProposal(s.Factory, s.Network, sponsor.Priv, "allow sponsor", &types.MsgUpdatePermissions{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Add: []types.PermissionEntry{{
+			List:    types.PermissionListSponsor,
+			Address: sponsor.Addr.Hex(),
+			Target:  contract.Hex(),
+		}},
+	})
+	s.Require().NoError(err)
+	s.Require().NoError(utils.ApproveProposal(s.Factory, s.Network, sponsor.Priv, proposalID))
+
+	_, err = s.Factory.ExecuteCosmosTx(sponsor.Priv, factory.CosmosTxArgs{Msgs: []sdk.Msg{setSponsorship}})
+	s.Require().NoError(err)
+	s.Require().NoError(s.Network.NextBlock())
+
+	sponsorBalance, err := s.Handler.GetBalanceFromEVM(sponsor.AccAddr)
+	s.Require().NoError(err)
+
+	_, err = s.Factory.ExecuteEthTx(user.Priv, txArgs)
+	s.Require().NoError(err)
+	s.Require().NoError(s.Network.NextBlock())
+
+	// the fees of the gas used are paid by the sponsor and deducted from the budget
+	fees := sdkmath.NewIntFromBigInt(new(big.Int).Mul(big.NewInt(int64(params.TxGas)), txArgs.GasPrice))
+	res, err := s.Network.GetEvmClient().Sponsorship(s.Network.GetContext(), &types.QuerySponsorshipRequest{Contract: contract.Hex()})
+	s.Require().NoError(err)
+	s.Require().Equal(budget.Sub(fees), res.Sponsorship.Budget)
+
+	newSponsorBalance, err := s.Handler.GetBalanceFromEVM(sponsor.AccAddr)
+	s.Require().NoError(err)
+	expBalance, ok := sdkmath.NewIntFromString(sponsorBalance.Balance)
+	s.Require().True(ok)
+	s.Require().Equal(expBalance.Sub(fees).String(), newSponsorBalance.Balance)
+
+	userBalance, err := s.Handler.GetBalanceFromEVM(user.AccAddr)
+	s.Require().NoError(err)
+	s.Require().Equal("0", userBalance.Balance)
+	userAccount, err := s.Handler.GetEvmAccount(user.Addr)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), userAccount.Nonce)
+
+	// the txs above the maximum gas or the maximum fee per gas of the sponsorship aren't sponsored
+	txArgs.GasLimit = params.TxGas*2 + 1
+	_, err = s.Factory.ExecuteEthTx(user.Priv, txArgs)
+	s.Require().ErrorContains(err, "insufficient funds")
+	s.Require().NoError(s.Network.NextBlock())
+
+	txArgs.GasLimit = params.TxGas * 2
+	txArgs.GasPrice = new(big.Int).Add(txArgs.GasPrice, big.NewInt(1))
+	_, err = s.Factory.ExecuteEthTx(user.Priv, txArgs)
+	s.Require().ErrorContains(err, "insufficient funds")
+	s.Require().NoError(s.Network.NextBlock())
+
+	// the maximum fees of another tx exceed the remaining fees for the user
+	txArgs.GasPrice = big.NewInt(1e9)
+	_, err = s.Factory.ExecuteEthTx(user.Priv, txArgs)
+	s.Require().ErrorContains(err, "insufficient funds")
+}
+
+// TestSponsorshipContractOwner checks that the owner of a contract can sponsor it without the
+// approval of governance, unlike the other accounts.
+func (s *KeeperTestSuite) TestSponsorshipContractOwner() {
+	s.SetupTest()
+
+	owner := s.Keyring.GetKey(0)
+	other := s.Keyring.GetKey(1)
+	ownableContract, err := testcontracts.LoadFlashLoanContract()
+	s.Require().NoError(err)
+	contract, err := s.Factory.DeployContract(owner.Priv, types.EvmTxArgs{}, testutiltypes.ContractDeploymentData{Contract: ownableContract})
+	s.Require().NoError(err)
+	s.Require().NoError(s.Network.NextBlock())
+
+	newMsg := func(sponsor sdk.AccAddress) *types.MsgSetSponsorship {
+		return &types.MsgSetSponsorship{
+			Sponsor:          sponsor.String(),
+			Contract:         contract.Hex(),
+			MaxGasPerTx:      params.TxGas * 2,
+			Budget:           sdkmath.NewInt(1e18),
+			MaxFeePerGas:     sdkmath.NewInt(1e9),
+			MaxFeesPerSender: sdkmath.NewInt(1e15),
+		}
+	}
+	_, err = s.Factory.ExecuteCosmosTx(other.Priv, factory.CosmosTxArgs{Msgs: []sdk.Msg{newMsg(other.AccAddr)}})
+	s.Require().ErrorContains(err, "nor allowed to sponsor it")
+	s.Require().NoError(s.Network.NextBlock())
+
+	_, err = s.Factory.ExecuteCosmosTx(owner.Priv, factory.CosmosTxArgs{Msgs: []sdk.Msg{newMsg(owner.AccAddr)}})
+	s.Require().NoError(err)
+	s.Require().NoError(s.Network.NextBlock())
+
+	res, err := s.Network.GetEvmClient().Sponsorship(s.Network.GetContext(), &types.QuerySponsorshipRequest{Contract: contract.Hex()})
+	s.Require().NoError(err)
+	s.Require().Equal(owner.Addr.Hex(), res.Sponsorship.Sponsor)
+}
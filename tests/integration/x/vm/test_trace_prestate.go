@@ -0,0 +1,152 @@
+package vm
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/cosmos/evm/precompiles/erc20"
+	"github.com/cosmos/evm/precompiles/staking"
+	testconstants "github.com/cosmos/evm/testutil/constants"
+	"github.com/cosmos/evm/testutil/integration/evm/factory"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	testutiltypes "github.com/cosmos/evm/testutil/types"
+	"github.com/cosmos/evm/x/vm/types"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type prestateDiff struct {
+	Pre  map[common.Address]prestateAccount `json:"pre"`
+	Post map[common.Address]prestateAccount `json:"post"`
+}
+
+type prestateAccount struct {
+	Balance *hexutil.Big `json:"balance,omitempty"`
+	Nonce   uint64       `json:"nonce,omitempty"`
+}
+
+func (s *KeeperTestSuite) TestTraceTxPrestatePrecompiles() {
+	s.SetupTest()
+
+	amount := big.NewInt(1e18)
+	recipient := utiltx.GenerateAddress()
+	bondedPool := common.BytesToAddress(authtypes.NewModuleAddress(stakingtypes.BondedPoolName))
+
+	testCases := []struct {
+		msg         string
+		getCallArgs func() (common.Address, testutiltypes.CallArgs)
+		diffMode    bool
+		// balance changes expected in the diff, keyed by address
+		expChanges map[common.Address]*big.Int
+		// accounts expected in the prestate when diffMode is disabled
+		expAccounts []common.Address
+	}{
+		{
+			msg: "staking delegate - diff mode",
+			getCallArgs: func() (common.Address, testutiltypes.CallArgs) {
+				return common.HexToAddress(types.StakingPrecompileAddress), testutiltypes.CallArgs{
+					ContractABI: staking.ABI,
+					MethodName:  staking.DelegateMethod,
+					Args:        []interface{}{s.Keyring.GetAddr(0), s.Network.GetValidators()[0].OperatorAddress, amount},
+				}
+			},
+			diffMode: true,
+			expChanges: map[common.Address]*big.Int{
+				s.Keyring.GetAddr(0): new(big.Int).Neg(amount),
+				bondedPool:           amount,
+			},
+		},
+		{
+			msg: "staking delegate - prestate",
+			getCallArgs: func() (common.Address, testutiltypes.CallArgs) {
+				return common.HexToAddress(types.StakingPrecompileAddress), testutiltypes.CallArgs{
+					ContractABI: staking.ABI,
+					MethodName:  staking.DelegateMethod,
+					Args:        []interface{}{s.Keyring.GetAddr(0), s.Network.GetValidators()[0].OperatorAddress, amount},
+				}
+			},
+			expAccounts: []common.Address{s.Keyring.GetAddr(0), bondedPool},
+		},
+		{
+			msg: "native ERC-20 transfer - diff mode",
+			getCallArgs: func() (common.Address, testutiltypes.CallArgs) {
+				return common.HexToAddress(testconstants.WEVMOSContractMainnet), testutiltypes.CallArgs{
+					ContractABI: erc20.ABI,
+					MethodName:  erc20.TransferMethod,
+					Args:        []interface{}{recipient, amount},
+				}
+			},
+			diffMode: true,
+			expChanges: map[common.Address]*big.Int{
+				s.Keyring.GetAddr(0): new(big.Int).Neg(amount),
+				recipient:            amount,
+			},
+		},
+		{
+			msg: "native ERC-20 transfer - prestate",
+			getCallArgs: func() (common.Address, testutiltypes.CallArgs) {
+				return common.HexToAddress(testconstants.WEVMOSContractMainnet), testutiltypes.CallArgs{
+					ContractABI: erc20.ABI,
+					MethodName:  erc20.TransferMethod,
+					Args:        []interface{}{recipient, amount},
+				}
+			},
+			expAccounts: []common.Address{s.Keyring.GetAddr(0), recipient},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.msg, func() {
+			to, callArgs := tc.getCallArgs()
+			input, err := factory.GenerateContractCallArgs(callArgs)
+			s.Require().NoError(err)
+
+			msg, err := s.Factory.GenerateSignedMsgEthereumTx(s.Keyring.GetPrivKey(0), types.EvmTxArgs{
+				To:       &to,
+				Input:    input,
+				GasLimit: 500_000,
+			})
+			s.Require().NoError(err)
+
+			req := getDefaultTraceTxRequest(s.Network)
+			req.Msg = &msg
+			req.TraceConfig = &types.TraceConfig{Tracer: "prestateTracer"}
+			if tc.diffMode {
+				req.TraceConfig.TracerJsonConfig = `{"diffMode":true}`
+			}
+
+			res, err := s.Network.GetEvmClient().TraceTx(s.Network.GetContext(), req)
+			s.Require().NoError(err)
+
+			if !tc.diffMode {
+				var prestate map[common.Address]prestateAccount
+				s.Require().NoError(json.Unmarshal(res.Data, &prestate))
+				for _, addr := range tc.expAccounts {
+					s.Require().Contains(prestate, addr)
+					s.Require().NotNil(prestate[addr].Balance)
+				}
+				return
+			}
+
+			var diff prestateDiff
+			s.Require().NoError(json.Unmarshal(res.Data, &diff))
+			for addr, expChange := range tc.expChanges {
+				s.Require().Contains(diff.Pre, addr)
+				s.Require().Contains(diff.Post, addr)
+				s.Require().NotNil(diff.Pre[addr].Balance)
+				s.Require().NotNil(diff.Post[addr].Balance)
+
+				change := new(big.Int).Sub(diff.Post[addr].Balance.ToInt(), diff.Pre[addr].Balance.ToInt())
+				s.Require().Equal(expChange.String(), change.String(), "unexpected balance change of %s", addr)
+
+				// the trace must not modify the state
+				balance := s.Network.App.GetEVMKeeper().GetBalance(s.Network.GetContext(), addr)
+				s.Require().Equal(diff.Pre[addr].Balance.ToInt().String(), balance.ToBig().String())
+			}
+		})
+	}
+}
@@ -34,6 +34,10 @@ type Config struct {
 
 	customBaseAppOpts []func(*baseapp.BaseApp)
 
+	// parallelExecutionWorkers is the number of workers executing the blocks of Ethereum txs in
+	// parallel with block-STM. The blocks are executed sequentially if it is 0.
+	parallelExecutionWorkers int
+
 	amountOfValidators  int
 	operatorsAddrs      []sdktypes.AccAddress
 	initialBondedAmount math.Int
@@ -205,6 +209,14 @@ func WithCustomBaseAppOpts(opts ...func(*baseapp.BaseApp)) ConfigOption {
 	}
 }
 
+// WithParallelExecution executes the blocks of Ethereum txs in parallel with block-STM on the given
+// number of workers.
+func WithParallelExecution(workers int) ConfigOption {
+	return func(cfg *Config) {
+		cfg.parallelExecutionWorkers = workers
+	}
+}
+
 // WithConsensusParams sets the custom consensus parameters for the network.
 func WithConsensusParams(params *cmtproto.ConsensusParams) ConfigOption {
 	return func(cfg *Config) {
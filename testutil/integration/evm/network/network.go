@@ -93,6 +93,9 @@ func New(createEvmApp CreateEvmApp, opts ...ConfigOption) *IntegrationNetwork {
 
 	// create a new testing app with the following params
 	evmApp := createEvmApp(cfg.chainID, cfg.eip155ChainID.Uint64(), cfg.customBaseAppOpts...)
+	if cfg.parallelExecutionWorkers > 0 {
+		setParallelExecution(evmApp, cfg.parallelExecutionWorkers)
+	}
 	err := network.configureAndInitChain(evmApp)
 	if err != nil {
 		panic(err)
@@ -0,0 +1,33 @@
+package network
+
+import (
+	"sort"
+
+	"github.com/cosmos/evm"
+	"github.com/cosmos/evm/x/vm/parallel"
+
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// setParallelExecution sets the block-STM runner of the Ethereum txs on the app as the apps do,
+// tracking all the KV and object stores mounted by the app.
+func setParallelExecution(app evm.EvmApp, workers int) {
+	cms, ok := app.GetBaseApp().CommitMultiStore().(*rootmulti.Store)
+	if !ok {
+		panic("parallel execution requires the root multistore")
+	}
+
+	var storeKeys []storetypes.StoreKey
+	for _, key := range cms.StoreKeysByName() {
+		switch key.(type) {
+		case *storetypes.KVStoreKey, *storetypes.ObjectStoreKey:
+			storeKeys = append(storeKeys, key)
+		}
+	}
+	sort.Slice(storeKeys, func(i, j int) bool {
+		return storeKeys[i].Name() < storeKeys[j].Name()
+	})
+
+	parallel.SetTxRunner(app.GetBaseApp(), app.GetEVMKeeper(), app.GetTxConfig().TxDecoder(), storeKeys, workers)
+}
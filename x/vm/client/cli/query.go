@@ -19,6 +19,9 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
+// flagCaller is the flag of the caller of the check-permission query
+const flagCaller = "caller"
+
 // GetQueryCmd returns the parent command for all x/bank CLi query commands.
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -35,6 +38,9 @@ func GetQueryCmd() *cobra.Command {
 		GetAccountCmd(),
 		GetParamsCmd(),
 		GetConfigCmd(),
+		GetPendingForksCmd(),
+		GetCheckPermissionCmd(),
+		GetSponsorshipCmd(),
 		HexToBech32Cmd(),
 		Bech32ToHexCmd(),
 		GetBankBalanceCmd(),
@@ -213,6 +219,34 @@ func GetConfigCmd() *cobra.Command {
 	return cmd
 }
 
+// GetPendingForksCmd queries the hard forks scheduled by governance which are not active yet
+func GetPendingForksCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-forks",
+		Short: "Get the pending hard forks",
+		Long:  "Get the hard forks scheduled by governance which are not active yet, with their activation height or time.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PendingForks(cmd.Context(), &types.QueryPendingForksRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetConfigCmd queries the evm configuration
 func HexToBech32Cmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -342,3 +376,80 @@ func GetERC20BalanceCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCheckPermissionCmd queries if an account can call a contract or create contracts
+func GetCheckPermissionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-permission SIGNER [RECIPIENT]",
+		Short: "Check if an account can call a contract or create contracts",
+		Long: `Check if a signer can call the recipient, or create contracts if the recipient isn't provided,
+under the access control of the params and the on-chain permission lists. The caller defaults to the signer.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryCheckPermissionRequest{}
+			if req.Signer, err = accountToHex(args[0]); err != nil {
+				return err
+			}
+			if len(args) == 2 {
+				if req.Recipient, err = accountToHex(args[1]); err != nil {
+					return err
+				}
+			}
+			if caller, _ := cmd.Flags().GetString(flagCaller); caller != "" {
+				if req.Caller, err = accountToHex(caller); err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.CheckPermission(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagCaller, "", "the caller of the operation, if it isn't the signer")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetSponsorshipCmd queries the sponsorship of a contract
+func GetSponsorshipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sponsorship CONTRACT",
+		Short: "Get the sponsor paying the fees of the transactions calling a contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			contract, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Sponsorship(cmd.Context(), &types.QuerySponsorshipRequest{Contract: contract})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -73,6 +73,26 @@ func InitGenesis(
 		panic(fmt.Errorf("error adding preinstalls: %s", err))
 	}
 
+	// the fork activations are applied to the chain config in BeginBlock
+	for _, activation := range data.PendingForks {
+		k.SetPendingFork(ctx, activation)
+	}
+	for _, activation := range data.ActivatedForks {
+		k.SetActivatedFork(ctx, activation)
+	}
+
+	for _, entry := range data.Permissions {
+		k.SetPermissionEntry(ctx, entry)
+	}
+
+	for _, sponsorship := range data.Sponsorships {
+		k.SetContractSponsorship(ctx, sponsorship)
+	}
+
+	for _, fees := range data.SponsoredFees {
+		k.SetSponsoredFees(ctx, fees)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -93,7 +113,12 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
 	})
 
 	return &types.GenesisState{
-		Accounts: ethGenAccounts,
-		Params:   k.GetParams(ctx),
+		Accounts:       ethGenAccounts,
+		Params:         k.GetParams(ctx),
+		PendingForks:   k.GetPendingForks(ctx),
+		ActivatedForks: k.GetActivatedForks(ctx),
+		Permissions:    k.GetPermissionEntries(ctx),
+		Sponsorships:   k.GetSponsorships(ctx),
+		SponsoredFees:  k.GetAllSponsoredFees(ctx),
 	}
 }
@@ -10,12 +10,17 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// BeginBlock emits a base fee event which will be adjusted to the evm decimals
+// BeginBlock applies the fork activations scheduled by governance and emits a
+// base fee event which will be adjusted to the evm decimals
 func (k *Keeper) BeginBlock(ctx sdk.Context) (err error) {
 	ctx, span := ctx.StartSpan(tracer, "BeginBlock", trace.WithAttributes(attribute.Int64("block_num", ctx.BlockHeight())))
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	logger := ctx.Logger().With("begin_block", "evm")
 
+	if err := k.ActivateForks(ctx); err != nil {
+		return err
+	}
+
 	// Base fee is already set on FeeMarket BeginBlock
 	// that runs before this one
 	// We emit this event on the EVM and FeeMarket modules
@@ -46,6 +46,31 @@ func CheckSenderBalance(
 	return nil
 }
 
+// CheckSponsoredSenderBalance validates that the sender of a sponsored tx has
+// enough funds to pay for the value of the transaction, since the fees are paid
+// by the sponsor.
+func CheckSponsoredSenderBalance(
+	balance sdkmath.Int,
+	ethTx *ethtypes.Transaction,
+) error {
+	value := ethTx.Value()
+
+	if value.Sign() < 0 {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidCoins,
+			"tx value (%s) is negative and invalid", value,
+		)
+	}
+
+	if balance.IsNegative() || balance.BigInt().Cmp(value) < 0 {
+		return errorsmod.Wrapf(
+			errortypes.ErrInsufficientFunds,
+			"sender balance < tx value (%s < %s)", balance, value,
+		)
+	}
+	return nil
+}
+
 // DeductTxCostsFromUserBalance deducts the fees from the user balance.
 func (k *Keeper) DeductTxCostsFromUserBalance(
 	ctx sdk.Context,
@@ -0,0 +1,240 @@
+package keeper
+
+import (
+	"strconv"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	evmtrace "github.com/cosmos/evm/trace"
+	"github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetPendingForks returns the fork activations scheduled by governance which
+// are not active yet, in the order of the forks.
+func (k Keeper) GetPendingForks(ctx sdk.Context) []types.ForkActivation {
+	return k.getForks(ctx, types.KeyPrefixPendingFork)
+}
+
+// GetActivatedForks returns the fork activations scheduled by governance which
+// are active, in the order of the forks.
+func (k Keeper) GetActivatedForks(ctx sdk.Context) []types.ForkActivation {
+	return k.getForks(ctx, types.KeyPrefixActivatedFork)
+}
+
+// SetPendingFork stores a pending fork activation, replacing the previous
+// activation of the fork if any.
+func (k Keeper) SetPendingFork(ctx sdk.Context, activation types.ForkActivation) {
+	k.setFork(ctx, types.KeyPrefixPendingFork, activation)
+}
+
+// SetActivatedFork stores an active fork activation
+func (k Keeper) SetActivatedFork(ctx sdk.Context, activation types.ForkActivation) {
+	k.setFork(ctx, types.KeyPrefixActivatedFork, activation)
+}
+
+func (k Keeper) deletePendingFork(ctx sdk.Context, fork string) {
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingFork).Delete([]byte(fork))
+}
+
+func (k Keeper) getForks(ctx sdk.Context, keyPrefix []byte) []types.ForkActivation {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	var forks []types.ForkActivation
+	for _, fork := range types.ScheduledForks {
+		bz := store.Get([]byte(fork))
+		if bz == nil {
+			continue
+		}
+		var activation types.ForkActivation
+		k.cdc.MustUnmarshal(bz, &activation)
+		forks = append(forks, activation)
+	}
+	return forks
+}
+
+func (k Keeper) getFork(ctx sdk.Context, keyPrefix []byte, fork string) (types.ForkActivation, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	bz := store.Get([]byte(fork))
+	if bz == nil {
+		return types.ForkActivation{}, false
+	}
+	var activation types.ForkActivation
+	k.cdc.MustUnmarshal(bz, &activation)
+	return activation, true
+}
+
+func (k Keeper) setFork(ctx sdk.Context, keyPrefix []byte, activation types.ForkActivation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	store.Set([]byte(activation.Fork), k.cdc.MustMarshal(&activation))
+}
+
+// ScheduleFork validates a fork activation against the current
+// rules and stores it as pending. The activation must be in the future, the
+// fork must not be configured already, and the activation must keep the order
+// of the forks: the previous fork must be active or scheduled no later, and
+// the next one, if scheduled, no earlier. The pending forks are compared with
+// the same kind of activation, by height or by time.
+func (k Keeper) ScheduleFork(ctx sdk.Context, activation types.ForkActivation) (err error) {
+	ctx, span := ctx.StartSpan(tracer, "ScheduleFork", trace.WithAttributes(
+		attribute.String("fork", activation.Fork),
+		attribute.Int64("height", activation.Height),
+	))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	if err := activation.Validate(); err != nil {
+		return err
+	}
+
+	height, now := ctx.BlockHeight(), blockUnixTime(ctx)
+	if activation.ActiveAt(height, now) {
+		return errorsmod.Wrapf(types.ErrInvalidForkActivation, "the activation of %s must be after the current block", activation.Fork)
+	}
+
+	cfg := types.GetChainConfig()
+	if cfg.ForkTime(activation.Fork) != nil {
+		return errorsmod.Wrapf(types.ErrInvalidForkActivation, "%s is already configured", activation.Fork)
+	}
+
+	index := types.ForkIndex(activation.Fork)
+	if index > 0 {
+		prev := types.ScheduledForks[index-1]
+		schedule, ok := k.forkSchedule(ctx, cfg, prev)
+		switch {
+		case !ok:
+			return errorsmod.Wrapf(types.ErrInvalidForkActivation, "%s must be scheduled before %s", prev, activation.Fork)
+		case schedule.ActiveAt(height, now):
+		case schedule.ByHeight() != activation.ByHeight():
+			return errorsmod.Wrapf(types.ErrInvalidForkActivation, "%s must be activated by height or by time like %s", activation.Fork, prev)
+		case activationValue(schedule) > activationValue(activation):
+			return errorsmod.Wrapf(types.ErrInvalidForkActivation, "%s can't be activated before %s", activation.Fork, prev)
+		}
+	}
+	if index < len(types.ScheduledForks)-1 {
+		next := types.ScheduledForks[index+1]
+		schedule, ok := k.forkSchedule(ctx, cfg, next)
+		switch {
+		case !ok:
+		case schedule.ActiveAt(height, now), schedule.ByHeight() != activation.ByHeight():
+			return errorsmod.Wrapf(types.ErrInvalidForkActivation, "%s must be activated before %s", activation.Fork, next)
+		case activationValue(activation) > activationValue(schedule):
+			return errorsmod.Wrapf(types.ErrInvalidForkActivation, "%s can't be activated after %s", activation.Fork, next)
+		}
+	}
+
+	k.SetPendingFork(ctx, activation)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeScheduleForkActivation,
+		sdk.NewAttribute(types.AttributeKeyFork, activation.Fork),
+		sdk.NewAttribute(types.AttributeKeyActivationHeight, strconv.FormatInt(activation.Height, 10)),
+		sdk.NewAttribute(types.AttributeKeyActivationTime, strconv.FormatUint(activation.Time, 10)),
+	))
+	return nil
+}
+
+// LoadActivatedForks applies the active fork activations to the chain config.
+// They aren't part of the chain config after a restart, so it must be called
+// when the app is loaded for CheckTx and the RPC to use the current rules
+// before the next block.
+func (k Keeper) LoadActivatedForks(ctx sdk.Context) error {
+	cfg := types.GetChainConfig()
+	updated, err := k.applyActivatedForks(ctx, cfg)
+	if err != nil || updated == cfg {
+		return err
+	}
+	return types.UpdateChainConfig(updated)
+}
+
+// applyActivatedForks returns the chain config with the active fork
+// activations applied.
+func (k Keeper) applyActivatedForks(ctx sdk.Context, cfg *types.ChainConfig) (updated *types.ChainConfig, err error) {
+	updated = cfg
+	for _, activation := range k.GetActivatedForks(ctx) {
+		if forkTime := updated.ForkTime(activation.Fork); forkTime != nil && *forkTime <= activation.Time {
+			continue
+		}
+		if updated, err = updated.WithForkTime(activation.Fork, activation.Time); err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to apply the activation of %s", activation.Fork)
+		}
+	}
+	return updated, nil
+}
+
+// ActivateForks applies the fork activations to the chain config: the ones
+// already active, in case they weren't loaded with LoadActivatedForks, and
+// the pending ones which are active from the current block. A fork activated
+// at a block height is activated at the time of the block, so that the chain
+// config keeps the rules of the previous blocks.
+func (k Keeper) ActivateForks(ctx sdk.Context) (err error) {
+	ctx, span := ctx.StartSpan(tracer, "ActivateForks")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	cfg := types.GetChainConfig()
+	updated, err := k.applyActivatedForks(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	height, now := ctx.BlockHeight(), blockUnixTime(ctx)
+	for _, pending := range k.GetPendingForks(ctx) {
+		if !pending.ActiveAt(height, now) {
+			continue
+		}
+
+		activation := types.ForkActivation{Fork: pending.Fork, Time: pending.Time}
+		if pending.ByHeight() {
+			activation.Time = now
+		}
+		next, err := updated.WithForkTime(activation.Fork, activation.Time)
+		if err != nil {
+			// the activation was valid when it was scheduled, drop it rather
+			// than halting the chain
+			k.Logger(ctx).Error("failed to activate fork", "fork", activation.Fork, "error", err.Error())
+			k.deletePendingFork(ctx, pending.Fork)
+			continue
+		}
+		updated = next
+
+		k.deletePendingFork(ctx, pending.Fork)
+		k.SetActivatedFork(ctx, activation)
+		k.Logger(ctx).Info("fork activated", "fork", activation.Fork, "height", height, "time", activation.Time)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeForkActivation,
+			sdk.NewAttribute(types.AttributeKeyFork, activation.Fork),
+			sdk.NewAttribute(types.AttributeKeyActivationHeight, strconv.FormatInt(height, 10)),
+			sdk.NewAttribute(types.AttributeKeyActivationTime, strconv.FormatUint(activation.Time, 10)),
+		))
+	}
+
+	if updated == cfg {
+		return nil
+	}
+	return types.UpdateChainConfig(updated)
+}
+
+// forkSchedule returns the activation of a fork, from the chain config if it
+// is configured or else from the pending forks.
+func (k Keeper) forkSchedule(ctx sdk.Context, cfg *types.ChainConfig, fork string) (types.ForkActivation, bool) {
+	if forkTime := cfg.ForkTime(fork); forkTime != nil {
+		return types.ForkActivation{Fork: fork, Time: *forkTime}, true
+	}
+	return k.getFork(ctx, types.KeyPrefixPendingFork, fork)
+}
+
+// activationValue returns the activation height or time of a fork activation
+func activationValue(activation types.ForkActivation) uint64 {
+	if activation.ByHeight() {
+		return uint64(activation.Height) //#nosec G115 -- the height is positive
+	}
+	return activation.Time
+}
+
+// blockUnixTime returns the unix time of the block of the context
+func blockUnixTime(ctx sdk.Context) uint64 {
+	return uint64(ctx.BlockTime().Unix()) //#nosec G115 -- the block time is after the epoch
+}
@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"time"
+
+	vmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// setForkTestConfig sets a chain config where Prague and Osaka aren't configured
+func (suite *KeeperTestSuite) setForkTestConfig() *vmtypes.ChainConfig {
+	cfg := vmtypes.DefaultChainConfig(0)
+	cfg.PragueTime = nil
+	cfg.OsakaTime = nil
+	vmtypes.NewEVMConfigurator().ResetTestConfig()
+	suite.Require().NoError(vmtypes.SetChainConfig(cfg))
+	suite.T().Cleanup(vmtypes.NewEVMConfigurator().ResetTestConfig)
+	return cfg
+}
+
+// forkCtx returns the suite context at the given block height and unix time
+func (suite *KeeperTestSuite) forkCtx(height int64, unix int64) sdk.Context {
+	return suite.ctx.WithBlockHeight(height).WithBlockTime(time.Unix(unix, 0)).WithEventManager(sdk.NewEventManager())
+}
+
+func (suite *KeeperTestSuite) TestScheduleFork() {
+	suite.setForkTestConfig()
+	ctx := suite.forkCtx(10, 1000)
+
+	testCases := []struct {
+		name       string
+		activation vmtypes.ForkActivation
+		expErr     string
+	}{
+		{"unknown fork", vmtypes.ForkActivation{Fork: "verkle", Height: 20}, "unknown fork"},
+		{"height and time", vmtypes.ForkActivation{Fork: vmtypes.ForkPrague, Height: 20, Time: 2000}, "exactly one"},
+		{"current height", vmtypes.ForkActivation{Fork: vmtypes.ForkPrague, Height: 10}, "after the current block"},
+		{"past time", vmtypes.ForkActivation{Fork: vmtypes.ForkPrague, Time: 900}, "after the current block"},
+		{"configured fork", vmtypes.ForkActivation{Fork: vmtypes.ForkCancun, Height: 20}, "already configured"},
+		{"previous fork not scheduled", vmtypes.ForkActivation{Fork: vmtypes.ForkOsaka, Height: 20}, "prague must be scheduled before osaka"},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := suite.vmKeeper.ScheduleFork(ctx, tc.activation)
+			suite.Require().ErrorIs(err, vmtypes.ErrInvalidForkActivation)
+			suite.Require().ErrorContains(err, tc.expErr)
+		})
+	}
+	suite.Require().Empty(suite.vmKeeper.GetPendingForks(ctx))
+
+	prague := vmtypes.ForkActivation{Fork: vmtypes.ForkPrague, Height: 20}
+	suite.Require().NoError(suite.vmKeeper.ScheduleFork(ctx, prague))
+
+	err := suite.vmKeeper.ScheduleFork(ctx, vmtypes.ForkActivation{Fork: vmtypes.ForkOsaka, Time: 2000})
+	suite.Require().ErrorContains(err, "osaka must be activated by height or by time like prague")
+	err = suite.vmKeeper.ScheduleFork(ctx, vmtypes.ForkActivation{Fork: vmtypes.ForkOsaka, Height: 15})
+	suite.Require().ErrorContains(err, "osaka can't be activated before prague")
+
+	osaka := vmtypes.ForkActivation{Fork: vmtypes.ForkOsaka, Height: 30}
+	suite.Require().NoError(suite.vmKeeper.ScheduleFork(ctx, osaka))
+
+	// rescheduling a pending fork must keep the order with the next fork
+	err = suite.vmKeeper.ScheduleFork(ctx, vmtypes.ForkActivation{Fork: vmtypes.ForkPrague, Height: 40})
+	suite.Require().ErrorContains(err, "prague can't be activated after osaka")
+	prague.Height = 25
+	suite.Require().NoError(suite.vmKeeper.ScheduleFork(ctx, prague))
+
+	suite.Require().Equal([]vmtypes.ForkActivation{prague, osaka}, suite.vmKeeper.GetPendingForks(ctx))
+
+	res, err := suite.vmKeeper.PendingForks(ctx, &vmtypes.QueryPendingForksRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]vmtypes.ForkActivation{prague, osaka}, res.Forks)
+}
+
+func (suite *KeeperTestSuite) TestActivateForks() {
+	cfg := suite.setForkTestConfig()
+	ctx := suite.forkCtx(10, 1000)
+
+	suite.Require().NoError(suite.vmKeeper.ScheduleFork(ctx, vmtypes.ForkActivation{Fork: vmtypes.ForkPrague, Height: 11}))
+	suite.Require().NoError(suite.vmKeeper.ScheduleFork(ctx, vmtypes.ForkActivation{Fork: vmtypes.ForkOsaka, Height: 12}))
+
+	// nothing to activate yet
+	suite.Require().NoError(suite.vmKeeper.ActivateForks(ctx))
+	suite.Require().Same(cfg, vmtypes.GetChainConfig())
+
+	ctx = suite.forkCtx(11, 1005)
+	suite.Require().NoError(suite.vmKeeper.ActivateForks(ctx))
+
+	// the fork is activated at the time of its activation block
+	ethCfg := vmtypes.GetEthChainConfig()
+	suite.Require().True(ethCfg.IsPrague(ethCfg.LondonBlock, 1005))
+	suite.Require().False(ethCfg.IsPrague(ethCfg.LondonBlock, 1004))
+	suite.Require().False(ethCfg.IsOsaka(ethCfg.LondonBlock, 1005))
+	suite.Require().Equal([]vmtypes.ForkActivation{{Fork: vmtypes.ForkPrague, Time: 1005}}, suite.vmKeeper.GetActivatedForks(ctx))
+	suite.Require().Equal([]vmtypes.ForkActivation{{Fork: vmtypes.ForkOsaka, Height: 12}}, suite.vmKeeper.GetPendingForks(ctx))
+
+	var activated bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == vmtypes.EventTypeForkActivation {
+			activated = true
+		}
+	}
+	suite.Require().True(activated)
+
+	// the activated forks are loaded again after a restart
+	vmtypes.NewEVMConfigurator().ResetTestConfig()
+	suite.Require().NoError(vmtypes.SetChainConfig(cfg))
+	suite.Require().NoError(suite.vmKeeper.LoadActivatedForks(ctx))
+	suite.Require().NotNil(vmtypes.GetChainConfig().ForkTime(vmtypes.ForkPrague))
+	suite.Require().Equal(uint64(1005), *vmtypes.GetChainConfig().ForkTime(vmtypes.ForkPrague))
+	suite.Require().Nil(vmtypes.GetChainConfig().ForkTime(vmtypes.ForkOsaka), "the pending forks aren't activated")
+
+	// and in BeginBlock if they weren't loaded
+	vmtypes.NewEVMConfigurator().ResetTestConfig()
+	suite.Require().NoError(vmtypes.SetChainConfig(cfg))
+	suite.Require().NoError(suite.vmKeeper.ActivateForks(ctx))
+	suite.Require().NotNil(vmtypes.GetChainConfig().ForkTime(vmtypes.ForkPrague))
+	suite.Require().Equal(uint64(1005), *vmtypes.GetChainConfig().ForkTime(vmtypes.ForkPrague))
+
+	// an activated fork can't be scheduled again
+	err := suite.vmKeeper.ScheduleFork(ctx, vmtypes.ForkActivation{Fork: vmtypes.ForkPrague, Height: 50})
+	suite.Require().ErrorContains(err, "already configured")
+
+	ctx = suite.forkCtx(12, 1010)
+	suite.Require().NoError(suite.vmKeeper.ActivateForks(ctx))
+	suite.Require().True(vmtypes.GetEthChainConfig().IsOsaka(ethCfg.LondonBlock, 1010))
+	suite.Require().Empty(suite.vmKeeper.GetPendingForks(ctx))
+	suite.Require().Len(suite.vmKeeper.GetActivatedForks(ctx), 2)
+}
@@ -36,10 +36,11 @@ func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *para
 		homestead, istanbul, shanghai)
 }
 
-// RefundGas transfers the leftover gas to the sender of the message, capped to half of the total gas
-// consumed in the transaction. Additionally, the function sets the total gas consumed to the value
-// returned by the EVM execution, thus ignoring the previous intrinsic gas consumed during in the
-// AnteHandler.
+// RefundGas transfers the leftover gas to the sender of the message, or to the sponsor which paid
+// the fees of the transaction, in which case the refund is added back to the sponsorship budget
+// and to the remaining fees for the sender. It is capped to half of the total gas consumed in the
+// transaction. Additionally, the function sets the total gas consumed to the value returned by the
+// EVM execution, thus ignoring the previous intrinsic gas consumed during in the AnteHandler.
 func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64, denom string) (err error) {
 	ctx, span := ctx.StartSpan(tracer, "RefundGas", trace.WithAttributes(attribute.Int64("leftover_gas", int64(leftoverGas)))) //nolint:gosec // G115
 	defer func() { evmtrace.EndSpanErr(span, err) }()
@@ -55,17 +56,27 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 		// positive amount refund
 		refundedCoins := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(remaining))}
 
-		// refund to sender from the fee collector module account, which is the escrow account in charge of collecting tx fees
+		// refund to the payer of the fees, the sender or the sponsor of the tx, from the fee collector
+		// module account, which is the escrow account in charge of collecting tx fees
+		payer := msg.From
+		sponsor, sponsored := k.getTxSponsor(ctx)
+		if sponsored {
+			payer = sponsor
+		}
+
 		var err error
 		if k.virtualFeeCollection {
-			err = k.bankWrapper.SendCoinsFromModuleToAccountVirtual(ctx, authtypes.FeeCollectorName, msg.From.Bytes(), refundedCoins)
+			err = k.bankWrapper.SendCoinsFromModuleToAccountVirtual(ctx, authtypes.FeeCollectorName, payer.Bytes(), refundedCoins)
 		} else {
-			err = k.bankWrapper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, msg.From.Bytes(), refundedCoins)
+			err = k.bankWrapper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, payer.Bytes(), refundedCoins)
 		}
 		if err != nil {
 			err = errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "fee collector account failed to refund fees: %s", err.Error())
 			return errorsmod.Wrapf(err, "failed to refund %d leftover gas (%s)", leftoverGas, refundedCoins.String())
 		}
+		if sponsored && msg.To != nil {
+			k.refundSponsorship(ctx, *msg.To, sponsor, msg.From, refundedCoins.AmountOf(denom))
+		}
 	default:
 		// no refund, consume gas and update the tx gas meter
 	}
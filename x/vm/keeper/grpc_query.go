@@ -588,30 +588,7 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (_ *t
 	signer := ethtypes.MakeSigner(types.GetEthChainConfig(), big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
 	txConfig := statedb.NewEmptyTxConfig()
 
-	// gas used at this point corresponds to GetProposerAddress & CalculateBaseFee
-	// need to reset gas meter per transaction to be consistent with tx execution
-	// and avoid stacking the gas used of every predecessor in the same gas meter
-
-	ctx = evmante.BuildEvmExecutionCtx(ctx).
-		WithGasMeter(storetypes.NewGasMeter(maxPredecessorGas))
-	for i, tx := range req.Predecessors {
-		ethTx := tx.AsTransaction()
-		msg, err := core.TransactionToMessage(ethTx, signer, cfg.BaseFee)
-		if err != nil {
-			continue
-		}
-		msg.GasLimit = min(msg.GasLimit, maxPredecessorGas)
-		txConfig.TxHash = ethTx.Hash()
-		txConfig.TxIndex = uint(i) //nolint:gosec // G115 // won't exceed uint64
-
-		ctx = buildTraceCtx(ctx, msg.GasLimit)
-		// we ignore the error here. this endpoint, ideally, is called internally from the ETH backend, which will call this query
-		// using all previous txs in the trace transaction's block. some of those _could_ be invalid transactions.
-		rsp, _ := k.ApplyMessageWithConfig(ctx, *msg, nil, true, cfg, txConfig, false, nil)
-		if rsp != nil {
-			ctx.GasMeter().ConsumeGas(rsp.GasUsed, "evm predecessor tx")
-		}
-	}
+	ctx = k.applyPredecessors(ctx, cfg, signer, &txConfig, req.Predecessors)
 
 	tx := req.Msg.AsTransaction()
 	txConfig.TxHash = tx.Hash()
@@ -905,6 +882,11 @@ func (k *Keeper) traceTxWithMsg(
 
 	// Build EVM execution context
 	ctx = buildTraceCtx(ctx, msg.GasLimit)
+
+	if traceConfig.Tracer == prestateTracerName {
+		return k.tracePrestate(ctx, cfg, txConfig, msg, tracer, json.RawMessage(traceConfig.TracerJsonConfig), commitMessage)
+	}
+
 	_, err = k.ApplyMessageWithConfig(ctx, *msg, tracer.Hooks, commitMessage, cfg, txConfig, false, nil)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -956,9 +938,114 @@ func (k Keeper) Config(ctx context.Context, _ *types.QueryConfigRequest) (*types
 	return &types.QueryConfigResponse{Config: config}, nil
 }
 
+// PendingForks implements the Query/PendingForks gRPC method
+func (k Keeper) PendingForks(c context.Context, _ *types.QueryPendingForksRequest) (_ *types.QueryPendingForksResponse, err error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx, span := ctx.StartSpan(tracer, "PendingForks", trace.WithAttributes())
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	return &types.QueryPendingForksResponse{Forks: k.GetPendingForks(ctx)}, nil
+}
+
+// CheckPermission implements the Query/CheckPermission gRPC method
+func (k Keeper) CheckPermission(c context.Context, req *types.QueryCheckPermissionRequest) (_ *types.QueryCheckPermissionResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx, span := ctx.StartSpan(tracer, "CheckPermission", trace.WithAttributes(
+		attribute.String("signer", req.Signer),
+		attribute.String("caller", req.Caller),
+		attribute.String("recipient", req.Recipient),
+	))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	if err := utils.ValidateAddress(req.Signer); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	signer := common.HexToAddress(req.Signer)
+	caller := signer
+	if req.Caller != "" {
+		if err := utils.ValidateAddress(req.Caller); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		caller = common.HexToAddress(req.Caller)
+	}
+
+	params := k.GetParams(ctx)
+	policy := k.NewPermissionPolicy(ctx, &params.AccessControl, signer)
+	if req.Recipient == "" {
+		return &types.QueryCheckPermissionResponse{Allowed: policy.CanCreate(signer, caller)}, nil
+	}
+	if err := utils.ValidateAddress(req.Recipient); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryCheckPermissionResponse{
+		Allowed: policy.CanCall(signer, caller, common.HexToAddress(req.Recipient)),
+	}, nil
+}
+
+// Sponsorship implements the Query/Sponsorship gRPC method
+func (k Keeper) Sponsorship(c context.Context, req *types.QuerySponsorshipRequest) (_ *types.QuerySponsorshipResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx, span := ctx.StartSpan(tracer, "Sponsorship", trace.WithAttributes(attribute.String("contract", req.Contract)))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	if err := utils.ValidateAddress(req.Contract); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sponsorship, found := k.GetContractSponsorship(ctx, common.HexToAddress(req.Contract))
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "contract %s is not sponsored", req.Contract)
+	}
+	return &types.QuerySponsorshipResponse{Sponsorship: sponsorship}, nil
+}
+
 // buildTraceCtx builds a context for simulating or tracing transactions by:
 // 1. assigning a new infinite gas meter with the provided gasLimit
 // 2. calling BuildEvmExecutionCtx to set up gas configs consistent with Ethereum transaction execution.
+// applyPredecessors replays the transactions preceding the requested one in
+// its block on top of the context and returns the resulting context.
+func (k Keeper) applyPredecessors(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	signer ethtypes.Signer,
+	txConfig *statedb.TxConfig,
+	predecessors []*types.MsgEthereumTx,
+) sdk.Context {
+	// gas used at this point corresponds to GetProposerAddress & CalculateBaseFee
+	// need to reset gas meter per transaction to be consistent with tx execution
+	// and avoid stacking the gas used of every predecessor in the same gas meter
+
+	ctx = evmante.BuildEvmExecutionCtx(ctx).
+		WithGasMeter(storetypes.NewGasMeter(maxPredecessorGas))
+	for i, tx := range predecessors {
+		ethTx := tx.AsTransaction()
+		msg, err := core.TransactionToMessage(ethTx, signer, cfg.BaseFee)
+		if err != nil {
+			continue
+		}
+		msg.GasLimit = min(msg.GasLimit, maxPredecessorGas)
+		txConfig.TxHash = ethTx.Hash()
+		txConfig.TxIndex = uint(i) //nolint:gosec // G115 // won't exceed uint64
+
+		ctx = buildTraceCtx(ctx, msg.GasLimit)
+		// we ignore the error here. this endpoint, ideally, is called internally from the ETH backend, which will call this query
+		// using all previous txs in the trace transaction's block. some of those _could_ be invalid transactions.
+		rsp, _ := k.ApplyMessageWithConfig(ctx, *msg, nil, true, cfg, *txConfig, false, nil)
+		if rsp != nil {
+			ctx.GasMeter().ConsumeGas(rsp.GasUsed, "evm predecessor tx")
+		}
+	}
+	return ctx
+}
+
 func buildTraceCtx(ctx sdk.Context, gasLimit uint64) sdk.Context {
 	return evmante.BuildEvmExecutionCtx(ctx).
 		WithGasMeter(types.NewInfiniteGasMeterWithLimit(gasLimit))
@@ -18,7 +18,11 @@ import (
 // Event Hooks
 // These can be utilized to customize evm transaction processing.
 
-var _ types.EvmHooks = MultiEvmHooks{}
+var (
+	_ types.EvmHooks      = MultiEvmHooks{}
+	_ types.EvmPreTxHooks = MultiEvmHooks{}
+	_ types.EvmCallHooks  = MultiEvmHooks{}
+)
 
 // MultiEvmHooks combine multiple evm hooks, all hook functions are run in array sequence
 type MultiEvmHooks []types.EvmHooks
@@ -43,3 +47,36 @@ func (mh MultiEvmHooks) PostTxProcessing(ctx sdk.Context, sender common.Address,
 	}
 	return nil
 }
+
+// PreTxProcessing delegate the call to the underlying hooks implementing types.EvmPreTxHooks
+func (mh MultiEvmHooks) PreTxProcessing(ctx sdk.Context, sender common.Address, msg core.Message) (err error) {
+	ctx, span := ctx.StartSpan(tracer, "MultiEVMHooks.PreTxProcessing", trace.WithAttributes(
+		attribute.String("sender", sender.Hex()),
+		attribute.Int("hooks_count", len(mh)),
+	))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	for i := range mh {
+		hook, ok := mh[i].(types.EvmPreTxHooks)
+		if !ok {
+			continue
+		}
+		if err := hook.PreTxProcessing(ctx, sender, msg); err != nil {
+			return errorsmod.Wrapf(err, "EVM hook %T failed", mh[i])
+		}
+	}
+	return nil
+}
+
+// PreCallProcessing delegate the call to the underlying hooks implementing types.EvmCallHooks
+func (mh MultiEvmHooks) PreCallProcessing(ctx sdk.Context, caller common.Address, recipient common.Address) error {
+	for i := range mh {
+		hook, ok := mh[i].(types.EvmCallHooks)
+		if !ok {
+			continue
+		}
+		if err := hook.PreCallProcessing(ctx, caller, recipient); err != nil {
+			return errorsmod.Wrapf(err, "EVM hook %T failed", mh[i])
+		}
+	}
+	return nil
+}
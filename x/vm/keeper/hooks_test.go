@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	vmkeeper "github.com/cosmos/evm/x/vm/keeper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// postTxHook only implements PostTxProcessing
+type postTxHook struct{}
+
+func (postTxHook) PostTxProcessing(sdk.Context, common.Address, core.Message, *ethtypes.Receipt) error {
+	return nil
+}
+
+// rejectHook rejects the txs from and the calls to an address
+type rejectHook struct {
+	postTxHook
+	addr common.Address
+}
+
+func (h rejectHook) PreTxProcessing(_ sdk.Context, sender common.Address, _ core.Message) error {
+	if sender == h.addr {
+		return errors.New("sender rejected")
+	}
+	return nil
+}
+
+func (h rejectHook) PreCallProcessing(_ sdk.Context, _ common.Address, recipient common.Address) error {
+	if recipient == h.addr {
+		return errors.New("recipient rejected")
+	}
+	return nil
+}
+
+func (suite *KeeperTestSuite) TestPreProcessingHooks() {
+	rejected := common.Address{1}
+	other := common.Address{2}
+
+	// without hooks implementing them, the pre processing hooks do nothing
+	suite.Require().NoError(suite.vmKeeper.PreTxProcessing(suite.ctx, rejected, core.Message{}))
+	suite.Require().Nil(suite.vmKeeper.GetEvmCallHook(suite.ctx, nil))
+
+	suite.vmKeeper.SetHooks(vmkeeper.NewMultiEvmHooks(postTxHook{}, rejectHook{addr: rejected}))
+
+	suite.Require().NoError(suite.vmKeeper.PreTxProcessing(suite.ctx, other, core.Message{}))
+	err := suite.vmKeeper.PreTxProcessing(suite.ctx, rejected, core.Message{})
+	suite.Require().ErrorContains(err, "sender rejected")
+
+	// the first rejected call is recorded
+	var rejectedCall error
+	callHook := suite.vmKeeper.GetEvmCallHook(suite.ctx, &rejectedCall)
+	suite.Require().NotNil(callHook)
+	suite.Require().NoError(callHook(nil, rejected, other))
+	suite.Require().NoError(rejectedCall)
+
+	err = callHook(nil, other, rejected)
+	suite.Require().ErrorContains(err, "recipient rejected")
+	suite.Require().Equal(err, rejectedCall)
+
+	suite.Require().Error(callHook(nil, rejected, rejected))
+	suite.Require().Equal(err, rejectedCall)
+}
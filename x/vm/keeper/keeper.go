@@ -159,6 +159,12 @@ func (k *Keeper) EnableVirtualFeeCollection() {
 	k.virtualFeeCollection = true
 }
 
+// VirtualFeeCollectionEnabled returns true if the fees of the evm transactions are collected with the virtual fee
+// collection, which the parallel execution of the transactions requires.
+func (k Keeper) VirtualFeeCollectionEnabled() bool {
+	return k.virtualFeeCollection
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", types.ModuleName)
@@ -256,6 +262,49 @@ func (k *Keeper) PostTxProcessing(
 	return k.hooks.PostTxProcessing(ctx, sender, msg, receipt)
 }
 
+// PreTxProcessing delegates the call to the hooks if they implement types.EvmPreTxHooks.
+// If no such hook has been registered, this function returns with a `nil` error
+func (k *Keeper) PreTxProcessing(
+	ctx sdk.Context,
+	sender common.Address,
+	msg core.Message,
+) (err error) {
+	preTxHooks, ok := k.hooks.(types.EvmPreTxHooks)
+	if !ok {
+		return nil
+	}
+	ctx, span := ctx.StartSpan(tracer, "PreTxProcessing", trace.WithAttributes(
+		attribute.String("sender", sender.Hex()),
+	))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	return preTxHooks.PreTxProcessing(ctx, sender, msg)
+}
+
+// GetEvmCallHook returns a CallHook delegating the calls to the hooks if they implement
+// types.EvmCallHooks, nil otherwise. The first call rejected by the hooks is recorded in
+// rejected, if not nil, so that the rejection can revert the whole execution.
+func (k *Keeper) GetEvmCallHook(ctx sdk.Context, rejected *error) types.CallHook {
+	callHooks, ok := k.hooks.(types.EvmCallHooks)
+	if !ok {
+		return nil
+	}
+	return func(_ *vm.EVM, caller common.Address, recipient common.Address) (err error) {
+		ctx, span := ctx.StartSpan(tracer, "EvmCallHook", trace.WithAttributes(
+			attribute.String("caller", caller.Hex()),
+			attribute.String("recipient", recipient.Hex()),
+		))
+		defer func() { evmtrace.EndSpanErr(span, err) }()
+		if err := callHooks.PreCallProcessing(ctx, caller, recipient); err != nil {
+			err = errorsmod.Wrapf(err, "call from %s to %s rejected", caller, recipient)
+			if rejected != nil && *rejected == nil {
+				*rejected = err
+			}
+			return err
+		}
+		return nil
+	}
+}
+
 // HasHooks returns true if hooks are set
 func (k *Keeper) HasHooks() bool {
 	return k.hooks != nil
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/hashicorp/go-metrics"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -164,3 +165,111 @@ func (k *Keeper) RegisterPreinstalls(goCtx context.Context, req *types.MsgRegist
 
 	return &types.MsgRegisterPreinstallsResponse{}, nil
 }
+
+// ScheduleForkActivation implements the gRPC MsgServer interface. When a
+// ScheduleForkActivation proposal passes, it schedules the activation of the
+// fork, which is applied to the chain config at the beginning of its
+// activation block. The scheduling can only be performed if the requested
+// authority is the Cosmos SDK governance module account.
+func (k *Keeper) ScheduleForkActivation(goCtx context.Context, req *types.MsgScheduleForkActivation) (
+	_ *types.MsgScheduleForkActivationResponse, err error,
+) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx, span := ctx.StartSpan(tracer, "ScheduleForkActivation", trace.WithAttributes(
+		attribute.String("authority", req.Authority),
+		attribute.String("fork", req.Activation.Fork),
+	))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	if err := k.ScheduleFork(ctx, req.Activation); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgScheduleForkActivationResponse{}, nil
+}
+
+// UpdatePermissions implements the gRPC MsgServer interface. When an
+// UpdatePermissions proposal passes, it removes and adds the entries of the
+// on-chain permission lists, which apply from the next EVM execution. The
+// update can only be performed if the requested authority is the Cosmos SDK
+// governance module account.
+func (k *Keeper) UpdatePermissions(goCtx context.Context, req *types.MsgUpdatePermissions) (
+	_ *types.MsgUpdatePermissionsResponse, err error,
+) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx, span := ctx.StartSpan(tracer, "UpdatePermissions", trace.WithAttributes(
+		attribute.String("authority", req.Authority),
+		attribute.Int("add_count", len(req.Add)),
+		attribute.Int("remove_count", len(req.Remove)),
+	))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	k.UpdatePermissionEntries(ctx, req.Add, req.Remove)
+
+	return &types.MsgUpdatePermissionsResponse{}, nil
+}
+
+// SetSponsorship implements the gRPC MsgServer interface. It sets the sponsorship of a contract,
+// whose sponsor pays the fees of the Ethereum transactions calling the contract within its budget.
+// The sponsor must be the owner of the contract or be allowed to sponsor it by governance, and an
+// existing sponsorship can only be replaced by its sponsor.
+func (k *Keeper) SetSponsorship(goCtx context.Context, req *types.MsgSetSponsorship) (
+	_ *types.MsgSetSponsorshipResponse, err error,
+) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx, span := ctx.StartSpan(tracer, "SetSponsorship", trace.WithAttributes(
+		attribute.String("sponsor", req.Sponsor),
+		attribute.String("contract", req.Contract),
+	))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	sponsor, err := sdk.AccAddressFromBech32(req.Sponsor)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid sponsor address")
+	}
+
+	sponsorship := types.Sponsorship{
+		Sponsor:          common.BytesToAddress(sponsor).Hex(),
+		Contract:         common.HexToAddress(req.Contract).Hex(),
+		MaxGasPerTx:      req.MaxGasPerTx,
+		Budget:           req.Budget,
+		MaxFeePerGas:     req.MaxFeePerGas,
+		MaxFeesPerSender: req.MaxFeesPerSender,
+	}
+	if err := k.updateSponsorship(ctx, sponsorship); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetSponsorshipResponse{}, nil
+}
+
+// RemoveSponsorship implements the gRPC MsgServer interface. It removes the sponsorship of a
+// contract, which can only be performed by its sponsor or by the Cosmos SDK governance module
+// account.
+func (k *Keeper) RemoveSponsorship(goCtx context.Context, req *types.MsgRemoveSponsorship) (
+	_ *types.MsgRemoveSponsorshipResponse, err error,
+) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx, span := ctx.StartSpan(tracer, "RemoveSponsorship", trace.WithAttributes(
+		attribute.String("signer", req.Signer),
+		attribute.String("contract", req.Contract),
+	))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	signer, err := sdk.AccAddressFromBech32(req.Signer)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid signer address")
+	}
+
+	if err := k.removeSponsorship(ctx, signer, common.HexToAddress(req.Contract)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveSponsorshipResponse{}, nil
+}
@@ -0,0 +1,191 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetPermissionEntries returns all the entries of the permission lists, expired ones included
+func (k Keeper) GetPermissionEntries(ctx sdk.Context) []types.PermissionEntry {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixPermission)
+	defer iterator.Close()
+
+	var entries []types.PermissionEntry
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.PermissionEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// SetPermissionEntry stores an entry of a permission list, replacing the existing entry if any
+func (k Keeper) SetPermissionEntry(ctx sdk.Context, entry types.PermissionEntry) {
+	ctx.KVStore(k.storeKey).Set(entry.Key(), k.cdc.MustMarshal(&entry))
+}
+
+// DeletePermissionEntry removes an entry of a permission list
+func (k Keeper) DeletePermissionEntry(ctx sdk.Context, entry types.PermissionEntry) {
+	ctx.KVStore(k.storeKey).Delete(entry.Key())
+}
+
+// UpdatePermissionEntries removes and then adds entries of the permission lists
+func (k Keeper) UpdatePermissionEntries(ctx sdk.Context, add, remove []types.PermissionEntry) {
+	for _, entry := range remove {
+		k.DeletePermissionEntry(ctx, entry)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeRemovePermission,
+			sdk.NewAttribute(types.AttributeKeyPermissionList, entry.List.String()),
+			sdk.NewAttribute(types.AttributeKeyAddress, entry.Address),
+			sdk.NewAttribute(types.AttributeKeyTarget, entry.Target),
+		))
+	}
+	for _, entry := range add {
+		k.SetPermissionEntry(ctx, entry)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeAddPermission,
+			sdk.NewAttribute(types.AttributeKeyPermissionList, entry.List.String()),
+			sdk.NewAttribute(types.AttributeKeyAddress, entry.Address),
+			sdk.NewAttribute(types.AttributeKeyTarget, entry.Target),
+			sdk.NewAttribute(types.AttributeKeyExpiresAt, strconv.FormatUint(entry.ExpiresAt, 10)),
+		))
+	}
+}
+
+// HasPermission returns true if the address has an entry of the permission list which isn't
+// expired at the block time. The target is only used for the contract call allowlist.
+func (k Keeper) HasPermission(ctx sdk.Context, list types.PermissionList, target, address common.Address) bool {
+	bz := ctx.KVStore(k.storeKey).Get(types.PermissionKey(list, target, address))
+	if bz == nil {
+		return false
+	}
+	var entry types.PermissionEntry
+	k.cdc.MustUnmarshal(bz, &entry)
+	return entry.ActiveAt(blockUnixTime(ctx))
+}
+
+// HasContractCallAllowlist returns true if the contract has at least one entry in the contract
+// call allowlist which isn't expired at the block time. A contract whose entries are all expired
+// can be called by anyone again.
+func (k Keeper) HasContractCallAllowlist(ctx sdk.Context, target common.Address) bool {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ContractPermissionPrefix(target))
+	defer iterator.Close()
+
+	now := blockUnixTime(ctx)
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.PermissionEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+		if entry.ActiveAt(now) {
+			return true
+		}
+	}
+	return false
+}
+
+// DynamicPermissionPolicy is the PermissionPolicy of the EVM executions. It complements the
+// AccessControl of the params with the on-chain permission lists:
+//   - the addresses of the deny lists can't perform the operation
+//   - the addresses of the allow lists can perform the operation if the AccessType of the params
+//     is permissioned
+//   - the contracts with unexpired entries in the contract call allowlist can only be called by
+//     them
+//
+// Like for the params, an address applies when it is either the signer or the caller.
+type DynamicPermissionPolicy struct {
+	k             *Keeper
+	ctx           sdk.Context
+	accessControl *types.AccessControl
+	params        types.PermissionPolicy
+}
+
+var _ types.PermissionPolicy = DynamicPermissionPolicy{}
+
+// NewPermissionPolicy returns the DynamicPermissionPolicy of the params access control and of the
+// permission lists at the state of the context.
+func (k *Keeper) NewPermissionPolicy(ctx sdk.Context, accessControl *types.AccessControl, signer common.Address) types.PermissionPolicy {
+	return DynamicPermissionPolicy{
+		k:             k,
+		ctx:           ctx,
+		accessControl: accessControl,
+		params:        types.NewRestrictedPermissionPolicy(accessControl, signer),
+	}
+}
+
+// GetCallHook returns a CallHook that checks if the caller is allowed to perform a call.
+func (p DynamicPermissionPolicy) GetCallHook(signer common.Address) types.CallHook {
+	return func(_ *vm.EVM, caller, recipient common.Address) error {
+		if p.CanCall(signer, caller, recipient) {
+			return nil
+		}
+		return fmt.Errorf("caller address %s does not have permission to call %s", caller, recipient)
+	}
+}
+
+// GetCreateHook returns a CreateHook that checks if the caller is allowed to deploy contracts.
+func (p DynamicPermissionPolicy) GetCreateHook(signer common.Address) types.CreateHook {
+	return func(_ *vm.EVM, caller common.Address) error {
+		if p.CanCreate(signer, caller) {
+			return nil
+		}
+		return fmt.Errorf("caller address %s does not have permission to deploy contracts", caller)
+	}
+}
+
+// CanCreate implements the PermissionPolicy interface.
+func (p DynamicPermissionPolicy) CanCreate(signer, caller common.Address) bool {
+	return p.allowed(
+		p.accessControl.Create.AccessType,
+		p.params.CanCreate(signer, caller),
+		types.PermissionListCreateAllow,
+		types.PermissionListCreateDeny,
+		signer, caller,
+	)
+}
+
+// CanCall implements the PermissionPolicy interface.
+func (p DynamicPermissionPolicy) CanCall(signer, caller, recipient common.Address) bool {
+	if !p.allowed(
+		p.accessControl.Call.AccessType,
+		p.params.CanCall(signer, caller, recipient),
+		types.PermissionListCallAllow,
+		types.PermissionListCallDeny,
+		signer, caller,
+	) {
+		return false
+	}
+	if !p.k.HasContractCallAllowlist(p.ctx, recipient) {
+		return true
+	}
+	return p.has(types.PermissionListContractCallAllow, recipient, signer, caller)
+}
+
+// allowed combines the permission of the params with the allow and deny lists
+func (p DynamicPermissionPolicy) allowed(
+	accessType types.AccessType,
+	paramsAllowed bool,
+	allowList, denyList types.PermissionList,
+	signer, caller common.Address,
+) bool {
+	if p.has(denyList, common.Address{}, signer, caller) {
+		return false
+	}
+	if paramsAllowed {
+		return true
+	}
+	return accessType == types.AccessTypePermissioned && p.has(allowList, common.Address{}, signer, caller)
+}
+
+// has returns true if either the signer or the caller has an active entry in the list
+func (p DynamicPermissionPolicy) has(list types.PermissionList, target, signer, caller common.Address) bool {
+	return p.k.HasPermission(p.ctx, list, target, signer) ||
+		(caller != signer && p.k.HasPermission(p.ctx, list, target, caller))
+}
@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	vmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func (suite *KeeperTestSuite) TestDynamicPermissionPolicy() {
+	signer := common.Address{1}
+	caller := common.Address{2}
+	contract := common.Address{3}
+	other := common.Address{4}
+	ctx := suite.ctx.WithBlockTime(time.Unix(1000, 0))
+
+	params := vmtypes.DefaultParams()
+	policy := suite.vmKeeper.NewPermissionPolicy(ctx, &params.AccessControl, signer)
+	suite.Require().True(policy.CanCall(signer, caller, contract))
+	suite.Require().True(policy.CanCreate(signer, caller))
+
+	// the deny lists block the signer and the caller
+	suite.vmKeeper.UpdatePermissionEntries(ctx, []vmtypes.PermissionEntry{
+		{List: vmtypes.PermissionListCallDeny, Address: caller.Hex()},
+		{List: vmtypes.PermissionListCreateDeny, Address: signer.Hex(), ExpiresAt: 1000},
+	}, nil)
+	suite.Require().False(policy.CanCall(signer, caller, contract))
+	suite.Require().True(policy.CanCall(signer, signer, contract))
+	suite.Require().False(policy.CanCall(caller, signer, contract))
+	// the expired entries are ignored
+	suite.Require().True(policy.CanCreate(signer, caller))
+	suite.Require().False(suite.vmKeeper.NewPermissionPolicy(ctx.WithBlockTime(time.Unix(999, 0)), &params.AccessControl, signer).
+		CanCreate(signer, caller))
+
+	// a contract with a call allowlist can only be called by its entries
+	suite.vmKeeper.UpdatePermissionEntries(ctx, []vmtypes.PermissionEntry{
+		{List: vmtypes.PermissionListContractCallAllow, Address: signer.Hex(), Target: contract.Hex()},
+		{List: vmtypes.PermissionListContractCallAllow, Address: other.Hex(), Target: contract.Hex(), ExpiresAt: 1000},
+	}, []vmtypes.PermissionEntry{
+		{List: vmtypes.PermissionListCallDeny, Address: caller.Hex()},
+	})
+	suite.Require().True(policy.CanCall(signer, caller, contract))
+	suite.Require().False(policy.CanCall(caller, caller, contract))
+	suite.Require().False(policy.CanCall(other, other, contract))
+	suite.Require().True(policy.CanCall(caller, caller, other))
+
+	err := policy.GetCallHook(caller)(nil, caller, contract)
+	suite.Require().ErrorContains(err, "does not have permission to call")
+
+	// a contract whose allowlist entries are all expired can be called by anyone
+	expired := common.Address{5}
+	suite.vmKeeper.UpdatePermissionEntries(ctx, []vmtypes.PermissionEntry{
+		{List: vmtypes.PermissionListContractCallAllow, Address: signer.Hex(), Target: expired.Hex(), ExpiresAt: 1000},
+		{List: vmtypes.PermissionListContractCallAllow, Address: other.Hex(), Target: expired.Hex(), ExpiresAt: 500},
+	}, nil)
+	suite.Require().False(suite.vmKeeper.HasContractCallAllowlist(ctx, expired))
+	suite.Require().True(policy.CanCall(caller, caller, expired))
+	suite.Require().True(suite.vmKeeper.HasContractCallAllowlist(ctx.WithBlockTime(time.Unix(999, 0)), expired))
+	suite.Require().False(suite.vmKeeper.NewPermissionPolicy(ctx.WithBlockTime(time.Unix(999, 0)), &params.AccessControl, caller).
+		CanCall(caller, caller, expired))
+
+	// the allow lists only apply when the params are permissioned
+	params.AccessControl.Create.AccessType = vmtypes.AccessTypePermissioned
+	policy = suite.vmKeeper.NewPermissionPolicy(ctx, &params.AccessControl, caller)
+	suite.Require().False(policy.CanCreate(caller, caller))
+	suite.vmKeeper.UpdatePermissionEntries(ctx, []vmtypes.PermissionEntry{
+		{List: vmtypes.PermissionListCreateAllow, Address: caller.Hex()},
+		{List: vmtypes.PermissionListCallAllow, Address: other.Hex()},
+	}, nil)
+	suite.Require().True(policy.CanCreate(caller, caller))
+	suite.Require().True(policy.CanCreate(other, caller))
+	params.AccessControl.Create.AccessType = vmtypes.AccessTypeRestricted
+	policy = suite.vmKeeper.NewPermissionPolicy(ctx, &params.AccessControl, caller)
+	suite.Require().False(policy.CanCreate(caller, caller))
+
+	suite.Require().Len(suite.vmKeeper.GetPermissionEntries(ctx), 7)
+}
+
+func (suite *KeeperTestSuite) TestUpdatePermissions() {
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(suite.vmKeeper.SetParams(ctx, vmtypes.DefaultParams()))
+	denied := common.Address{1}
+	entry := vmtypes.PermissionEntry{List: vmtypes.PermissionListCallDeny, Address: denied.Hex()}
+
+	_, err := suite.vmKeeper.UpdatePermissions(ctx, &vmtypes.MsgUpdatePermissions{
+		Authority: denied.Hex(),
+		Add:       []vmtypes.PermissionEntry{entry},
+	})
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = suite.vmKeeper.UpdatePermissions(ctx, &vmtypes.MsgUpdatePermissions{
+		Authority: sdk.AccAddress("foobar").String(),
+		Add:       []vmtypes.PermissionEntry{entry},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]vmtypes.PermissionEntry{entry}, suite.vmKeeper.GetPermissionEntries(ctx))
+	suite.Require().Equal(vmtypes.EventTypeAddPermission, ctx.EventManager().Events()[0].Type)
+
+	res, err := suite.vmKeeper.CheckPermission(ctx, &vmtypes.QueryCheckPermissionRequest{
+		Signer:    denied.Hex(),
+		Recipient: common.Address{2}.Hex(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().False(res.Allowed)
+
+	res, err = suite.vmKeeper.CheckPermission(ctx, &vmtypes.QueryCheckPermissionRequest{Signer: denied.Hex()})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Allowed)
+
+	_, err = suite.vmKeeper.CheckPermission(ctx, &vmtypes.QueryCheckPermissionRequest{Signer: "invalid"})
+	suite.Require().Error(err)
+}
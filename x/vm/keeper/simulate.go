@@ -0,0 +1,630 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/holiman/uint256"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	antetypes "github.com/cosmos/evm/ante/types"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtrace "github.com/cosmos/evm/trace"
+	"github.com/cosmos/evm/x/vm/statedb"
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// simulateTimestampIncrement is the default time difference (in seconds)
+// between two consecutive simulated blocks.
+const simulateTimestampIncrement = 1
+
+var (
+	// transferAddress is the ERC-7528 pseudo-address used as the emitter of the
+	// synthetic logs that track native token transfers.
+	transferAddress = common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE")
+	// transferTopic is the ERC-20 Transfer(address,address,uint256) event signature.
+	transferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+)
+
+// SimulateV1 implements the `eth_simulateV1` rpc api. It executes the calls of
+// every requested block on top of the state of the block in the context,
+// applying the state changes of every call so that subsequent calls and blocks
+// observe them. No state is persisted.
+func (k Keeper) SimulateV1(c context.Context, req *types.SimulateV1Request) (_ *types.SimulateV1Response, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx, span := ctx.StartSpan(tracer, "SimulateV1", trace.WithAttributes(attribute.String("proposer", req.ProposerAddress.String())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	var opts rpctypes.SimOpts
+	if err := json.Unmarshal(req.Opts, &opts); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// all the state changes are discarded after the simulation
+	ctx, _ = ctx.CacheContext()
+	ctx = k.SetConsensusParamsInCtx(ctx)
+
+	sim := &simulator{
+		k:        &k,
+		opts:     opts,
+		budget:   req.GasCap,
+		proposer: req.ProposerAddress,
+	}
+
+	blocks, err := sim.execute(ctx)
+	if err != nil {
+		var simErr *rpctypes.SimError
+		if errors.As(err, &simErr) {
+			return &types.SimulateV1Response{Error: simErr.Message, ErrorCode: int64(simErr.Code)}, nil
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	bz, err := json.Marshal(blocks)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.SimulateV1Response{Data: bz}, nil
+}
+
+// simulator executes the blocks of an eth_simulateV1 request.
+type simulator struct {
+	k        *Keeper
+	opts     rpctypes.SimOpts
+	budget   uint64 // remaining gas shared by all the calls, 0 means unlimited
+	proposer sdk.ConsAddress
+}
+
+// simBlock is a sanitized block of the request, with its number and timestamp
+// resolved.
+type simBlock struct {
+	number int64
+	time   uint64
+	block  rpctypes.SimBlock
+}
+
+func simErrorf(code int, format string, args ...interface{}) error {
+	return &rpctypes.SimError{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// execute runs all the blocks of the request and returns their RPC
+// representation.
+func (s *simulator) execute(ctx sdk.Context) ([]rpctypes.SimBlockResult, error) {
+	blocks, err := s.sanitize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	parentHash := s.k.GetHashFn(ctx)(uint64(ctx.BlockHeight())) //nolint:gosec // G115 -- block height is positive
+	results := make([]rpctypes.SimBlockResult, 0, len(blocks))
+	for _, block := range blocks {
+		result, hash, err := s.processBlock(ctx, block, parentHash)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+		parentHash = hash
+	}
+	return results, nil
+}
+
+// sanitize resolves the number and timestamp of every requested block and
+// fills the number gaps between them with empty blocks.
+func (s *simulator) sanitize(ctx sdk.Context) ([]simBlock, error) {
+	if len(s.opts.BlockStateCalls) == 0 {
+		return nil, simErrorf(rpctypes.SimErrCodeInvalidParams, "empty input")
+	}
+
+	var (
+		prevNumber = ctx.BlockHeight()
+		prevTime   = uint64(ctx.BlockTime().Unix()) //nolint:gosec // G115 -- block time is positive
+		blocks     = make([]simBlock, 0, len(s.opts.BlockStateCalls))
+	)
+	for _, block := range s.opts.BlockStateCalls {
+		number := prevNumber + 1
+		if overrides := block.BlockOverrides; overrides != nil {
+			if overrides.PrevRandao != nil {
+				return nil, simErrorf(rpctypes.SimErrCodeInvalidParams, "prevRandao override is not supported")
+			}
+			if overrides.BlobBaseFee != nil {
+				return nil, simErrorf(rpctypes.SimErrCodeInvalidParams, "blobBaseFee override is not supported")
+			}
+			if overrides.Number != nil {
+				n := overrides.Number.ToInt()
+				if !n.IsInt64() {
+					return nil, simErrorf(rpctypes.SimErrCodeBlockNumberInvalid, "block number %s is out of range", n)
+				}
+				number = n.Int64()
+				if number <= prevNumber {
+					return nil, simErrorf(rpctypes.SimErrCodeBlockNumberInvalid, "block numbers must be in order: %d <= %d", number, prevNumber)
+				}
+			}
+		}
+		if number-ctx.BlockHeight() > rpctypes.MaxSimulateBlocks {
+			return nil, simErrorf(rpctypes.SimErrCodeClientLimitExceeded, "too many blocks")
+		}
+
+		// fill the gap with empty blocks
+		for n := prevNumber + 1; n < number; n++ {
+			prevTime += simulateTimestampIncrement
+			blocks = append(blocks, simBlock{number: n, time: prevTime})
+		}
+
+		blockTime := prevTime + simulateTimestampIncrement
+		if block.BlockOverrides != nil && block.BlockOverrides.Time != nil {
+			blockTime = uint64(*block.BlockOverrides.Time)
+			if blockTime <= prevTime {
+				return nil, simErrorf(rpctypes.SimErrCodeBlockTimestamp, "block timestamps must be in order: %d <= %d", blockTime, prevTime)
+			}
+		}
+		if blockTime > math.MaxInt64 {
+			return nil, simErrorf(rpctypes.SimErrCodeBlockTimestamp, "block timestamp %d is out of range", blockTime)
+		}
+
+		blocks = append(blocks, simBlock{number: number, time: blockTime, block: block})
+		prevNumber, prevTime = number, blockTime
+	}
+	return blocks, nil
+}
+
+// processBlock applies the state overrides and executes the calls of a single
+// block. It returns the RPC representation of the block and its hash.
+func (s *simulator) processBlock(ctx sdk.Context, block simBlock, parentHash common.Hash) (rpctypes.SimBlockResult, common.Hash, error) {
+	ctx = ctx.
+		WithBlockHeight(block.number).
+		WithBlockTime(time.Unix(int64(block.time), 0).UTC()). //nolint:gosec // G115 -- timestamp is validated on sanitize
+		WithHeaderHash(nil)
+
+	overrides := block.block.BlockOverrides
+	if overrides != nil && overrides.GasLimit != nil {
+		cp := ctx.ConsensusParams()
+		if cp.Block != nil {
+			blockParams := *cp.Block
+			blockParams.MaxGas = int64(min(uint64(*overrides.GasLimit), math.MaxInt64)) //nolint:gosec // G115 -- capped above
+			cp.Block = &blockParams
+			ctx = ctx.WithConsensusParams(cp)
+		}
+	}
+	gasLimit := antetypes.BlockGasLimit(ctx)
+
+	cfg, err := s.k.EVMConfig(ctx, GetProposerAddress(ctx, s.proposer))
+	if err != nil {
+		return nil, common.Hash{}, err
+	}
+	// calls are free unless the fee checks are enforced
+	if !s.opts.Validation {
+		cfg.BaseFee = new(big.Int)
+	}
+	if overrides != nil {
+		if overrides.BaseFeePerGas != nil {
+			cfg.BaseFee = overrides.BaseFeePerGas.ToInt()
+		}
+		if overrides.FeeRecipient != nil {
+			cfg.CoinBase = *overrides.FeeRecipient
+		}
+	}
+
+	if block.block.StateOverrides != nil {
+		if err := s.applyStateOverrides(ctx, *block.block.StateOverrides); err != nil {
+			return nil, common.Hash{}, err
+		}
+	}
+
+	var (
+		ethCfg   = types.GetEthChainConfig()
+		calls    = make([]rpctypes.SimCallResult, 0, len(block.block.Calls))
+		txs      = make([]*ethtypes.Transaction, 0, len(block.block.Calls))
+		senders  = make([]common.Address, 0, len(block.block.Calls))
+		receipts = make([]*ethtypes.Receipt, 0, len(block.block.Calls))
+		gasUsed  uint64
+	)
+	for i, args := range block.block.Calls {
+		if gasLimit < gasUsed {
+			return nil, common.Hash{}, simErrorf(rpctypes.SimErrCodeBlockGasLimit, "block gas limit reached")
+		}
+		tx, result, err := s.applyCall(ctx, cfg, args, uint(i), gasLimit-gasUsed) //nolint:gosec // G115 -- index is positive
+		if err != nil {
+			return nil, common.Hash{}, err
+		}
+		gasUsed += uint64(result.GasUsed)
+
+		receiptStatus := ethtypes.ReceiptStatusSuccessful
+		if result.Status == 0 {
+			receiptStatus = ethtypes.ReceiptStatusFailed
+		}
+		receipt := &ethtypes.Receipt{
+			Type:              tx.Type(),
+			Status:            receiptStatus,
+			CumulativeGasUsed: gasUsed,
+			Logs:              result.Logs,
+			TxHash:            tx.Hash(),
+			GasUsed:           uint64(result.GasUsed),
+		}
+		receipt.Bloom = ethtypes.CreateBloom(receipt)
+
+		calls = append(calls, *result)
+		txs = append(txs, tx)
+		senders = append(senders, args.GetFrom())
+		receipts = append(receipts, receipt)
+	}
+
+	header := &ethtypes.Header{
+		ParentHash: parentHash,
+		Coinbase:   cfg.CoinBase,
+		Difficulty: big.NewInt(0),
+		Number:     big.NewInt(block.number),
+		GasLimit:   gasLimit,
+		GasUsed:    gasUsed,
+		Time:       block.time,
+	}
+	if ethCfg.IsLondon(header.Number) {
+		header.BaseFee = cfg.BaseFee
+	}
+	if ethCfg.IsCancun(header.Number, header.Time) {
+		header.ExcessBlobGas = new(uint64)
+		header.BlobGasUsed = new(uint64)
+		header.ParentBeaconRoot = new(common.Hash)
+	}
+	if ethCfg.IsPrague(header.Number, header.Time) {
+		header.RequestsHash = &ethtypes.EmptyRequestsHash
+	}
+
+	ethBlock := ethtypes.NewBlock(header, &ethtypes.Body{Transactions: txs}, receipts, trie.NewStackTrie(nil))
+	hash := ethBlock.Hash()
+
+	// set the block context of the logs now that the block hash is known
+	var logIndex uint
+	for i := range calls {
+		for _, log := range calls[i].Logs {
+			log.BlockNumber = uint64(block.number) //nolint:gosec // G115 -- block number is positive
+			log.BlockHash = hash
+			log.BlockTimestamp = block.time
+			log.TxHash = txs[i].Hash()
+			log.TxIndex = uint(i) //nolint:gosec // G115 -- index is positive
+			log.Index = logIndex
+			logIndex++
+		}
+	}
+
+	result := rpctypes.SimBlockResult(rpctypes.RPCMarshalHeader(ethBlock.Header(), hash.Bytes()))
+	result["size"] = hexutil.Uint64(ethBlock.Size())
+	result["uncles"] = []common.Hash{}
+	result["calls"] = calls
+	if s.opts.ReturnFullTransactions {
+		rpcTxs := make([]*rpctypes.RPCTransaction, len(txs))
+		for i, tx := range txs {
+			rpcTxs[i] = rpctypes.NewRPCTransaction(tx, hash, uint64(block.number), block.time, uint64(i), cfg.BaseFee, ethCfg) //nolint:gosec // G115 -- block number and index are positive
+			// simulated transactions are not signed
+			rpcTxs[i].From = senders[i]
+		}
+		result["transactions"] = rpcTxs
+	} else {
+		hashes := make([]common.Hash, len(txs))
+		for i, tx := range txs {
+			hashes[i] = tx.Hash()
+		}
+		result["transactions"] = hashes
+	}
+
+	return result, hash, nil
+}
+
+// applyCall executes a single call on top of the given context, persisting its
+// state changes on it. It returns the unsigned transaction built from the call
+// arguments and the call result.
+func (s *simulator) applyCall(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	args types.TransactionArgs,
+	txIndex uint,
+	remainingGas uint64,
+) (*ethtypes.Transaction, *rpctypes.SimCallResult, error) {
+	if args.BlobHashes != nil {
+		return nil, nil, simErrorf(rpctypes.SimErrCodeInvalidParams, "blob transactions are not supported")
+	}
+	if args.AuthorizationList != nil && args.To == nil {
+		return nil, nil, simErrorf(rpctypes.SimErrCodeInvalidParams, "set code transactions cannot create contracts")
+	}
+
+	from := args.GetFrom()
+	args.From = &from
+
+	nonce := s.k.GetNonce(ctx, from)
+	if args.Nonce == nil {
+		args.Nonce = (*hexutil.Uint64)(&nonce)
+	} else if s.opts.Validation {
+		if have := uint64(*args.Nonce); have < nonce {
+			return nil, nil, simErrorf(rpctypes.SimErrCodeNonceTooLow, "%s: address %s, tx: %d state: %d", core.ErrNonceTooLow, from, have, nonce)
+		} else if have > nonce {
+			return nil, nil, simErrorf(rpctypes.SimErrCodeNonceTooHigh, "%s: address %s, tx: %d state: %d", core.ErrNonceTooHigh, from, have, nonce)
+		}
+	}
+
+	if args.Gas == nil {
+		gas := min(remainingGas, uint64(math.MaxUint64/2))
+		if s.budget > 0 {
+			gas = min(gas, s.budget)
+		}
+		args.Gas = (*hexutil.Uint64)(&gas)
+	} else if uint64(*args.Gas) > remainingGas {
+		return nil, nil, simErrorf(rpctypes.SimErrCodeBlockGasLimit, "block gas limit reached: %d >= %d", uint64(*args.Gas), remainingGas)
+	}
+
+	if err := args.CallDefaults(s.budget, cfg.BaseFee, types.GetEthChainConfig().ChainID); err != nil {
+		return nil, nil, simErrorf(rpctypes.SimErrCodeInvalidParams, "%s", err.Error())
+	}
+
+	msg := args.ToMessage(cfg.BaseFee, !s.opts.Validation, !s.opts.Validation)
+
+	if s.opts.Validation {
+		if msg.GasFeeCap.Cmp(cfg.BaseFee) < 0 {
+			return nil, nil, simErrorf(rpctypes.SimErrCodeBaseFeeTooLow, "%s: address %s, maxFeePerGas: %s, baseFee: %s", core.ErrFeeCapTooLow, from, msg.GasFeeCap, cfg.BaseFee)
+		}
+		cost := new(big.Int).Mul(new(big.Int).SetUint64(msg.GasLimit), msg.GasFeeCap)
+		cost.Add(cost, msg.Value)
+		balance := s.k.GetBalance(ctx, from).ToBig()
+		if balance.Cmp(cost) < 0 {
+			return nil, nil, simErrorf(rpctypes.SimErrCodeInsufficientFunds, "%s: address %s have %s want %s", core.ErrInsufficientFunds, from, balance, cost)
+		}
+	}
+
+	tx := args.ToTransaction(ethtypes.DynamicFeeTxType)
+	txConfig := statedb.NewTxConfig(tx.Hash(), txIndex)
+
+	var (
+		hooks     *tracing.Hooks
+		transfers *transferTracer
+	)
+	if s.opts.TraceTransfers {
+		transfers = &transferTracer{}
+		hooks = transfers.hooks()
+	}
+
+	res, err := s.k.ApplyMessageWithConfig(ctx, *msg, hooks, true, cfg, txConfig, true, nil)
+	if err != nil {
+		if errors.Is(err, core.ErrIntrinsicGas) {
+			return nil, nil, simErrorf(rpctypes.SimErrCodeIntrinsicGas, "%s", err.Error())
+		}
+		return nil, nil, err
+	}
+
+	// the nonce of contract creations is already increased by the EVM
+	if msg.To != nil {
+		account := s.k.GetAccountOrEmpty(ctx, from)
+		account.Nonce++
+		if err := s.k.SetAccount(ctx, from, account); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if s.opts.Validation {
+		fee := new(uint256.Int).Mul(uint256.NewInt(res.GasUsed), uint256.MustFromBig(msg.GasPrice))
+		balance := s.k.GetBalance(ctx, from)
+		if balance.Cmp(fee) < 0 {
+			fee = balance
+		}
+		if err := s.k.SetBalance(ctx, from, new(uint256.Int).Sub(balance, fee)); err != nil {
+			return nil, nil, err
+		}
+		// the fee is credited to the fee recipient of the block, like the fee collector of the
+		// real execution
+		coinbaseBalance := s.k.GetBalance(ctx, cfg.CoinBase)
+		if err := s.k.SetBalance(ctx, cfg.CoinBase, new(uint256.Int).Add(coinbaseBalance, fee)); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if s.budget > 0 {
+		s.budget -= min(res.GasUsed, s.budget)
+	}
+
+	logs := types.LogsToEthereum(res.Logs)
+	if transfers != nil {
+		logs = transfers.merge(logs)
+	}
+	if logs == nil {
+		logs = []*ethtypes.Log{}
+	}
+
+	result := &rpctypes.SimCallResult{
+		ReturnValue: res.Ret,
+		Logs:        logs,
+		GasUsed:     hexutil.Uint64(res.GasUsed),
+		Status:      hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+	}
+	if res.Failed() {
+		result.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+		// logs of failed calls are discarded
+		result.Logs = []*ethtypes.Log{}
+		if res.VmError == vm.ErrExecutionReverted.Error() {
+			revertErr := types.NewExecErrorWithReason(res.Ret)
+			result.Error = &rpctypes.SimCallError{
+				Code:    revertErr.ErrorCode(),
+				Message: revertErr.Error(),
+				Data:    hexutil.Encode(res.Ret),
+			}
+		} else {
+			result.Error = &rpctypes.SimCallError{
+				Code:    rpctypes.SimErrCodeVMError,
+				Message: res.VmError,
+			}
+		}
+	}
+
+	return tx, result, nil
+}
+
+// applyStateOverrides persists the given state overrides in the context so
+// that they are visible to all the subsequent calls and blocks.
+func (s *simulator) applyStateOverrides(ctx sdk.Context, overrides rpctypes.StateOverride) error {
+	for addr, account := range overrides {
+		if account.MovePrecompileTo != nil {
+			return simErrorf(rpctypes.SimErrCodeInvalidParams, "movePrecompileToAddress is not supported")
+		}
+		if account.State != nil && account.StateDiff != nil {
+			return simErrorf(rpctypes.SimErrCodeInvalidParams, "account %s has both 'state' and 'stateDiff'", addr.Hex())
+		}
+
+		acct := s.k.GetAccountOrEmpty(ctx, addr)
+		if account.Nonce != nil {
+			acct.Nonce = uint64(*account.Nonce)
+		}
+		if account.Code != nil {
+			code := *account.Code
+			acct.CodeHash = types.EmptyCodeHash
+			if len(code) > 0 {
+				acct.CodeHash = crypto.Keccak256(code)
+				s.k.SetCode(ctx, acct.CodeHash, code)
+			}
+		}
+		if account.Balance != nil && *account.Balance != nil {
+			balance, overflow := uint256.FromBig((*big.Int)(*account.Balance))
+			if overflow {
+				return simErrorf(rpctypes.SimErrCodeInvalidParams, "balance of account %s overflows", addr.Hex())
+			}
+			acct.Balance = balance
+		}
+		if err := s.k.SetAccount(ctx, addr, acct); err != nil {
+			return err
+		}
+
+		if account.State != nil {
+			// replace the whole storage of the account
+			var keys []common.Hash
+			s.k.ForEachStorage(ctx, addr, func(key, _ common.Hash) bool {
+				keys = append(keys, key)
+				return true
+			})
+			for _, key := range keys {
+				s.k.DeleteState(ctx, addr, key)
+			}
+			for key, value := range *account.State {
+				s.setState(ctx, addr, key, value)
+			}
+		}
+		if account.StateDiff != nil {
+			for key, value := range *account.StateDiff {
+				s.setState(ctx, addr, key, value)
+			}
+		}
+	}
+	return nil
+}
+
+func (s *simulator) setState(ctx sdk.Context, addr common.Address, key, value common.Hash) {
+	if value == (common.Hash{}) {
+		s.k.DeleteState(ctx, addr, key)
+		return
+	}
+	s.k.SetState(ctx, addr, key, value.Bytes())
+}
+
+// transferTracer records the native token transfers of a call as ERC-7528
+// Transfer logs.
+type transferTracer struct {
+	stateDB *statedb.StateDB
+	// frames holds the pending transfers of every active call frame, they are
+	// discarded if the frame reverts
+	frames [][]transferLog
+	logs   []transferLog
+}
+
+// transferLog is a synthetic transfer log together with the number of EVM
+// logs emitted before it.
+type transferLog struct {
+	position int
+	log      *ethtypes.Log
+}
+
+func (t *transferTracer) hooks() *tracing.Hooks {
+	return &tracing.Hooks{
+		OnTxStart: t.onTxStart,
+		OnEnter:   t.onEnter,
+		OnExit:    t.onExit,
+	}
+}
+
+func (t *transferTracer) onTxStart(vmCtx *tracing.VMContext, _ *ethtypes.Transaction, _ common.Address) {
+	t.stateDB, _ = vmCtx.StateDB.(*statedb.StateDB)
+}
+
+func (t *transferTracer) onEnter(_ int, typ byte, from, to common.Address, _ []byte, _ uint64, value *big.Int) {
+	t.frames = append(t.frames, nil)
+	if vm.OpCode(typ) == vm.DELEGATECALL || value == nil || value.Sign() <= 0 {
+		return
+	}
+
+	var position int
+	if t.stateDB != nil {
+		position = len(t.stateDB.Logs())
+	}
+	top := len(t.frames) - 1
+	t.frames[top] = append(t.frames[top], transferLog{
+		position: position,
+		log: &ethtypes.Log{
+			Address: transferAddress,
+			Topics:  []common.Hash{transferTopic, common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())},
+			Data:    common.BigToHash(value).Bytes(),
+		},
+	})
+}
+
+func (t *transferTracer) onExit(_ int, _ []byte, _ uint64, _ error, reverted bool) {
+	if len(t.frames) == 0 {
+		return
+	}
+	top := t.frames[len(t.frames)-1]
+	t.frames = t.frames[:len(t.frames)-1]
+	if reverted {
+		return
+	}
+	if len(t.frames) > 0 {
+		parent := len(t.frames) - 1
+		t.frames[parent] = append(t.frames[parent], top...)
+		return
+	}
+	t.logs = append(t.logs, top...)
+}
+
+// merge interleaves the recorded transfer logs with the given EVM logs, in
+// the order they were emitted.
+func (t *transferTracer) merge(logs []*ethtypes.Log) []*ethtypes.Log {
+	if len(t.logs) == 0 {
+		return logs
+	}
+	merged := make([]*ethtypes.Log, 0, len(logs)+len(t.logs))
+	j := 0
+	for i := 0; i <= len(logs); i++ {
+		for j < len(t.logs) && t.logs[j].position <= i {
+			merged = append(merged, t.logs[j].log)
+			j++
+		}
+		if i < len(logs) {
+			merged = append(merged, logs[i])
+		}
+	}
+	return merged
+}
@@ -0,0 +1,289 @@
+package keeper
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	evmtrace "github.com/cosmos/evm/trace"
+	"github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// ownableABI is the ABI of the owner function of the ERC-173 contracts
+var ownableABI = func() abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(`[{"inputs":[],"name":"owner","outputs":[{"name":"","type":"address"}],"stateMutability":"view","type":"function"}]`))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}()
+
+// GetSponsorships returns the sponsorships of all the contracts
+func (k Keeper) GetSponsorships(ctx sdk.Context) []types.Sponsorship {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixSponsorship)
+	defer iterator.Close()
+
+	var sponsorships []types.Sponsorship
+	for ; iterator.Valid(); iterator.Next() {
+		var sponsorship types.Sponsorship
+		k.cdc.MustUnmarshal(iterator.Value(), &sponsorship)
+		sponsorships = append(sponsorships, sponsorship)
+	}
+	return sponsorships
+}
+
+// GetContractSponsorship returns the sponsorship of a contract, if any
+func (k Keeper) GetContractSponsorship(ctx sdk.Context, contract common.Address) (types.Sponsorship, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.SponsorshipKey(contract))
+	if bz == nil {
+		return types.Sponsorship{}, false
+	}
+	var sponsorship types.Sponsorship
+	k.cdc.MustUnmarshal(bz, &sponsorship)
+	return sponsorship, true
+}
+
+// SetContractSponsorship stores the sponsorship of a contract, replacing the existing one if any
+func (k Keeper) SetContractSponsorship(ctx sdk.Context, sponsorship types.Sponsorship) {
+	ctx.KVStore(k.storeKey).Set(sponsorship.Key(), k.cdc.MustMarshal(&sponsorship))
+}
+
+// DeleteContractSponsorship removes the sponsorship of a contract, along with the fees paid by its
+// sponsor for each sender
+func (k Keeper) DeleteContractSponsorship(ctx sdk.Context, contract common.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.SponsorshipKey(contract))
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.SponsoredFeesPrefix(contract))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetAllSponsoredFees returns the fees paid by the sponsors of all the contracts for each sender
+func (k Keeper) GetAllSponsoredFees(ctx sdk.Context) []types.SponsoredFees {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixSponsoredFees)
+	defer iterator.Close()
+
+	var allFees []types.SponsoredFees
+	for ; iterator.Valid(); iterator.Next() {
+		var fees types.SponsoredFees
+		k.cdc.MustUnmarshal(iterator.Value(), &fees)
+		allFees = append(allFees, fees)
+	}
+	return allFees
+}
+
+// GetSponsoredFees returns the fees paid by the sponsor of a contract for a sender
+func (k Keeper) GetSponsoredFees(ctx sdk.Context, contract, sender common.Address) sdkmath.Int {
+	bz := ctx.KVStore(k.storeKey).Get(types.SponsoredFeesKey(contract, sender))
+	if bz == nil {
+		return sdkmath.ZeroInt()
+	}
+	var fees types.SponsoredFees
+	k.cdc.MustUnmarshal(bz, &fees)
+	return fees.Fees
+}
+
+// SetSponsoredFees stores the fees paid by the sponsor of a contract for a sender, removing them
+// if they are zero
+func (k Keeper) SetSponsoredFees(ctx sdk.Context, fees types.SponsoredFees) {
+	if !fees.Fees.IsPositive() {
+		ctx.KVStore(k.storeKey).Delete(fees.Key())
+		return
+	}
+	ctx.KVStore(k.storeKey).Set(fees.Key(), k.cdc.MustMarshal(&fees))
+}
+
+// GetTxSponsor returns the sponsor paying the fees of an Ethereum tx. A tx is sponsored if it
+// calls a contract with a sponsorship whose maximum gas per tx and maximum fee per gas cover the
+// gas limit and the fee cap of the tx, and if the remaining budget, the remaining fees for the
+// sender and the spendable balance of the sponsor all cover the maximum fees of the tx. Otherwise
+// the fees are paid by the sender. The fees of a sender are capped over the lifetime of the
+// sponsorship, they are only reset when it is removed.
+func (k *Keeper) GetTxSponsor(ctx sdk.Context, ethTx *ethtypes.Transaction, sender common.Address) (common.Address, bool) {
+	if ethTx.To() == nil {
+		return common.Address{}, false
+	}
+	sponsorship, found := k.GetContractSponsorship(ctx, *ethTx.To())
+	if !found || !sponsorship.CoversGas(ethTx.Gas(), ethTx.GasFeeCap()) {
+		return common.Address{}, false
+	}
+
+	maxFees := new(big.Int).Mul(new(big.Int).SetUint64(ethTx.Gas()), ethTx.GasFeeCap())
+	if sponsorship.Budget.BigInt().Cmp(maxFees) < 0 {
+		return common.Address{}, false
+	}
+	senderFees := k.GetSponsoredFees(ctx, *ethTx.To(), sender).BigInt()
+	if sponsorship.MaxFeesPerSender.BigInt().Cmp(senderFees.Add(senderFees, maxFees)) < 0 {
+		return common.Address{}, false
+	}
+	sponsor := common.HexToAddress(sponsorship.Sponsor)
+	if k.SpendableCoin(ctx, sponsor).ToBig().Cmp(maxFees) < 0 {
+		return common.Address{}, false
+	}
+	return sponsor, true
+}
+
+// DeductTxCostsFromSponsor deducts the fees of a tx calling a sponsored contract from the balance
+// of its sponsor, from the budget of the sponsorship and from the remaining fees for the sender.
+// The sponsor is recorded for the tx, so that the leftover gas is refunded to it in RefundGas.
+func (k *Keeper) DeductTxCostsFromSponsor(ctx sdk.Context, fees sdk.Coins, contract, sender common.Address) (err error) {
+	ctx, span := ctx.StartSpan(tracer, "DeductTxCostsFromSponsor", trace.WithAttributes(
+		attribute.String("contract", contract.Hex()),
+		attribute.String("sender", sender.Hex()),
+		attribute.String("fees", fees.String()),
+	))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	sponsorship, found := k.GetContractSponsorship(ctx, contract)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidSponsorship, "contract %s is not sponsored", contract)
+	}
+	amount := fees.AmountOf(types.GetEVMCoinDenom())
+	if sponsorship.Budget.LT(amount) {
+		return errorsmod.Wrapf(types.ErrInvalidSponsorship, "sponsorship budget < tx fees (%s < %s)", sponsorship.Budget, amount)
+	}
+	senderFees := k.GetSponsoredFees(ctx, contract, sender).Add(amount)
+	if sponsorship.MaxFeesPerSender.LT(senderFees) {
+		return errorsmod.Wrapf(types.ErrInvalidSponsorship, "max fees per sender < sponsored fees of %s (%s < %s)", sender, sponsorship.MaxFeesPerSender, senderFees)
+	}
+
+	sponsor := common.HexToAddress(sponsorship.Sponsor)
+	if err := k.DeductTxCostsFromUserBalance(ctx, fees, sponsor); err != nil {
+		return errorsmod.Wrapf(err, "failed to deduct tx costs from sponsor %s", sponsor)
+	}
+	sponsorship.Budget = sponsorship.Budget.Sub(amount)
+	k.SetContractSponsorship(ctx, sponsorship)
+	k.SetSponsoredFees(ctx, types.SponsoredFees{
+		Contract: sponsorship.Contract,
+		Sender:   sender.Hex(),
+		Fees:     senderFees,
+	})
+
+	// the sponsor isn't recorded during CheckTx, where the messages aren't executed, so that the
+	// simulations on top of the check state don't refund a previous tx sponsor
+	if !ctx.IsCheckTx() {
+		ctx.ObjectStore(k.objectKey).Set(types.ObjectTxSponsorKey(ctx.TxIndex()), sponsor)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSponsoredFee,
+		sdk.NewAttribute(types.AttributeKeySponsor, sponsorship.Sponsor),
+		sdk.NewAttribute(types.AttributeKeyContractAddress, sponsorship.Contract),
+		sdk.NewAttribute(sdk.AttributeKeyFee, fees.String()),
+	))
+	return nil
+}
+
+// getTxSponsor returns the sponsor paying the fees of the current tx, if any
+func (k Keeper) getTxSponsor(ctx sdk.Context) (common.Address, bool) {
+	sponsor, ok := ctx.ObjectStore(k.objectKey).Get(types.ObjectTxSponsorKey(ctx.TxIndex())).(common.Address)
+	return sponsor, ok
+}
+
+// refundSponsorship adds the refund of the leftover gas of a sponsored tx back to the budget of the
+// sponsorship of the contract and to the remaining fees for the sender, if the sponsorship still
+// exists
+func (k Keeper) refundSponsorship(ctx sdk.Context, contract, sponsor, sender common.Address, refund sdkmath.Int) {
+	sponsorship, found := k.GetContractSponsorship(ctx, contract)
+	if !found || common.HexToAddress(sponsorship.Sponsor) != sponsor {
+		return
+	}
+	sponsorship.Budget = sponsorship.Budget.Add(refund)
+	k.SetContractSponsorship(ctx, sponsorship)
+
+	senderFees := k.GetSponsoredFees(ctx, contract, sender)
+	k.SetSponsoredFees(ctx, types.SponsoredFees{
+		Contract: sponsorship.Contract,
+		Sender:   sender.Hex(),
+		Fees:     senderFees.Sub(sdkmath.MinInt(senderFees, refund)),
+	})
+}
+
+// updateSponsorship sets the sponsorship of a contract on behalf of the sponsor, which must be the
+// current sponsor of the contract if it is already sponsored. The sponsor must either be allowed to
+// sponsor the contract by governance or be the owner of the contract.
+func (k Keeper) updateSponsorship(ctx sdk.Context, sponsorship types.Sponsorship) error {
+	contract := common.HexToAddress(sponsorship.Contract)
+	sponsor := common.HexToAddress(sponsorship.Sponsor)
+	if existing, found := k.GetContractSponsorship(ctx, contract); found && common.HexToAddress(existing.Sponsor) != sponsor {
+		return errorsmod.Wrapf(types.ErrInvalidSponsorship, "contract %s is already sponsored by %s", sponsorship.Contract, existing.Sponsor)
+	}
+	if !k.HasPermission(ctx, types.PermissionListSponsor, contract, sponsor) && !k.isContractOwner(ctx, contract, sponsor) {
+		return errorsmod.Wrapf(types.ErrInvalidSponsorship, "%s is neither the owner of contract %s nor allowed to sponsor it", sponsorship.Sponsor, sponsorship.Contract)
+	}
+
+	k.SetContractSponsorship(ctx, sponsorship)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetSponsorship,
+		sdk.NewAttribute(types.AttributeKeySponsor, sponsorship.Sponsor),
+		sdk.NewAttribute(types.AttributeKeyContractAddress, sponsorship.Contract),
+		sdk.NewAttribute(types.AttributeKeyMaxGasPerTx, strconv.FormatUint(sponsorship.MaxGasPerTx, 10)),
+		sdk.NewAttribute(types.AttributeKeyBudget, sponsorship.Budget.String()),
+		sdk.NewAttribute(types.AttributeKeyMaxFeePerGas, sponsorship.MaxFeePerGas.String()),
+		sdk.NewAttribute(types.AttributeKeyMaxFeesPerSender, sponsorship.MaxFeesPerSender.String()),
+	))
+	return nil
+}
+
+// isContractOwner returns true if the address is the owner of the contract, as returned by its
+// ERC-173 owner function
+func (k Keeper) isContractOwner(ctx sdk.Context, contract, address common.Address) bool {
+	// the call runs on its own gas meter, which is consumed on the one of the tx afterwards, since
+	// a failed call consumes the whole gas limit of the meter
+	callCtx, _ := ctx.CacheContext()
+	callCtx = callCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	res, err := k.CallEVM(callCtx, ownableABI, address, contract, false, nil, "owner")
+	if res != nil {
+		ctx.GasMeter().ConsumeGas(res.GasUsed, "contract owner call")
+	}
+	if err != nil {
+		return false
+	}
+
+	out, err := ownableABI.Unpack("owner", res.Ret)
+	if err != nil || len(out) != 1 {
+		return false
+	}
+	owner, ok := out[0].(common.Address)
+	return ok && owner == address
+}
+
+// removeSponsorship removes the sponsorship of a contract on behalf of the signer, which must be
+// either the sponsor or the governance authority
+func (k Keeper) removeSponsorship(ctx sdk.Context, signer sdk.AccAddress, contract common.Address) error {
+	sponsorship, found := k.GetContractSponsorship(ctx, contract)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidSponsorship, "contract %s is not sponsored", contract)
+	}
+	if !signer.Equals(k.authority) && common.BytesToAddress(signer) != common.HexToAddress(sponsorship.Sponsor) {
+		return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid signer, expected the sponsor %s or the authority %s, got %s", sponsorship.Sponsor, k.authority, signer)
+	}
+
+	k.DeleteContractSponsorship(ctx, contract)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRemoveSponsorship,
+		sdk.NewAttribute(types.AttributeKeySponsor, sponsorship.Sponsor),
+		sdk.NewAttribute(types.AttributeKeyContractAddress, sponsorship.Contract),
+	))
+	return nil
+}
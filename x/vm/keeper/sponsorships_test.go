@@ -0,0 +1,257 @@
+package keeper_test
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/mock"
+
+	testconstants "github.com/cosmos/evm/testutil/constants"
+	vmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// setupSponsorshipCoinInfo configures the EVM coin of the chain with 18 decimals
+func (suite *KeeperTestSuite) setupSponsorshipCoinInfo() string {
+	coinInfo := testconstants.ChainsCoinInfo[testconstants.EighteenDecimalsChainID]
+	configurator := vmtypes.NewEVMConfigurator()
+	configurator.ResetTestConfig()
+	suite.Require().NoError(configurator.WithEVMCoinInfo(coinInfo).Configure())
+	suite.T().Cleanup(vmtypes.NewEVMConfigurator().ResetTestConfig)
+	return coinInfo.Denom
+}
+
+func (suite *KeeperTestSuite) TestSetAndRemoveSponsorship() {
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	sponsor := sdk.AccAddress(common.Address{1}.Bytes())
+	other := sdk.AccAddress(common.Address{2}.Bytes())
+	contract := common.Address{3}
+	newMsg := func(sponsor sdk.AccAddress, budget int64) *vmtypes.MsgSetSponsorship {
+		return &vmtypes.MsgSetSponsorship{
+			Sponsor:          sponsor.String(),
+			Contract:         contract.Hex(),
+			MaxGasPerTx:      100_000,
+			Budget:           sdkmath.NewInt(budget),
+			MaxFeePerGas:     sdkmath.NewInt(10),
+			MaxFeesPerSender: sdkmath.NewInt(500),
+		}
+	}
+
+	_, err := suite.vmKeeper.Sponsorship(ctx, &vmtypes.QuerySponsorshipRequest{Contract: contract.Hex()})
+	suite.Require().ErrorContains(err, "is not sponsored")
+
+	// the contract can't be sponsored by an account which isn't its owner, the call of the owner
+	// function failing here, without the approval of governance
+	suite.accKeeper.On("GetSequence", mock.Anything, mock.Anything).Return(uint64(0), errors.New("no account"))
+	_, err = suite.vmKeeper.SetSponsorship(ctx, newMsg(sponsor, 1000))
+	suite.Require().ErrorContains(err, "nor allowed to sponsor it")
+
+	suite.vmKeeper.SetPermissionEntry(ctx, vmtypes.PermissionEntry{
+		List:    vmtypes.PermissionListSponsor,
+		Address: common.BytesToAddress(sponsor).Hex(),
+		Target:  contract.Hex(),
+	})
+	_, err = suite.vmKeeper.SetSponsorship(ctx, newMsg(sponsor, 1000))
+	suite.Require().NoError(err)
+	suite.Require().Equal(vmtypes.EventTypeSetSponsorship, ctx.EventManager().Events()[0].Type)
+
+	expSponsorship := vmtypes.Sponsorship{
+		Sponsor:          common.BytesToAddress(sponsor).Hex(),
+		Contract:         contract.Hex(),
+		MaxGasPerTx:      100_000,
+		Budget:           sdkmath.NewInt(1000),
+		MaxFeePerGas:     sdkmath.NewInt(10),
+		MaxFeesPerSender: sdkmath.NewInt(500),
+	}
+	res, err := suite.vmKeeper.Sponsorship(ctx, &vmtypes.QuerySponsorshipRequest{Contract: contract.Hex()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expSponsorship, res.Sponsorship)
+
+	// only the sponsor can replace the sponsorship
+	suite.vmKeeper.SetPermissionEntry(ctx, vmtypes.PermissionEntry{
+		List:    vmtypes.PermissionListSponsor,
+		Address: common.BytesToAddress(other).Hex(),
+		Target:  contract.Hex(),
+	})
+	_, err = suite.vmKeeper.SetSponsorship(ctx, newMsg(other, 1))
+	suite.Require().ErrorContains(err, "is already sponsored")
+	_, err = suite.vmKeeper.SetSponsorship(ctx, newMsg(sponsor, 2000))
+	suite.Require().NoError(err)
+	expSponsorship.Budget = sdkmath.NewInt(2000)
+	suite.Require().Equal([]vmtypes.Sponsorship{expSponsorship}, suite.vmKeeper.GetSponsorships(ctx))
+
+	// the fees paid for the senders are removed with the sponsorship
+	suite.vmKeeper.SetSponsoredFees(ctx, vmtypes.SponsoredFees{Contract: contract.Hex(), Sender: common.Address{4}.Hex(), Fees: sdkmath.NewInt(10)})
+	suite.Require().Len(suite.vmKeeper.GetAllSponsoredFees(ctx), 1)
+
+	// only the sponsor or the authority can remove the sponsorship
+	_, err = suite.vmKeeper.RemoveSponsorship(ctx, &vmtypes.MsgRemoveSponsorship{Signer: other.String(), Contract: contract.Hex()})
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+	_, err = suite.vmKeeper.RemoveSponsorship(ctx, &vmtypes.MsgRemoveSponsorship{
+		Signer:   sdk.AccAddress("foobar").String(),
+		Contract: contract.Hex(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Empty(suite.vmKeeper.GetSponsorships(ctx))
+	suite.Require().Empty(suite.vmKeeper.GetAllSponsoredFees(ctx))
+
+	_, err = suite.vmKeeper.RemoveSponsorship(ctx, &vmtypes.MsgRemoveSponsorship{Signer: sponsor.String(), Contract: contract.Hex()})
+	suite.Require().ErrorIs(err, vmtypes.ErrInvalidSponsorship)
+}
+
+func (suite *KeeperTestSuite) TestGetTxSponsor() {
+	suite.setupSponsorshipCoinInfo()
+	sponsor := common.Address{1}
+	contract := common.Address{3}
+	sender := common.Address{5}
+	suite.vmKeeper.SetContractSponsorship(suite.ctx, vmtypes.Sponsorship{
+		Sponsor:          sponsor.Hex(),
+		Contract:         contract.Hex(),
+		MaxGasPerTx:      50_000,
+		Budget:           sdkmath.NewInt(100_000),
+		MaxFeePerGas:     sdkmath.NewInt(3),
+		MaxFeesPerSender: sdkmath.NewInt(90_000),
+	})
+	suite.vmKeeper.SetSponsoredFees(suite.ctx, vmtypes.SponsoredFees{Contract: contract.Hex(), Sender: sender.Hex(), Fees: sdkmath.NewInt(40_000)})
+	suite.bankKeeper.On("SpendableCoin", mock.Anything, sdk.AccAddress(sponsor.Bytes()), mock.Anything).
+		Return(sdk.NewCoin(testconstants.ExampleAttoDenom, sdkmath.NewInt(60_000)))
+
+	newTx := func(to *common.Address, gas uint64, gasPrice int64) *ethtypes.Transaction {
+		return ethtypes.NewTx(&ethtypes.LegacyTx{To: to, Gas: gas, GasPrice: big.NewInt(gasPrice)})
+	}
+	other := common.Address{4}
+
+	testCases := []struct {
+		name      string
+		tx        *ethtypes.Transaction
+		sender    common.Address
+		sponsored bool
+	}{
+		{"sponsored call", newTx(&contract, 30_000, 2), other, true},
+		{"contract creation", newTx(nil, 30_000, 2), other, false},
+		{"call of an unsponsored contract", newTx(&other, 30_000, 2), other, false},
+		{"gas limit above the maximum", newTx(&contract, 50_001, 1), other, false},
+		{"fee cap above the maximum", newTx(&contract, 10_000, 4), other, false},
+		{"fees above the budget", newTx(&contract, 40_000, 3), other, false},
+		{"fees above the sponsor balance", newTx(&contract, 40_000, 2), other, false},
+		{"fees within the remaining fees of the sender", newTx(&contract, 25_000, 2), sender, true},
+		{"fees above the remaining fees of the sender", newTx(&contract, 30_000, 2), sender, false},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			txSponsor, sponsored := suite.vmKeeper.GetTxSponsor(suite.ctx, tc.tx, tc.sender)
+			suite.Require().Equal(tc.sponsored, sponsored)
+			if tc.sponsored {
+				suite.Require().Equal(sponsor, txSponsor)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSponsoredFeesAndRefund() {
+	denom := suite.setupSponsorshipCoinInfo()
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager()).WithTxIndex(2)
+	sender := common.Address{1}
+	sponsor := common.Address{2}
+	contract := common.Address{3}
+	suite.vmKeeper.SetContractSponsorship(ctx, vmtypes.Sponsorship{
+		Sponsor:          sponsor.Hex(),
+		Contract:         contract.Hex(),
+		MaxGasPerTx:      1000,
+		Budget:           sdkmath.NewInt(1000),
+		MaxFeePerGas:     sdkmath.NewInt(2),
+		MaxFeesPerSender: sdkmath.NewInt(800),
+	})
+
+	fees := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(600)))
+	sponsorAcc := authtypes.NewBaseAccountWithAddress(sponsor.Bytes())
+	suite.accKeeper.On("GetAccount", mock.Anything, sdk.AccAddress(sponsor.Bytes())).Return(sponsorAcc)
+	suite.bankKeeper.On("SendCoinsFromAccountToModule", mock.Anything, sdk.AccAddress(sponsor.Bytes()), authtypes.FeeCollectorName, fees).
+		Return(nil).Once()
+	suite.Require().NoError(suite.vmKeeper.DeductTxCostsFromSponsor(ctx, fees, contract, sender))
+	suite.Require().Equal(vmtypes.EventTypeSponsoredFee, ctx.EventManager().Events()[0].Type)
+
+	sponsorship, found := suite.vmKeeper.GetContractSponsorship(ctx, contract)
+	suite.Require().True(found)
+	suite.Require().Equal(sdkmath.NewInt(400), sponsorship.Budget)
+	suite.Require().Equal(sdkmath.NewInt(600), suite.vmKeeper.GetSponsoredFees(ctx, contract, sender))
+
+	// the budget doesn't cover the fees of another tx
+	err := suite.vmKeeper.DeductTxCostsFromSponsor(ctx.WithTxIndex(3), fees, contract, common.Address{4})
+	suite.Require().ErrorContains(err, "sponsorship budget < tx fees")
+
+	// the remaining fees of the sender don't cover the fees of another tx
+	lowFees := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(300)))
+	err = suite.vmKeeper.DeductTxCostsFromSponsor(ctx.WithTxIndex(3), lowFees, contract, sender)
+	suite.Require().ErrorContains(err, "max fees per sender")
+
+	// the leftover gas is refunded to the sponsor and added back to the budget
+	refund := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(200)))
+	suite.bankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, authtypes.FeeCollectorName, sdk.AccAddress(sponsor.Bytes()), refund).
+		Return(nil).Once()
+	msg := core.Message{From: sender, To: &contract, GasPrice: big.NewInt(2)}
+	suite.Require().NoError(suite.vmKeeper.RefundGas(ctx, msg, 100, denom))
+
+	sponsorship, _ = suite.vmKeeper.GetContractSponsorship(ctx, contract)
+	suite.Require().Equal(sdkmath.NewInt(600), sponsorship.Budget)
+	suite.Require().Equal(sdkmath.NewInt(400), suite.vmKeeper.GetSponsoredFees(ctx, contract, sender))
+
+	// the txs which aren't sponsored are refunded to the sender
+	suite.bankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, authtypes.FeeCollectorName, sdk.AccAddress(sender.Bytes()), refund).
+		Return(nil).Once()
+	suite.Require().NoError(suite.vmKeeper.RefundGas(ctx.WithTxIndex(3), msg, 100, denom))
+}
+
+func (suite *KeeperTestSuite) TestSponsoredFeesLifetimeCap() {
+	suite.setupSponsorshipCoinInfo()
+	sponsor := sdk.AccAddress(common.Address{1}.Bytes())
+	contract := common.Address{3}
+	sender := common.Address{5}
+	suite.vmKeeper.SetPermissionEntry(suite.ctx, vmtypes.PermissionEntry{
+		List:    vmtypes.PermissionListSponsor,
+		Address: common.BytesToAddress(sponsor).Hex(),
+		Target:  contract.Hex(),
+	})
+	msg := &vmtypes.MsgSetSponsorship{
+		Sponsor:          sponsor.String(),
+		Contract:         contract.Hex(),
+		MaxGasPerTx:      100_000,
+		Budget:           sdkmath.NewInt(1_000_000),
+		MaxFeePerGas:     sdkmath.NewInt(10),
+		MaxFeesPerSender: sdkmath.NewInt(500),
+	}
+	_, err := suite.vmKeeper.SetSponsorship(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.vmKeeper.SetSponsoredFees(suite.ctx, vmtypes.SponsoredFees{Contract: contract.Hex(), Sender: sender.Hex(), Fees: sdkmath.NewInt(500)})
+	suite.bankKeeper.On("SpendableCoin", mock.Anything, sponsor, mock.Anything).
+		Return(sdk.NewCoin(testconstants.ExampleAttoDenom, sdkmath.NewInt(1_000_000)))
+	tx := ethtypes.NewTx(&ethtypes.LegacyTx{To: &contract, Gas: 100, GasPrice: big.NewInt(1)})
+
+	// the fees of the sender are neither reset in the later blocks nor when the sponsor updates
+	// the sponsorship
+	ctx := suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 100_000).WithBlockTime(suite.ctx.BlockTime().AddDate(1, 0, 0))
+	_, sponsored := suite.vmKeeper.GetTxSponsor(ctx, tx, sender)
+	suite.Require().False(sponsored)
+	_, err = suite.vmKeeper.SetSponsorship(ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdkmath.NewInt(500), suite.vmKeeper.GetSponsoredFees(ctx, contract, sender))
+	_, sponsored = suite.vmKeeper.GetTxSponsor(ctx, tx, sender)
+	suite.Require().False(sponsored)
+
+	// they are only reset with the removal of the sponsorship
+	_, err = suite.vmKeeper.RemoveSponsorship(ctx, &vmtypes.MsgRemoveSponsorship{Signer: sponsor.String(), Contract: contract.Hex()})
+	suite.Require().NoError(err)
+	_, err = suite.vmKeeper.SetSponsorship(ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().True(suite.vmKeeper.GetSponsoredFees(ctx, contract, sender).IsZero())
+	_, sponsored = suite.vmKeeper.GetTxSponsor(ctx, tx, sender)
+	suite.Require().True(sponsored)
+}
@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	evmtrace "github.com/cosmos/evm/trace"
+	"github.com/cosmos/evm/utils"
+	"github.com/cosmos/evm/x/vm/statedb"
+	"github.com/cosmos/evm/x/vm/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// maxStorageRangeResults is the maximum number of storage entries returned by StorageRangeAt
+	maxStorageRangeResults = 1024
+	// maxAccountRangeResults is the maximum number of accounts returned by AccountRange, same as geth
+	maxAccountRangeResults = 256
+	// maxAccountStorageResults is the maximum number of storage entries of each account returned by
+	// AccountRange
+	maxAccountStorageResults = 256
+)
+
+// StorageRangeAt implements the Query/StorageRangeAt gRPC method. It returns
+// the storage of the contract in ascending key order, starting from the start
+// key. The predecessors are replayed first, so the storage is the one before
+// the execution of the requested transaction in its block.
+func (k Keeper) StorageRangeAt(c context.Context, req *types.QueryStorageRangeAtRequest) (_ *types.QueryStorageRangeAtResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx, span := ctx.StartSpan(tracer, "StorageRangeAt", trace.WithAttributes(
+		attribute.String("address", req.Address),
+		attribute.String("start_key", req.StartKey),
+		attribute.Int64("block_number", req.BlockNumber),
+		attribute.Int("predecessor_count", len(req.Predecessors)),
+	))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	if err := utils.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if len(req.Predecessors) > maxTracePredecessors {
+		return nil, status.Errorf(codes.InvalidArgument, "too many predecessors, got %d: limit %d", len(req.Predecessors), maxTracePredecessors)
+	}
+
+	if len(req.Predecessors) > 0 {
+		ctx, err = k.predecessorsCtx(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	maxResult := req.MaxResult
+	if maxResult == 0 || maxResult > maxStorageRangeResults {
+		maxResult = maxStorageRangeResults
+	}
+
+	res := &types.QueryStorageRangeAtResponse{}
+	res.Storage, res.NextKey = k.storageRange(ctx, common.HexToAddress(req.Address), common.HexToHash(req.StartKey), maxResult)
+	return res, nil
+}
+
+// storageRange returns at most maxResult storage entries of the account in ascending key order,
+// starting from the start key, along with the key of the next entry, empty if there is none. The
+// iteration starts at the start key, so the preceding entries aren't read.
+func (k Keeper) storageRange(ctx sdk.Context, addr common.Address, start common.Hash, maxResult uint64) (types.Storage, string) {
+	prefix := types.AddressStoragePrefix(addr)
+	iterator := ctx.KVStore(k.storeKey).Iterator(types.StateKey(addr, start.Bytes()), storetypes.PrefixEndBytes(prefix))
+	defer iterator.Close()
+
+	storage := types.Storage{}
+	for ; iterator.Valid(); iterator.Next() {
+		key := common.BytesToHash(iterator.Key()[len(prefix):])
+		if uint64(len(storage)) == maxResult {
+			return storage, key.Hex()
+		}
+		storage = append(storage, types.NewState(key, common.BytesToHash(iterator.Value())))
+	}
+	return storage, ""
+}
+
+// predecessorsCtx returns the context of the requested block with the
+// predecessors of the StorageRangeAt request applied.
+func (k Keeper) predecessorsCtx(ctx sdk.Context, req *types.QueryStorageRangeAtRequest) (sdk.Context, error) {
+	// the caller sets the `ctx.BlockHeight()` to be `requestedHeight - 1`, so we can get the context of block beginning
+	requestedHeight := req.BlockNumber
+	if requestedHeight < 1 {
+		requestedHeight = 1
+	}
+	if requestedHeight > ctx.BlockHeight()+1 {
+		return ctx, status.Errorf(codes.FailedPrecondition, "requested height [%d] must be less than or equal to current height [%d]", requestedHeight, ctx.BlockHeight())
+	}
+
+	ctx = ctx.WithBlockHeight(requestedHeight)
+	ctx = ctx.WithBlockTime(req.BlockTime)
+	ctx = ctx.WithHeaderHash(common.Hex2Bytes(req.BlockHash))
+
+	// to get the base fee we only need the block max gas in the consensus params
+	ctx = ctx.WithConsensusParams(tmproto.ConsensusParams{
+		Block: &tmproto.BlockParams{MaxGas: req.BlockMaxGas},
+	})
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
+	if err != nil {
+		return ctx, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
+	}
+
+	baseFee := k.feeMarketWrapper.CalculateBaseFee(ctx)
+	if baseFee != nil {
+		cfg.BaseFee = baseFee
+	}
+
+	signer := ethtypes.MakeSigner(types.GetEthChainConfig(), big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
+	txConfig := statedb.NewEmptyTxConfig()
+	return k.applyPredecessors(ctx, cfg, signer, &txConfig, req.Predecessors), nil
+}
+
+// AccountRange implements the Query/AccountRange gRPC method. It returns the
+// contract accounts in ascending address order, starting from the start
+// address. Accounts without code aren't enumerated. The storage of each
+// account is capped at maxAccountStorageResults entries, the rest of it is
+// returned by StorageRangeAt.
+func (k Keeper) AccountRange(c context.Context, req *types.QueryAccountRangeRequest) (_ *types.QueryAccountRangeResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx, span := ctx.StartSpan(tracer, "AccountRange", trace.WithAttributes(
+		attribute.String("start", req.Start),
+		attribute.Int64("max_result", int64(req.MaxResult)), //#nosec G115 -- int overflow is not a concern here
+	))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	if req.Start != "" && !common.IsHexAddress(req.Start) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid start address %s", req.Start)
+	}
+
+	maxResult := req.MaxResult
+	if maxResult == 0 || maxResult > maxAccountRangeResults {
+		maxResult = maxAccountRangeResults
+	}
+
+	start := common.HexToAddress(req.Start)
+	res := &types.QueryAccountRangeResponse{
+		Accounts: []types.DumpAccount{},
+	}
+	k.IterateContracts(ctx, func(addr common.Address, codeHash common.Hash) bool {
+		if bytes.Compare(addr.Bytes(), start.Bytes()) < 0 {
+			return false
+		}
+		if uint64(len(res.Accounts)) == maxResult {
+			res.Next = addr.Hex()
+			return true
+		}
+
+		account := types.DumpAccount{
+			Address:  addr.Hex(),
+			Balance:  "0",
+			CodeHash: codeHash.Hex(),
+		}
+		if acct := k.GetAccount(ctx, addr); acct != nil {
+			account.Balance = acct.Balance.String()
+			account.Nonce = acct.Nonce
+		}
+		if !req.NoCode {
+			account.Code = hexutil.Encode(k.GetCode(ctx, codeHash))
+		}
+		if !req.NoStorage {
+			account.Storage, _ = k.storageRange(ctx, addr, common.Hash{}, maxAccountStorageResults)
+		}
+		res.Accounts = append(res.Accounts, account)
+		return false
+	})
+
+	return res, nil
+}
@@ -0,0 +1,48 @@
+package keeper_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	vmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+func (suite *KeeperTestSuite) TestStorageRangeAt() {
+	addr := common.Address{0x10}
+	keys := []common.Hash{common.HexToHash("0x10"), common.HexToHash("0x20"), common.HexToHash("0x30")}
+	for i, key := range keys {
+		suite.vmKeeper.SetState(suite.ctx, addr, key, common.Hash{byte(i + 1)}.Bytes())
+	}
+	// the storage of the next account isn't part of the range
+	suite.vmKeeper.SetState(suite.ctx, common.Address{0x11}, keys[0], common.Hash{0xff}.Bytes())
+
+	testCases := []struct {
+		name     string
+		startKey common.Hash
+		max      uint64
+		expKeys  []common.Hash
+		expNext  string
+	}{
+		{"all the storage", common.Hash{}, 0, keys, ""},
+		{"start key of an entry", keys[1], 0, keys[1:], ""},
+		{"start key between entries", common.HexToHash("0x11"), 0, keys[1:], ""},
+		{"start key after the entries", common.HexToHash("0x31"), 0, nil, ""},
+		{"paginated", keys[0], 2, keys[:2], keys[2].Hex()},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res, err := suite.vmKeeper.StorageRangeAt(suite.ctx, &vmtypes.QueryStorageRangeAtRequest{
+				Address:   addr.Hex(),
+				StartKey:  tc.startKey.Hex(),
+				MaxResult: tc.max,
+			})
+			suite.Require().NoError(err)
+
+			expStorage := vmtypes.Storage{}
+			for _, key := range tc.expKeys {
+				expStorage = append(expStorage, vmtypes.NewState(key, suite.vmKeeper.GetState(suite.ctx, addr, key)))
+			}
+			suite.Require().Equal(expStorage, res.Storage)
+			suite.Require().Equal(tc.expNext, res.NextKey)
+		})
+	}
+}
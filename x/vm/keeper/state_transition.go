@@ -44,7 +44,21 @@ func (k *Keeper) NewEVMWithOverridePrecompiles(
 	stateDB vm.StateDB,
 	overridePrecompiles bool,
 ) *vm.EVM {
-	ctx, span := ctx.StartSpan(tracer, "NewEVMWithOverridePrecompiles", trace.WithAttributes(
+	return k.newEVM(ctx, msg, cfg, tracingHooks, stateDB, overridePrecompiles, nil)
+}
+
+// newEVM creates a new EVM instance like NewEVMWithOverridePrecompiles, recording the first call
+// rejected by the EvmCallHooks in rejectedCall if not nil.
+func (k *Keeper) newEVM(
+	ctx sdk.Context,
+	msg core.Message,
+	cfg *statedb.EVMConfig,
+	tracingHooks *tracing.Hooks,
+	stateDB vm.StateDB,
+	overridePrecompiles bool,
+	rejectedCall *error,
+) *vm.EVM {
+	ctx, span := ctx.StartSpan(tracer, "newEVM", trace.WithAttributes(
 		attribute.Bool("override_precompiles", overridePrecompiles),
 		attribute.String("from", msg.From.Hex()),
 	))
@@ -71,7 +85,7 @@ func (k *Keeper) NewEVMWithOverridePrecompiles(
 	vmConfig := k.VMConfig(ctx, msg, cfg, tracingHooks)
 
 	signer := msg.From
-	accessControl := types.NewRestrictedPermissionPolicy(&cfg.Params.AccessControl, signer)
+	accessControl := k.NewPermissionPolicy(ctx, &cfg.Params.AccessControl, signer)
 
 	// Set hooks for the EVM opcodes
 	evmHooks := types.NewDefaultOpCodesHooks()
@@ -81,6 +95,9 @@ func (k *Keeper) NewEVMWithOverridePrecompiles(
 	evmHooks.AddCallHooks(
 		accessControl.GetCallHook(signer),
 	)
+	if callHook := k.GetEvmCallHook(ctx, rejectedCall); callHook != nil {
+		evmHooks.AddCallHooks(callHook)
+	}
 	if overridePrecompiles {
 		evmHooks.AddCallHooks(
 			k.GetPrecompilesCallHook(ctx),
@@ -208,6 +225,12 @@ func calculateCumulativeGasFromEthResponse(meter storetypes.GasMeter, res *types
 // returning.
 //
 // For relevant discussion see: https://github.com/cosmos/cosmos-sdk/discussions/9072
+//
+// # Hooks
+//
+// The EVM hooks can reject the transaction before its execution with PreTxProcessing, reject any of
+// its calls with PreCallProcessing and reject it after its execution with PostTxProcessing. A
+// rejected transaction fails and its state changes are reverted.
 func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (_ *types.MsgEthereumTxResponse, err error) {
 	ctx, span := ctx.StartSpan(tracer, "ApplyTransaction", trace.WithAttributes(
 		attribute.String("hash", tx.Hash().String()),
@@ -231,13 +254,37 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (_
 	// thus restricted to be used only inside `ApplyMessage`.
 	tmpCtx, commitFn := ctx.CacheContext()
 
-	// pass true to commit the StateDB
-	res, err := k.ApplyMessageWithConfig(tmpCtx, *msg, nil, true, cfg, txConfig, false, nil)
+	signerAddr, err := signer.Sender(tx)
 	if err != nil {
-		// when a transaction contains multiple msg, as long as one of the msg fails
-		// all gas will be deducted. so is not msg.Gas()
-		k.ResetGasMeterAndConsumeGas(tmpCtx, tmpCtx.GasMeter().Limit())
-		return nil, errorsmod.Wrap(err, "failed to apply ethereum core message")
+		return nil, errorsmod.Wrap(err, "failed to extract sender address from ethereum transaction")
+	}
+
+	var (
+		res      *types.MsgEthereumTxResponse
+		rejected bool
+	)
+	if err = k.PreTxProcessing(tmpCtx, signerAddr, *msg); err != nil {
+		// If the hooks reject the tx, it isn't executed and fails consuming its
+		// whole gas limit, like an execution which runs out of gas.
+		k.Logger(ctx).Debug("tx rejected by pre processing", "error", err)
+		rejected = true
+		res = &types.MsgEthereumTxResponse{
+			GasUsed:        msg.GasLimit,
+			MaxUsedGas:     msg.GasLimit,
+			VmError:        errorsmod.Wrap(err, "failed to execute pre transaction processing").Error(),
+			Hash:           txConfig.TxHash.Hex(),
+			BlockHash:      ctx.HeaderHash(),
+			BlockTimestamp: uint64(ctx.BlockTime().Unix()), //#nosec G115 -- int overflow is not a concern here
+		}
+	} else {
+		// pass true to commit the StateDB
+		res, err = k.ApplyMessageWithConfig(tmpCtx, *msg, nil, true, cfg, txConfig, false, nil)
+		if err != nil {
+			// when a transaction contains multiple msg, as long as one of the msg fails
+			// all gas will be deducted. so is not msg.Gas()
+			k.ResetGasMeterAndConsumeGas(tmpCtx, tmpCtx.GasMeter().Limit())
+			return nil, errorsmod.Wrap(err, "failed to apply ethereum core message")
+		}
 	}
 
 	ethLogs := types.LogsToEthereum(res.Logs)
@@ -246,8 +293,9 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (_
 		k.SetTxBloom(tmpCtx, new(big.Int).SetBytes(logsBloom(ethLogs)))
 	}
 
+	// the rejected creations don't create a contract
 	var contractAddr common.Address
-	if msg.To == nil {
+	if msg.To == nil && !rejected {
 		contractAddr = crypto.CreateAddress(msg.From, msg.Nonce)
 	}
 
@@ -274,11 +322,6 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (_
 		receipt.Status = ethtypes.ReceiptStatusSuccessful
 	}
 
-	signerAddr, err := signer.Sender(tx)
-	if err != nil {
-		return nil, errorsmod.Wrap(err, "failed to extract sender address from ethereum transaction")
-	}
-
 	// Only call PostTxProcessing if there are hooks set, to avoid calling commitFn unnecessarily
 	if !k.HasHooks() {
 		// If there are no hooks, we can commit the state immediately if the tx is successful
@@ -413,9 +456,12 @@ func (k *Keeper) ApplyMessageWithConfig(
 	))
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 
+	// the first call rejected by the EVM hooks, which reverts the whole execution
+	var rejectedCall error
+
 	stateDB := statedb.New(ctx, k, txConfig)
 	ethCfg := types.GetEthChainConfig()
-	evm := k.NewEVMWithOverridePrecompiles(ctx, msg, cfg, tracingHooks, stateDB, overrides == nil)
+	evm := k.newEVM(ctx, msg, cfg, tracingHooks, stateDB, overrides == nil, &rejectedCall)
 	// Gas limit suffices for the floor data cost (EIP-7623)
 	rules := ethCfg.Rules(evm.Context.BlockNumber, true, evm.Context.Time)
 	if overrides != nil {
@@ -548,6 +594,12 @@ func (k *Keeper) ApplyMessageWithConfig(
 		}
 	}
 
+	// A call rejected by the EVM hooks fails the execution even if the calling
+	// contract ignored the failure of the call.
+	if rejectedCall != nil {
+		vmErr = rejectedCall
+	}
+
 	// EVM execution error needs to be available for the JSON-RPC client
 	var vmError string
 	if vmErr != nil {
@@ -556,7 +608,7 @@ func (k *Keeper) ApplyMessageWithConfig(
 	}
 
 	// The dirty states in `StateDB` is either committed or discarded after return
-	if commit {
+	if commit && rejectedCall == nil {
 		if err := stateDB.Commit(); err != nil {
 			return nil, errorsmod.Wrap(err, "failed to commit stateDB")
 		}
@@ -0,0 +1,214 @@
+package keeper
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// prestateTracerName is the name of the geth native prestate tracer
+const prestateTracerName = "prestateTracer"
+
+// prestateTracerConfig is the subset of the prestate tracer configuration
+// needed to fix its balances.
+type prestateTracerConfig struct {
+	DiffMode bool `json:"diffMode"`
+}
+
+// prestateAccount is an account of the prestate tracer output, the fields
+// other than the balance and the nonce are kept as they are.
+type prestateAccount map[string]json.RawMessage
+
+// tracePrestate traces the message with the prestate tracer. The message is
+// executed and committed on a branch of the context, so that the balance
+// changes made outside of the StateDB can be read back and merged into the
+// tracer result. The branch is written to the context only if commitMessage
+// is true.
+func (k *Keeper) tracePrestate(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+	msg *core.Message,
+	tracer *tracers.Tracer,
+	tracerConfig json.RawMessage,
+	commitMessage bool,
+) (*any, error) {
+	execCtx, write := ctx.CacheContext()
+	if _, err := k.ApplyMessageWithConfig(execCtx, *msg, tracer.Hooks, true, cfg, txConfig, false, nil); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res, err := tracer.GetResult()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res, err = k.fixPrestateBalances(ctx, execCtx, execCtx.EventManager().Events(), res, tracerConfig)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if commitMessage {
+		write()
+	}
+
+	var result interface{} = res
+	return &result, nil
+}
+
+// fixPrestateBalances corrects the balances reported by the prestate tracer.
+//
+// The tracer only looks up the accounts touched by the EVM opcodes and reads
+// their balances from the StateDB. The bank transfers made by precompiles
+// (e.g. staking, distribution or the ERC-20 precompile of the native token)
+// and by precisebank can modify other accounts, and the balance changes of
+// blocked addresses such as module accounts are never reflected in the
+// StateDB. The accounts found in the bank and precisebank events emitted by
+// the message are therefore added to the output, with the balances read from
+// the stores before (ctx) and after (execCtx) the execution of the message.
+func (k *Keeper) fixPrestateBalances(ctx, execCtx sdk.Context, events sdk.Events, result json.RawMessage, tracerConfig json.RawMessage) (json.RawMessage, error) {
+	var config prestateTracerConfig
+	if len(tracerConfig) > 0 {
+		if err := json.Unmarshal(tracerConfig, &config); err != nil {
+			return nil, err
+		}
+	}
+
+	addresses := balanceChangeAddresses(events)
+	if len(addresses) == 0 {
+		return result, nil
+	}
+
+	if !config.DiffMode {
+		var pre map[common.Address]prestateAccount
+		if err := json.Unmarshal(result, &pre); err != nil {
+			return nil, err
+		}
+		for _, addr := range addresses {
+			if _, ok := pre[addr]; ok {
+				continue
+			}
+			if pre == nil {
+				pre = make(map[common.Address]prestateAccount)
+			}
+			pre[addr] = k.prestateAccount(ctx, addr)
+		}
+		return json.Marshal(pre)
+	}
+
+	var diff struct {
+		Post map[common.Address]prestateAccount `json:"post"`
+		Pre  map[common.Address]prestateAccount `json:"pre"`
+	}
+	if err := json.Unmarshal(result, &diff); err != nil {
+		return nil, err
+	}
+	if diff.Pre == nil {
+		diff.Pre = make(map[common.Address]prestateAccount)
+	}
+	if diff.Post == nil {
+		diff.Post = make(map[common.Address]prestateAccount)
+	}
+
+	for _, addr := range addresses {
+		preBalance := k.SpendableCoin(ctx, addr).ToBig()
+		postBalance := k.SpendableCoin(execCtx, addr).ToBig()
+
+		if preBalance.Cmp(postBalance) == 0 {
+			post, ok := diff.Post[addr]
+			if !ok {
+				continue
+			}
+			delete(post, "balance")
+			if len(post) == 0 {
+				// the balance was the only modification
+				delete(diff.Post, addr)
+				delete(diff.Pre, addr)
+			}
+			continue
+		}
+
+		if _, ok := diff.Pre[addr]; !ok {
+			diff.Pre[addr] = k.prestateAccount(ctx, addr)
+		}
+		if _, ok := diff.Post[addr]; !ok {
+			diff.Post[addr] = prestateAccount{}
+		}
+		if err := diff.Pre[addr].setBalance(preBalance); err != nil {
+			return nil, err
+		}
+		if err := diff.Post[addr].setBalance(postBalance); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(diff)
+}
+
+// prestateAccount returns the balance and the nonce of the account
+func (k *Keeper) prestateAccount(ctx sdk.Context, addr common.Address) prestateAccount {
+	account := prestateAccount{}
+	_ = account.setBalance(k.SpendableCoin(ctx, addr).ToBig())
+	if acct := k.GetAccountWithoutBalance(ctx, addr); acct != nil && acct.Nonce > 0 {
+		account["nonce"], _ = json.Marshal(acct.Nonce)
+	}
+	return account
+}
+
+func (a prestateAccount) setBalance(balance *big.Int) error {
+	bz, err := json.Marshal((*hexutil.Big)(balance))
+	if err != nil {
+		return err
+	}
+	a["balance"] = bz
+	return nil
+}
+
+// balanceChangeAddresses returns the addresses found in the bank and
+// precisebank balance change events, in order of appearance.
+func balanceChangeAddresses(events sdk.Events) []common.Address {
+	var (
+		addresses []common.Address
+		seen      = make(map[common.Address]struct{})
+	)
+	for _, event := range events {
+		var key string
+		switch event.Type {
+		case banktypes.EventTypeCoinSpent:
+			key = banktypes.AttributeKeySpender
+		case banktypes.EventTypeCoinReceived:
+			key = banktypes.AttributeKeyReceiver
+		case precisebanktypes.EventTypeFractionalBalanceChange:
+			key = precisebanktypes.AttributeKeyAddress
+		default:
+			continue
+		}
+
+		attr, ok := event.GetAttribute(key)
+		if !ok {
+			continue
+		}
+		accAddr, err := sdk.AccAddressFromBech32(attr.Value)
+		if err != nil {
+			continue
+		}
+		addr := common.BytesToAddress(accAddr)
+		if _, ok := seen[addr]; ok {
+			continue
+		}
+		seen[addr] = struct{}{}
+		addresses = append(addresses, addr)
+	}
+	return addresses
+}
@@ -0,0 +1,97 @@
+package parallel
+
+import (
+	"context"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/baseapp/txnrunner"
+	"github.com/cosmos/cosmos-sdk/blockstm"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.TxRunner = TxRunner{}
+
+// TxRunner is an opt-in sdk.TxRunner executing the blocks which only contain Ethereum txs with
+// block-STM, an optimistic concurrency control executor:
+//   - the txs are speculatively executed in parallel by the workers, each incarnation against a
+//     multi-version view of the block state, which records the keys read and written by the tx
+//   - a tx is validated once the txs before it are executed. If one of them wrote a key of its
+//     read set, the tx is aborted and executed again on top of the new writes
+//   - the writes of the txs are applied to the block state in the order of the block
+//
+// The results and the final state are identical to the sequential execution of the block. The
+// EVM state transitions run on a snapshotmulti.Store on top of the multi-version view, so the
+// read and write sets of a tx cover every store touched by the EVM, precompiles included.
+//
+// The blocks with other txs, which can't be decoded or which contain other msgs, are executed
+// sequentially. Since the fees of the txs are all credited to the fee collector, the EVM keeper
+// must use the virtual fee collection (see Keeper.EnableVirtualFeeCollection), otherwise all the
+// txs of the block conflict on the balance of the fee collector. SetTxRunner enables both, which
+// restricts the parallel execution to the chains with an 18 decimals gas token. The virtual fee
+// collection credits the fees to the fee collector at the end of the block, so the balance of
+// the fee collector within the blocks differs from the nodes executing them sequentially without
+// it, unless the chain enables it on all its nodes.
+type TxRunner struct {
+	txDecoder  sdk.TxDecoder
+	sequential sdk.TxRunner
+	parallel   sdk.TxRunner
+}
+
+// NewTxRunner returns a TxRunner executing the Ethereum txs on the given number of workers. The
+// store keys must include all the KV and object stores of the app, since the keys of the stores
+// which aren't listed aren't tracked.
+func NewTxRunner(txDecoder sdk.TxDecoder, storeKeys []storetypes.StoreKey, workers int) *TxRunner {
+	return &TxRunner{
+		txDecoder:  txDecoder,
+		sequential: txnrunner.NewDefaultRunner(txDecoder),
+		parallel: blockstm.NewSTMRunner(txDecoder, storeKeys, workers, true, func(storetypes.MultiStore) string {
+			return evmtypes.GetEVMCoinDenom()
+		}),
+	}
+}
+
+// SetTxRunner enables the virtual fee collection of the EVM keeper and sets a TxRunner executing
+// the Ethereum txs on the given number of workers as the tx runner of the app.
+func SetTxRunner(app *baseapp.BaseApp, evmKeeper *evmkeeper.Keeper, txDecoder sdk.TxDecoder, storeKeys []storetypes.StoreKey, workers int) {
+	evmKeeper.EnableVirtualFeeCollection()
+	app.SetBlockSTMTxRunner(NewTxRunner(txDecoder, storeKeys, workers))
+}
+
+// Run implements sdk.TxRunner.
+func (r TxRunner) Run(ctx context.Context, ms storetypes.MultiStore, txs [][]byte, deliverTx sdk.DeliverTxFunc) ([]*abci.ExecTxResult, error) {
+	if !r.IsEthereumBlock(txs) {
+		return r.sequential.Run(ctx, ms, txs, deliverTx)
+	}
+	return r.parallel.Run(ctx, ms, txs, deliverTx)
+}
+
+// IsEthereumBlock returns true if the block isn't empty and only contains txs whose msgs are all
+// MsgEthereumTx.
+func (r TxRunner) IsEthereumBlock(txs [][]byte) bool {
+	if len(txs) == 0 {
+		return false
+	}
+	for _, bz := range txs {
+		tx, err := r.txDecoder(bz)
+		if err != nil {
+			return false
+		}
+		msgs := tx.GetMsgs()
+		if len(msgs) == 0 {
+			return false
+		}
+		for _, msg := range msgs {
+			if _, ok := msg.(*evmtypes.MsgEthereumTx); !ok {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,208 @@
+package parallel_test
+
+import (
+	"context"
+	"math/big"
+	"sync/atomic"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"github.com/cosmos/evm/encoding"
+	testconstants "github.com/cosmos/evm/testutil/constants"
+	"github.com/cosmos/evm/x/vm/parallel"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const testChainID = 9001
+
+var (
+	balanceKey = storetypes.NewKVStoreKey("balance")
+	countKey   = storetypes.NewObjectStoreKey("count")
+	storeKeys  = []storetypes.StoreKey{balanceKey, countKey}
+)
+
+// newTxConfig returns the TxConfig of the app encoding, with the msgs of the tests registered
+func newTxConfig() client.TxConfig {
+	encodingConfig := encoding.MakeConfig(testChainID)
+	evmtypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	banktypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	return encodingConfig.TxConfig
+}
+
+// newMultiStore returns a multistore where the recipients have an initial balance
+func newMultiStore(t *testing.T, recipients []common.Address) storetypes.CacheMultiStore {
+	t.Helper()
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	cms.MountStoreWithDB(balanceKey, storetypes.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(countKey, storetypes.StoreTypeObject, db)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	ms := cms.CacheMultiStore()
+	for i, recipient := range recipients {
+		ms.GetKVStore(balanceKey).Set(recipient.Bytes(), big.NewInt(int64(i)).Bytes())
+	}
+	return ms
+}
+
+// encodeTxs returns the encoded Ethereum txs transferring increasing amounts to the recipients in turn
+func encodeTxs(t *testing.T, txConfig client.TxConfig, recipients []common.Address, n int) [][]byte {
+	t.Helper()
+	txs := make([][]byte, n)
+	for i := range txs {
+		to := recipients[i%len(recipients)]
+		msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			ChainID:  big.NewInt(testChainID),
+			Nonce:    uint64(i),
+			To:       &to,
+			Amount:   big.NewInt(int64(i + 1)),
+			GasLimit: 21000,
+			GasPrice: big.NewInt(1),
+		})
+		tx, err := msg.BuildTxWithEvmParams(txConfig.NewTxBuilder(), evmtypes.DefaultParams())
+		require.NoError(t, err)
+		bz, err := txConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		txs[i] = bz
+	}
+	return txs
+}
+
+// newDeliverTx returns a DeliverTxFunc crediting the value of the txs to their recipient. The
+// result of a tx depends on the txs executed before it: it holds the new balance of the recipient
+// and the number of txs executed in the block.
+func newDeliverTx(t *testing.T, txDecoder sdk.TxDecoder, blockStore storetypes.MultiStore, executions *atomic.Int32) sdk.DeliverTxFunc {
+	t.Helper()
+	return func(bz []byte, ms storetypes.MultiStore, _ int, _ map[string]any) *abci.ExecTxResult {
+		executions.Add(1)
+		if ms == nil {
+			ms = blockStore
+		}
+		tx, err := txDecoder(bz)
+		require.NoError(t, err)
+		ethTx := tx.GetMsgs()[0].(*evmtypes.MsgEthereumTx).AsTransaction()
+
+		balances := ms.GetKVStore(balanceKey)
+		balance := new(big.Int).SetBytes(balances.Get(ethTx.To().Bytes()))
+		balance.Add(balance, ethTx.Value())
+		balances.Set(ethTx.To().Bytes(), balance.Bytes())
+
+		counts := ms.GetObjKVStore(countKey)
+		count, _ := counts.Get([]byte("count")).(int)
+		counts.Set([]byte("count"), count+1)
+
+		return &abci.ExecTxResult{Data: balance.Bytes(), GasUsed: int64(count + 1)}
+	}
+}
+
+func TestTxRunnerDeterminism(t *testing.T) {
+	configurator := evmtypes.NewEVMConfigurator()
+	configurator.ResetTestConfig()
+	require.NoError(t, configurator.WithEVMCoinInfo(testconstants.ExampleChainCoinInfo[testconstants.ExampleChainID]).Configure())
+	t.Cleanup(configurator.ResetTestConfig)
+
+	txConfig := newTxConfig()
+	txDecoder := txConfig.TxDecoder()
+	recipients := []common.Address{
+		common.HexToAddress("0x1000000000000000000000000000000000000001"),
+		common.HexToAddress("0x1000000000000000000000000000000000000002"),
+		common.HexToAddress("0x1000000000000000000000000000000000000003"),
+	}
+
+	testCases := []struct {
+		name    string
+		txs     int
+		workers int
+	}{
+		{"single tx", 1, 4},
+		{"single worker", 20, 1},
+		{"conflicting txs", 50, 4},
+		{"more workers than txs", 5, 16},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txs := encodeTxs(t, txConfig, recipients, tc.txs)
+
+			var seqExecutions, parExecutions atomic.Int32
+			seqStore := newMultiStore(t, recipients)
+			seqResults, err := parallel.NewTxRunner(txDecoder, storeKeys, 1).Run(
+				context.Background(), seqStore, append(txs, []byte{0xFF}), newDeliverTx(t, txDecoder, seqStore, &seqExecutions),
+			)
+			require.NoError(t, err)
+			// the undecodable tx makes the block sequential
+			require.Len(t, seqResults, tc.txs+1)
+			require.NotZero(t, seqResults[tc.txs].Code)
+			require.Equal(t, int32(tc.txs), seqExecutions.Load())
+
+			parStore := newMultiStore(t, recipients)
+			parResults, err := parallel.NewTxRunner(txDecoder, storeKeys, tc.workers).Run(
+				context.Background(), parStore, txs, newDeliverTx(t, txDecoder, parStore, &parExecutions),
+			)
+			require.NoError(t, err)
+			require.Equal(t, seqResults[:tc.txs], parResults)
+			require.GreaterOrEqual(t, parExecutions.Load(), int32(tc.txs))
+
+			for _, recipient := range recipients {
+				require.Equal(t,
+					seqStore.GetKVStore(balanceKey).Get(recipient.Bytes()),
+					parStore.GetKVStore(balanceKey).Get(recipient.Bytes()),
+				)
+			}
+			require.Equal(t, tc.txs, parStore.GetObjKVStore(countKey).Get([]byte("count")))
+		})
+	}
+}
+
+func TestIsEthereumBlock(t *testing.T) {
+	txConfig := newTxConfig()
+	runner := parallel.NewTxRunner(txConfig.TxDecoder(), storeKeys, 4)
+
+	to := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	ethTx, err := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:  big.NewInt(testChainID),
+		To:       &to,
+		GasLimit: 21000,
+		GasPrice: big.NewInt(1),
+	}).BuildTxWithEvmParams(txConfig.NewTxBuilder(), evmtypes.DefaultParams())
+	require.NoError(t, err)
+	ethTxBz, err := txConfig.TxEncoder()(ethTx)
+	require.NoError(t, err)
+
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(&banktypes.MsgSend{
+		FromAddress: sdk.AccAddress(to.Bytes()).String(),
+		ToAddress:   sdk.AccAddress(to.Bytes()).String(),
+	}))
+	cosmosTxBz, err := txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name string
+		txs  [][]byte
+		exp  bool
+	}{
+		{"empty block", nil, false},
+		{"ethereum txs", [][]byte{ethTxBz, ethTxBz}, true},
+		{"cosmos tx", [][]byte{ethTxBz, cosmosTxBz}, false},
+		{"undecodable tx", [][]byte{ethTxBz, {0xFF}}, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.exp, runner.IsEthereumBlock(tc.txs))
+		})
+	}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"math/big"
+	"sync/atomic"
 
 	gethparams "github.com/ethereum/go-ethereum/params"
 
@@ -10,8 +11,9 @@ import (
 )
 
 // chainConfig is the chain configuration used in the EVM to defined which
-// opcodes are active based on Ethereum upgrades.
-var chainConfig *ChainConfig
+// opcodes are active based on Ethereum upgrades. It is atomic since the fork
+// activations replace it while the RPC and CheckTx read it.
+var chainConfig atomic.Pointer[ChainConfig]
 
 // EthereumConfig returns an Ethereum ChainConfig for EVM state transitions.
 // All the negative or nil values are converted to nil
@@ -20,7 +20,11 @@ var (
 
 const (
 	// Amino names
-	updateParamsName = "os/evm/MsgUpdateParams"
+	updateParamsName           = "os/evm/MsgUpdateParams"
+	scheduleForkActivationName = "cosmos/evm/x/vm/MsgScheduleForkActivation"
+	updatePermissionsName      = "cosmos/evm/x/vm/MsgUpdatePermissions"
+	setSponsorshipName         = "cosmos/evm/x/vm/MsgSetSponsorship"
+	removeSponsorshipName      = "cosmos/evm/x/vm/MsgRemoveSponsorship"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -39,6 +43,10 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgEthereumTx{},
 		&MsgUpdateParams{},
+		&MsgScheduleForkActivation{},
+		&MsgUpdatePermissions{},
+		&MsgSetSponsorship{},
+		&MsgRemoveSponsorship{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -47,4 +55,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgScheduleForkActivation{}, scheduleForkActivationName, nil)
+	cdc.RegisterConcrete(&MsgUpdatePermissions{}, updatePermissionsName, nil)
+	cdc.RegisterConcrete(&MsgSetSponsorship{}, setSponsorshipName, nil)
+	cdc.RegisterConcrete(&MsgRemoveSponsorship{}, removeSponsorshipName, nil)
 }
@@ -47,19 +47,19 @@ func (ec *EVMConfigurator) ResetTestConfig() {
 
 // GetEthChainConfig returns the `chainConfig` used in the EVM (geth type).
 func GetEthChainConfig() *geth.ChainConfig {
-	return chainConfig.EthereumConfig(nil)
+	return chainConfig.Load().EthereumConfig(nil)
 }
 
 // GetChainConfig returns the `chainConfig`.
 func GetChainConfig() *ChainConfig {
-	return chainConfig
+	return chainConfig.Load()
 }
 
 // SetChainConfig allows to set the `chainConfig` variable modifying the
 // default values. The method is private because it should only be called once
 // in the EVMConfigurator.
 func SetChainConfig(cc *ChainConfig) error {
-	if chainConfig != nil && chainConfig.ChainId != DefaultEVMChainID {
+	if current := chainConfig.Load(); current != nil && current.ChainId != DefaultEVMChainID {
 		return errors.New("chainConfig already set. Cannot set again the chainConfig")
 	}
 	config := DefaultChainConfig(0)
@@ -69,7 +69,17 @@ func SetChainConfig(cc *ChainConfig) error {
 	if err := config.Validate(); err != nil {
 		return err
 	}
-	chainConfig = config
+	chainConfig.Store(config)
 
 	return nil
 }
+
+// UpdateChainConfig replaces the `chainConfig` once it is set, e.g. to apply
+// the fork activations scheduled by governance.
+func UpdateChainConfig(cc *ChainConfig) error {
+	if err := cc.Validate(); err != nil {
+		return err
+	}
+	chainConfig.Store(cc)
+	return nil
+}
@@ -32,6 +32,9 @@ const (
 	codeErrABIPack
 	codeErrABIUnpack
 	codeErrInvalidPreinstall
+	codeErrInvalidForkActivation
+	codeErrInvalidPermissionEntry
+	codeErrInvalidSponsorship
 )
 
 var (
@@ -92,6 +95,15 @@ var (
 	// ErrInvalidPreinstall returns an error if a preinstall is invalid
 	ErrInvalidPreinstall = errorsmod.Register(ModuleName, codeErrInvalidPreinstall, "invalid preinstall")
 
+	// ErrInvalidForkActivation returns an error if a fork activation is invalid
+	ErrInvalidForkActivation = errorsmod.Register(ModuleName, codeErrInvalidForkActivation, "invalid fork activation")
+
+	// ErrInvalidPermissionEntry returns an error if an entry of a permission list is invalid
+	ErrInvalidPermissionEntry = errorsmod.Register(ModuleName, codeErrInvalidPermissionEntry, "invalid permission entry")
+
+	// ErrInvalidSponsorship returns an error if a sponsorship is invalid
+	ErrInvalidSponsorship = errorsmod.Register(ModuleName, codeErrInvalidSponsorship, "invalid sponsorship")
+
 	// RevertSelector is selector of ErrExecutionReverted
 	RevertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
 )
@@ -6,6 +6,14 @@ const (
 	EventTypeBlockBloom = "block_bloom"
 	EventTypeFeeMarket  = "evm_fee_market"
 
+	EventTypeScheduleForkActivation = "schedule_fork_activation"
+	EventTypeForkActivation         = "fork_activation"
+	EventTypeAddPermission          = "add_permission"
+	EventTypeRemovePermission       = "remove_permission"
+	EventTypeSetSponsorship         = "set_sponsorship"
+	EventTypeRemoveSponsorship      = "remove_sponsorship"
+	EventTypeSponsoredFee           = "sponsored_fee"
+
 	AttributeKeyBaseFee         = "base_fee"
 	AttributeKeyContractAddress = "contract"
 	AttributeKeyRecipient       = "recipient"
@@ -21,6 +29,21 @@ const (
 	AttributeValueCategory       = ModuleName
 	AttributeKeyEthereumBloom    = "bloom"
 
+	AttributeKeyFork             = "fork"
+	AttributeKeyActivationHeight = "activation_height"
+	AttributeKeyActivationTime   = "activation_time"
+
+	AttributeKeyPermissionList = "permission_list"
+	AttributeKeyAddress        = "address"
+	AttributeKeyTarget         = "target"
+	AttributeKeyExpiresAt      = "expires_at"
+
+	AttributeKeySponsor          = "sponsor"
+	AttributeKeyMaxGasPerTx      = "max_gas_per_tx"
+	AttributeKeyBudget           = "budget"
+	AttributeKeyMaxFeePerGas     = "max_fee_per_gas"
+	AttributeKeyMaxFeesPerSender = "max_fees_per_sender"
+
 	MetricKeyTransitionDB = "transition_db"
 	MetricKeyStaticCall   = "static_call"
 )
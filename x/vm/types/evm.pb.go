@@ -57,6 +57,62 @@ func (AccessType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_d1129b8db63d55c7, []int{0}
 }
 
+// PermissionList defines the on-chain permission lists which complement the
+// AccessControl of the params
+type PermissionList int32
+
+const (
+	// PERMISSION_LIST_UNSPECIFIED is not a valid list
+	PermissionListUnspecified PermissionList = 0
+	// PERMISSION_LIST_CREATE_ALLOW allows the address to create contracts when
+	// the create AccessType is ACCESS_TYPE_PERMISSIONED
+	PermissionListCreateAllow PermissionList = 1
+	// PERMISSION_LIST_CREATE_DENY blocks the address from creating contracts
+	PermissionListCreateDeny PermissionList = 2
+	// PERMISSION_LIST_CALL_ALLOW allows the address to perform calls when the
+	// call AccessType is ACCESS_TYPE_PERMISSIONED
+	PermissionListCallAllow PermissionList = 3
+	// PERMISSION_LIST_CALL_DENY blocks the address from performing calls
+	PermissionListCallDeny PermissionList = 4
+	// PERMISSION_LIST_CONTRACT_CALL_ALLOW allows the address to call the target
+	// contract. A contract with unexpired entries in this list can only be called
+	// by them.
+	PermissionListContractCallAllow PermissionList = 5
+	// PERMISSION_LIST_SPONSOR allows the address to sponsor the fees of the
+	// transactions calling the target contract. It extends the permission lists
+	// for the sponsorships, whose sponsor must either be in this list or be the
+	// owner of the contract.
+	PermissionListSponsor PermissionList = 6
+)
+
+var PermissionList_name = map[int32]string{
+	0: "PERMISSION_LIST_UNSPECIFIED",
+	1: "PERMISSION_LIST_CREATE_ALLOW",
+	2: "PERMISSION_LIST_CREATE_DENY",
+	3: "PERMISSION_LIST_CALL_ALLOW",
+	4: "PERMISSION_LIST_CALL_DENY",
+	5: "PERMISSION_LIST_CONTRACT_CALL_ALLOW",
+	6: "PERMISSION_LIST_SPONSOR",
+}
+
+var PermissionList_value = map[string]int32{
+	"PERMISSION_LIST_UNSPECIFIED":         0,
+	"PERMISSION_LIST_CREATE_ALLOW":        1,
+	"PERMISSION_LIST_CREATE_DENY":         2,
+	"PERMISSION_LIST_CALL_ALLOW":          3,
+	"PERMISSION_LIST_CALL_DENY":           4,
+	"PERMISSION_LIST_CONTRACT_CALL_ALLOW": 5,
+	"PERMISSION_LIST_SPONSOR":             6,
+}
+
+func (x PermissionList) String() string {
+	return proto.EnumName(PermissionList_name, int32(x))
+}
+
+func (PermissionList) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d1129b8db63d55c7, []int{1}
+}
+
 // Params defines the EVM module parameters
 type Params struct {
 	// evm_denom represents the token denomination used to run the EVM state
@@ -1051,8 +1107,291 @@ func (m *EvmCoinInfo) GetDecimals() uint32 {
 	return 0
 }
 
+// ForkActivation defines the activation of an Ethereum hard fork of the
+// ChainConfig scheduled by governance. The fork is activated either at a block
+// height or at a block time.
+type ForkActivation struct {
+	// fork is the name of the fork: shanghai, cancun, prague or osaka
+	Fork string `protobuf:"bytes,1,opt,name=fork,proto3" json:"fork,omitempty"`
+	// height is the block height from which the fork is active, zero if the fork
+	// is activated at a block time
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the unix timestamp in seconds from which the fork is active, zero
+	// if the fork is activated at a block height. The forks activated at a block
+	// height are recorded with the time of that block.
+	Time uint64 `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (m *ForkActivation) Reset()         { *m = ForkActivation{} }
+func (m *ForkActivation) String() string { return proto.CompactTextString(m) }
+func (*ForkActivation) ProtoMessage()    {}
+func (*ForkActivation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1129b8db63d55c7, []int{13}
+}
+func (m *ForkActivation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForkActivation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForkActivation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForkActivation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForkActivation.Merge(m, src)
+}
+func (m *ForkActivation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForkActivation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForkActivation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForkActivation proto.InternalMessageInfo
+
+func (m *ForkActivation) GetFork() string {
+	if m != nil {
+		return m.Fork
+	}
+	return ""
+}
+
+func (m *ForkActivation) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ForkActivation) GetTime() uint64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+// PermissionEntry defines an entry of an on-chain permission list
+type PermissionEntry struct {
+	// list is the permission list of the entry
+	List PermissionList `protobuf:"varint,1,opt,name=list,proto3,enum=cosmos.evm.vm.v1.PermissionList" json:"list,omitempty"`
+	// address is the hex address the entry applies to, either as the signer of
+	// the transaction or as the caller
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// target is the hex address of the contract of a
+	// PERMISSION_LIST_CONTRACT_CALL_ALLOW or PERMISSION_LIST_SPONSOR entry, empty
+	// for the other lists
+	Target string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	// expires_at is the unix timestamp in seconds from which the entry is
+	// ignored, zero if the entry doesn't expire
+	ExpiresAt uint64 `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (m *PermissionEntry) Reset()         { *m = PermissionEntry{} }
+func (m *PermissionEntry) String() string { return proto.CompactTextString(m) }
+func (*PermissionEntry) ProtoMessage()    {}
+func (*PermissionEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1129b8db63d55c7, []int{14}
+}
+func (m *PermissionEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PermissionEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PermissionEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PermissionEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PermissionEntry.Merge(m, src)
+}
+func (m *PermissionEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *PermissionEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_PermissionEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PermissionEntry proto.InternalMessageInfo
+
+func (m *PermissionEntry) GetList() PermissionList {
+	if m != nil {
+		return m.List
+	}
+	return PermissionListUnspecified
+}
+
+func (m *PermissionEntry) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PermissionEntry) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *PermissionEntry) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+// Sponsorship defines a sponsor paying the fees of the Ethereum transactions
+// calling a contract, instead of their sender.
+type Sponsorship struct {
+	// sponsor is the hex address of the account paying the fees
+	Sponsor string `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// contract is the hex address of the sponsored contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// max_gas_per_tx is the maximum gas limit of a sponsored transaction
+	MaxGasPerTx uint64 `protobuf:"varint,3,opt,name=max_gas_per_tx,json=maxGasPerTx,proto3" json:"max_gas_per_tx,omitempty"`
+	// budget is the remaining amount of fees, in the 18 decimals EVM denom, that
+	// the sponsor pays for the transactions calling the contract
+	Budget cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=budget,proto3,customtype=cosmossdk.io/math.Int" json:"budget"`
+	// max_fee_per_gas is the maximum fee cap, in the 18 decimals EVM denom, of a
+	// sponsored transaction, which bounds the tip paid by the sponsor
+	MaxFeePerGas cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=max_fee_per_gas,json=maxFeePerGas,proto3,customtype=cosmossdk.io/math.Int" json:"max_fee_per_gas"`
+	// max_fees_per_sender is the maximum amount of fees, in the 18 decimals EVM
+	// denom, that the sponsor pays for the transactions of a sender. It is a
+	// lifetime cap of the sponsorship: the fees paid for a sender are only reset
+	// when the sponsorship is removed.
+	MaxFeesPerSender cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=max_fees_per_sender,json=maxFeesPerSender,proto3,customtype=cosmossdk.io/math.Int" json:"max_fees_per_sender"`
+}
+
+func (m *Sponsorship) Reset()         { *m = Sponsorship{} }
+func (m *Sponsorship) String() string { return proto.CompactTextString(m) }
+func (*Sponsorship) ProtoMessage()    {}
+func (*Sponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1129b8db63d55c7, []int{15}
+}
+func (m *Sponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Sponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Sponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Sponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Sponsorship.Merge(m, src)
+}
+func (m *Sponsorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *Sponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_Sponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Sponsorship proto.InternalMessageInfo
+
+func (m *Sponsorship) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *Sponsorship) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *Sponsorship) GetMaxGasPerTx() uint64 {
+	if m != nil {
+		return m.MaxGasPerTx
+	}
+	return 0
+}
+
+// SponsoredFees defines the fees paid by the sponsor of a contract for the
+// transactions of a sender since the sponsorship was set, which are bounded by
+// the max_fees_per_sender of the sponsorship.
+type SponsoredFees struct {
+	// contract is the hex address of the sponsored contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// sender is the hex address of the sender of the transactions
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// fees is the amount of fees, in the 18 decimals EVM denom, paid by the
+	// sponsor for the transactions of the sender
+	Fees cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=fees,proto3,customtype=cosmossdk.io/math.Int" json:"fees"`
+}
+
+func (m *SponsoredFees) Reset()         { *m = SponsoredFees{} }
+func (m *SponsoredFees) String() string { return proto.CompactTextString(m) }
+func (*SponsoredFees) ProtoMessage()    {}
+func (*SponsoredFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1129b8db63d55c7, []int{16}
+}
+func (m *SponsoredFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SponsoredFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SponsoredFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SponsoredFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SponsoredFees.Merge(m, src)
+}
+func (m *SponsoredFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *SponsoredFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_SponsoredFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SponsoredFees proto.InternalMessageInfo
+
+func (m *SponsoredFees) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *SponsoredFees) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.evm.vm.v1.AccessType", AccessType_name, AccessType_value)
+	proto.RegisterEnum("cosmos.evm.vm.v1.PermissionList", PermissionList_name, PermissionList_value)
 	proto.RegisterType((*Params)(nil), "cosmos.evm.vm.v1.Params")
 	proto.RegisterType((*ExtendedDenomOptions)(nil), "cosmos.evm.vm.v1.ExtendedDenomOptions")
 	proto.RegisterType((*AccessControl)(nil), "cosmos.evm.vm.v1.AccessControl")
@@ -1066,144 +1405,176 @@ func init() {
 	proto.RegisterType((*TraceConfig)(nil), "cosmos.evm.vm.v1.TraceConfig")
 	proto.RegisterType((*Preinstall)(nil), "cosmos.evm.vm.v1.Preinstall")
 	proto.RegisterType((*EvmCoinInfo)(nil), "cosmos.evm.vm.v1.EvmCoinInfo")
+	proto.RegisterType((*ForkActivation)(nil), "cosmos.evm.vm.v1.ForkActivation")
+	proto.RegisterType((*PermissionEntry)(nil), "cosmos.evm.vm.v1.PermissionEntry")
+	proto.RegisterType((*Sponsorship)(nil), "cosmos.evm.vm.v1.Sponsorship")
+	proto.RegisterType((*SponsoredFees)(nil), "cosmos.evm.vm.v1.SponsoredFees")
 }
 
 func init() { proto.RegisterFile("cosmos/evm/vm/v1/evm.proto", fileDescriptor_d1129b8db63d55c7) }
 
 var fileDescriptor_d1129b8db63d55c7 = []byte{
-	// 2111 bytes of a gzipped FileDescriptorProto
+	// 2547 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0x4d, 0x6f, 0x1b, 0xc7,
-	0x19, 0x16, 0xc5, 0x95, 0xb4, 0x1c, 0x52, 0xd4, 0x7a, 0x44, 0xcb, 0x34, 0xed, 0x68, 0xd5, 0x4d,
-	0x5b, 0xa8, 0x46, 0x2a, 0x59, 0x72, 0xd4, 0x1a, 0x4e, 0xd3, 0x42, 0x94, 0x98, 0x56, 0xaa, 0x6c,
-	0x0b, 0x43, 0x35, 0x46, 0x8a, 0x14, 0x8b, 0xe1, 0xee, 0x78, 0xb9, 0xd1, 0xee, 0x0e, 0xb1, 0xb3,
-	0xa4, 0xc5, 0xfe, 0x81, 0x06, 0xee, 0x25, 0xed, 0xdd, 0x40, 0x80, 0x5e, 0x72, 0xcc, 0x4f, 0xe8,
-	0x31, 0xc7, 0x1c, 0x8b, 0x02, 0x5d, 0x14, 0xf4, 0x21, 0x80, 0x8e, 0xfa, 0x05, 0xc5, 0x7c, 0xf0,
-	0x5b, 0x61, 0x15, 0x40, 0xb0, 0xe7, 0x79, 0x3f, 0x9e, 0x67, 0x3e, 0xde, 0xdd, 0x79, 0x97, 0xa0,
-	0xe2, 0x50, 0x16, 0x52, 0xb6, 0x4d, 0x3a, 0xe1, 0x36, 0xff, 0xdb, 0xe1, 0xa3, 0xad, 0x56, 0x4c,
-	0x13, 0x0a, 0x0d, 0xe9, 0xdb, 0xe2, 0x16, 0xfe, 0xb7, 0x53, 0xb9, 0x85, 0x43, 0x3f, 0xa2, 0xdb,
-	0xe2, 0x5f, 0x19, 0x54, 0x29, 0x79, 0xd4, 0xa3, 0x62, 0xb8, 0xcd, 0x47, 0xd2, 0x6a, 0xfd, 0x5d,
-	0x03, 0x8b, 0xa7, 0x38, 0xc6, 0x21, 0x83, 0x3b, 0x20, 0x47, 0x3a, 0xa1, 0xed, 0x92, 0x88, 0x86,
-	0xe5, 0xcc, 0x46, 0x66, 0x33, 0x57, 0x2d, 0x5d, 0xa5, 0xa6, 0xd1, 0xc5, 0x61, 0xf0, 0xc4, 0x1a,
-	0xb8, 0x2c, 0xa4, 0x93, 0x4e, 0x78, 0xc8, 0x87, 0x70, 0x1f, 0x00, 0x72, 0x91, 0xc4, 0xd8, 0x26,
-	0x7e, 0x8b, 0x95, 0xb5, 0x8d, 0xec, 0x66, 0xb6, 0x6a, 0xf5, 0x52, 0x33, 0x57, 0xe3, 0xd6, 0xda,
-	0xd1, 0x29, 0xbb, 0x4a, 0xcd, 0x5b, 0x8a, 0x60, 0x10, 0x68, 0xa1, 0x9c, 0x00, 0x35, 0xbf, 0xc5,
-	0xe0, 0x2e, 0x28, 0x70, 0x6a, 0xa7, 0x89, 0xa3, 0x88, 0x04, 0xac, 0xbc, 0xb4, 0x91, 0xdd, 0xcc,
-	0x55, 0x57, 0x7a, 0xa9, 0x99, 0xaf, 0x7d, 0xfc, 0xf4, 0x40, 0x99, 0x51, 0x9e, 0x74, 0xc2, 0x3e,
-	0x80, 0x7f, 0x02, 0x45, 0xec, 0x38, 0x84, 0x31, 0xdb, 0xa1, 0x51, 0x12, 0xd3, 0xa0, 0xac, 0x6f,
-	0x64, 0x36, 0xf3, 0xbb, 0xe6, 0xd6, 0xe4, 0x46, 0x6c, 0xed, 0x8b, 0xb8, 0x03, 0x19, 0x56, 0xbd,
-	0xfd, 0x4d, 0x6a, 0xce, 0xf5, 0x52, 0x73, 0x79, 0xcc, 0x8c, 0x96, 0xf1, 0x28, 0x84, 0x4f, 0xc0,
-	0x5d, 0xec, 0x24, 0x7e, 0x87, 0xd8, 0x2c, 0xc1, 0x89, 0xef, 0xd8, 0xad, 0x98, 0x38, 0x34, 0x6c,
-	0xf9, 0x01, 0x61, 0xe5, 0x1c, 0x9f, 0x1f, 0xba, 0x23, 0x03, 0xea, 0xc2, 0x7f, 0x3a, 0x74, 0xc3,
-	0x87, 0xa0, 0xd4, 0xf4, 0x59, 0x42, 0xe3, 0xae, 0xcd, 0x48, 0xdc, 0x21, 0xf6, 0x2b, 0x3f, 0x72,
-	0xe9, 0xab, 0x32, 0xd8, 0xc8, 0x6c, 0x6a, 0x08, 0x2a, 0x5f, 0x9d, 0xbb, 0x5e, 0x08, 0x0f, 0xfc,
-	0x14, 0xac, 0x91, 0x8b, 0x84, 0x44, 0x2e, 0x71, 0xe5, 0x06, 0xdb, 0xb4, 0x95, 0xf8, 0x34, 0x62,
-	0xe5, 0xbc, 0x58, 0xd4, 0x4f, 0xa7, 0x17, 0x55, 0x53, 0xf1, 0xe2, 0x10, 0x9e, 0xcb, 0x68, 0x54,
-	0x22, 0xd7, 0x58, 0x9f, 0xdc, 0x7b, 0xfd, 0xdd, 0xd7, 0x0f, 0xd6, 0x46, 0x6a, 0xe7, 0x82, 0x57,
-	0x8f, 0x3c, 0xf1, 0x63, 0x4d, 0x9f, 0x37, 0xb2, 0xc7, 0x9a, 0x9e, 0x35, 0xb4, 0x63, 0x4d, 0x5f,
-	0x30, 0x16, 0x8f, 0x35, 0x7d, 0xd1, 0x58, 0xb2, 0x3e, 0x04, 0xa5, 0xeb, 0x24, 0xe0, 0x4f, 0x40,
-	0x71, 0x7c, 0xaa, 0xb2, 0x4c, 0xd0, 0xf2, 0x98, 0xb4, 0xf5, 0xb7, 0x0c, 0x18, 0xdf, 0x60, 0xb8,
-	0x0f, 0x16, 0x9d, 0x98, 0xe0, 0x84, 0x88, 0x84, 0xfc, 0xee, 0xbb, 0xff, 0xe7, 0xa0, 0xce, 0xba,
-	0x2d, 0x52, 0xd5, 0xf8, 0x61, 0x21, 0x95, 0x08, 0x3f, 0x04, 0x9a, 0x83, 0x83, 0xa0, 0x3c, 0xff,
-	0x43, 0x09, 0x44, 0x9a, 0xf5, 0x9f, 0x0c, 0xb8, 0x35, 0x15, 0x01, 0x1d, 0x90, 0x57, 0x85, 0x94,
-	0x74, 0x5b, 0x72, 0x72, 0xc5, 0xdd, 0xfb, 0xdf, 0xc7, 0x2d, 0x48, 0x7f, 0xdc, 0x4b, 0x4d, 0x30,
-	0xc4, 0x57, 0xa9, 0x09, 0x65, 0x7d, 0x8f, 0x10, 0x59, 0x08, 0xe0, 0x41, 0x04, 0x74, 0xc0, 0xea,
-	0x78, 0xb5, 0xda, 0x81, 0xcf, 0x92, 0xf2, 0xbc, 0x28, 0xf4, 0x47, 0xbd, 0xd4, 0x1c, 0x9f, 0xd8,
-	0x89, 0xcf, 0x92, 0xab, 0xd4, 0xac, 0x8c, 0xb1, 0x8e, 0x66, 0x5a, 0xe8, 0x16, 0x9e, 0x4c, 0xb0,
-	0xbe, 0x32, 0x40, 0xfe, 0xa0, 0x89, 0xfd, 0xe8, 0x80, 0x46, 0x2f, 0x7d, 0x0f, 0x7e, 0x0a, 0x56,
-	0x9a, 0x34, 0x24, 0x2c, 0x21, 0xd8, 0xb5, 0x1b, 0x01, 0x75, 0xce, 0xd5, 0x23, 0xfd, 0xe8, 0xdf,
-	0xa9, 0x79, 0x5b, 0x2e, 0x90, 0xb9, 0xe7, 0x5b, 0x3e, 0xdd, 0x0e, 0x71, 0xd2, 0xdc, 0x3a, 0x8a,
-	0xb8, 0xe8, 0x9a, 0x14, 0x9d, 0xc8, 0xb4, 0x50, 0x71, 0x60, 0xa9, 0x72, 0x03, 0x6c, 0x82, 0xa2,
-	0x8b, 0xa9, 0xfd, 0x92, 0xc6, 0xe7, 0x8a, 0x7c, 0x5e, 0x90, 0x57, 0xbf, 0x97, 0xbc, 0x97, 0x9a,
-	0x85, 0xc3, 0xfd, 0xe7, 0x1f, 0xd1, 0xf8, 0x5c, 0x50, 0x5c, 0xa5, 0xe6, 0x6d, 0x29, 0x36, 0x4e,
-	0x64, 0xa1, 0x82, 0x8b, 0xe9, 0x20, 0x0c, 0xbe, 0x00, 0xc6, 0x20, 0x80, 0xb5, 0x5b, 0x2d, 0x1a,
-	0x27, 0xe5, 0xec, 0x46, 0x66, 0x53, 0xaf, 0xfe, 0xbc, 0x97, 0x9a, 0x45, 0x45, 0x59, 0x97, 0x9e,
-	0xab, 0xd4, 0xbc, 0x33, 0x41, 0xaa, 0x72, 0x2c, 0x54, 0x54, 0xb4, 0x2a, 0x14, 0x36, 0x40, 0x81,
-	0xf8, 0xad, 0x9d, 0xbd, 0x87, 0x6a, 0x01, 0x9a, 0x58, 0xc0, 0x6f, 0x66, 0x2d, 0x20, 0x5f, 0x3b,
-	0x3a, 0xdd, 0xd9, 0x7b, 0xd8, 0x9f, 0xff, 0xaa, 0x7a, 0xaf, 0x8d, 0xb0, 0x58, 0x28, 0x2f, 0xa1,
-	0x9c, 0x7c, 0x5f, 0x63, 0x4f, 0x69, 0x2c, 0xde, 0x54, 0x63, 0xef, 0x3a, 0x8d, 0xbd, 0x71, 0x8d,
-	0xbd, 0x71, 0x8d, 0xc7, 0x4a, 0x63, 0xe9, 0xa6, 0x1a, 0x8f, 0xaf, 0xd3, 0x78, 0x3c, 0xae, 0x21,
-	0x63, 0x78, 0x31, 0x35, 0xba, 0x7f, 0xc6, 0x51, 0xe2, 0xb7, 0x43, 0x25, 0xa3, 0xdf, 0xb8, 0x98,
-	0x26, 0x32, 0x2d, 0x54, 0x1c, 0x58, 0x24, 0xfb, 0x39, 0x28, 0x39, 0x34, 0x62, 0x09, 0xb7, 0x45,
-	0xb4, 0x15, 0x10, 0x25, 0x91, 0x13, 0x12, 0x8f, 0x67, 0x49, 0xdc, 0x93, 0x12, 0xd7, 0xa5, 0x5b,
-	0x68, 0x75, 0xdc, 0x2c, 0xc5, 0x6c, 0x60, 0xb4, 0x48, 0x42, 0x62, 0xd6, 0x68, 0xc7, 0x9e, 0x12,
-	0x02, 0x42, 0xe8, 0xfd, 0x59, 0x42, 0xaa, 0xac, 0x26, 0x53, 0x2d, 0xb4, 0x32, 0x34, 0x49, 0x81,
-	0x4f, 0x40, 0xd1, 0xe7, 0xaa, 0x8d, 0x76, 0xa0, 0xe8, 0xf3, 0x82, 0x7e, 0x77, 0x16, 0xbd, 0x7a,
-	0x14, 0xc6, 0x13, 0x2d, 0xb4, 0xdc, 0x37, 0x48, 0x6a, 0x17, 0xc0, 0xb0, 0xed, 0xc7, 0xb6, 0x17,
-	0x60, 0xc7, 0x27, 0xb1, 0xa2, 0x2f, 0x08, 0xfa, 0x5f, 0xcc, 0xa2, 0xbf, 0x2b, 0xe9, 0xa7, 0x93,
-	0x2d, 0x64, 0x70, 0xe3, 0x6f, 0xa5, 0x4d, 0xaa, 0xd4, 0x41, 0xa1, 0x41, 0xe2, 0xc0, 0x8f, 0x14,
-	0xff, 0xb2, 0xe0, 0x7f, 0x38, 0x8b, 0x5f, 0x55, 0xd0, 0x68, 0x9a, 0x85, 0xf2, 0x12, 0x0e, 0x48,
-	0x03, 0x1a, 0xb9, 0xb4, 0x4f, 0x7a, 0xeb, 0xc6, 0xa4, 0xa3, 0x69, 0x16, 0xca, 0x4b, 0x28, 0x49,
-	0x3d, 0xb0, 0x8a, 0xe3, 0x98, 0xbe, 0x9a, 0xd8, 0x10, 0x28, 0xb8, 0x7f, 0x39, 0x8b, 0xbb, 0xff,
-	0x72, 0x9d, 0xce, 0xe6, 0x2f, 0x57, 0x6e, 0x1d, 0xdb, 0x12, 0x17, 0x40, 0x2f, 0xc6, 0xdd, 0x09,
-	0x9d, 0xd2, 0x8d, 0x37, 0x7e, 0x3a, 0xd9, 0x42, 0x06, 0x37, 0x8e, 0xa9, 0x7c, 0x06, 0x4a, 0x21,
-	0x89, 0x3d, 0x62, 0x47, 0x24, 0x61, 0xad, 0xc0, 0x4f, 0x94, 0xce, 0xed, 0x1b, 0x3f, 0x07, 0xd7,
-	0xa5, 0x5b, 0x08, 0x0a, 0xf3, 0x33, 0x65, 0x95, 0x5a, 0x77, 0x81, 0xee, 0xf0, 0xdb, 0xc2, 0xf6,
-	0xdd, 0x72, 0x59, 0xb4, 0x26, 0x4b, 0x02, 0x1f, 0xb9, 0xb0, 0x04, 0x16, 0xe4, 0xdd, 0x7e, 0x57,
-	0xdc, 0xed, 0x12, 0xc0, 0x0a, 0xd0, 0x5d, 0xe2, 0xf8, 0x21, 0x0e, 0x58, 0xb9, 0x22, 0x12, 0x06,
-	0x18, 0x7e, 0x0c, 0x96, 0x59, 0x13, 0x47, 0x5e, 0x13, 0xfb, 0x76, 0xe2, 0x87, 0xa4, 0x7c, 0x4f,
-	0xcc, 0x78, 0x67, 0xd6, 0x8c, 0x4b, 0x72, 0xc6, 0x63, 0x79, 0x16, 0x2a, 0xf4, 0xf1, 0x99, 0x1f,
-	0x12, 0x78, 0x0a, 0xf2, 0x0e, 0x8e, 0x9c, 0x76, 0x24, 0x59, 0xef, 0x0b, 0xd6, 0xed, 0x59, 0xac,
-	0xea, 0x2a, 0x1e, 0xc9, 0xb2, 0x10, 0x90, 0xa8, 0xcf, 0xd8, 0x8a, 0xb1, 0xd7, 0x26, 0x92, 0xf1,
-	0x9d, 0x1b, 0x33, 0x8e, 0x64, 0x59, 0x08, 0x48, 0xd4, 0x67, 0xec, 0x90, 0xf8, 0x3c, 0x50, 0x8c,
-	0xeb, 0x37, 0x66, 0x1c, 0xc9, 0xb2, 0x10, 0x90, 0x48, 0x30, 0x3e, 0x05, 0x80, 0x32, 0x7c, 0x8e,
-	0x25, 0xa1, 0x29, 0x08, 0xb7, 0x66, 0x11, 0xaa, 0xfe, 0x7a, 0x98, 0x64, 0xa1, 0x9c, 0x00, 0x9c,
-	0x6e, 0xd0, 0xd7, 0xad, 0x19, 0x77, 0x8e, 0x35, 0xfd, 0x8e, 0x51, 0xb6, 0xb6, 0xc1, 0x02, 0xef,
-	0x5b, 0x09, 0x34, 0x40, 0xf6, 0x9c, 0x74, 0x55, 0x0f, 0xc7, 0x87, 0xfc, 0xec, 0x3b, 0x38, 0x68,
-	0x13, 0x79, 0x9d, 0x23, 0x09, 0xac, 0x53, 0xb0, 0x72, 0x16, 0xe3, 0x88, 0xf1, 0x9e, 0x97, 0x46,
-	0x27, 0xd4, 0x63, 0x10, 0x02, 0xad, 0x89, 0x59, 0x53, 0xe5, 0x8a, 0x31, 0xfc, 0x19, 0xd0, 0x02,
-	0xea, 0x31, 0xd1, 0xd8, 0xe4, 0x77, 0x6f, 0x4f, 0x77, 0x51, 0x27, 0xd4, 0x43, 0x22, 0xc4, 0xfa,
-	0x4b, 0x16, 0x64, 0x4f, 0xa8, 0x07, 0xcb, 0x60, 0x09, 0xbb, 0x6e, 0x4c, 0x18, 0x53, 0x4c, 0x7d,
-	0x08, 0xd7, 0xc0, 0x62, 0x42, 0x5b, 0xbe, 0x23, 0xe9, 0x72, 0x48, 0x21, 0x2e, 0xec, 0xe2, 0x04,
-	0x8b, 0x1e, 0xa0, 0x80, 0xc4, 0x98, 0x7f, 0x42, 0x88, 0x52, 0xb7, 0xa3, 0x76, 0xd8, 0x20, 0xb1,
-	0xb8, 0xca, 0xb5, 0xea, 0xca, 0x65, 0x6a, 0xe6, 0x85, 0xfd, 0x99, 0x30, 0xa3, 0x51, 0x00, 0xdf,
-	0x03, 0x4b, 0xc9, 0x85, 0x2d, 0xd6, 0xb0, 0x20, 0xb6, 0x78, 0xf5, 0x32, 0x35, 0x57, 0x92, 0xe1,
-	0x32, 0x7f, 0x87, 0x59, 0x13, 0x2d, 0x26, 0x17, 0xfc, 0x7f, 0xb8, 0x0d, 0xf4, 0xe4, 0xc2, 0xf6,
-	0x23, 0x97, 0x5c, 0x88, 0x4b, 0x5c, 0xab, 0x96, 0x2e, 0x53, 0xd3, 0x18, 0x09, 0x3f, 0xe2, 0x3e,
-	0xb4, 0x94, 0x5c, 0x88, 0x01, 0x7c, 0x0f, 0x00, 0x39, 0x25, 0xa1, 0x20, 0xef, 0xe4, 0xe5, 0xcb,
-	0xd4, 0xcc, 0x09, 0xab, 0xe0, 0x1e, 0x0e, 0xa1, 0x05, 0x16, 0x24, 0xb7, 0x2e, 0xb8, 0x0b, 0x97,
-	0xa9, 0xa9, 0x07, 0xd4, 0x93, 0x9c, 0xd2, 0xc5, 0xb7, 0x2a, 0x26, 0x21, 0xed, 0x10, 0x57, 0x5c,
-	0x8c, 0x3a, 0xea, 0x43, 0xf8, 0x01, 0x58, 0x91, 0x5a, 0xfc, 0xec, 0x59, 0x82, 0xc3, 0x96, 0xfc,
-	0xda, 0xa8, 0xc2, 0xcb, 0xd4, 0x2c, 0x0a, 0xd7, 0x59, 0xdf, 0x83, 0x26, 0xb0, 0xf5, 0xc5, 0x3c,
-	0xd0, 0xcf, 0x2e, 0x10, 0x61, 0xed, 0x20, 0x81, 0x1f, 0x01, 0x43, 0x34, 0x9a, 0xd8, 0x49, 0xec,
-	0xb1, 0x73, 0xa9, 0xde, 0x1b, 0xde, 0x81, 0x93, 0x11, 0x16, 0x5a, 0xe9, 0x9b, 0xf6, 0xd5, 0xe1,
-	0x95, 0xc0, 0x42, 0x23, 0xa0, 0x34, 0x14, 0x65, 0x54, 0x40, 0x12, 0xc0, 0x17, 0x62, 0xcb, 0x45,
-	0x89, 0x64, 0x45, 0x13, 0xff, 0xa3, 0xe9, 0x12, 0x99, 0xa8, 0xb3, 0xea, 0x3d, 0xde, 0xc2, 0x5f,
-	0xa5, 0x66, 0x51, 0x6a, 0xab, 0x7c, 0xeb, 0xab, 0xef, 0xbe, 0x7e, 0x90, 0xe1, 0xa7, 0x23, 0x8a,
-	0xd1, 0x00, 0xd9, 0x98, 0x24, 0xe2, 0xd8, 0x0b, 0x88, 0x0f, 0xf9, 0xdb, 0x2a, 0x26, 0x1d, 0x12,
-	0x27, 0xc4, 0x15, 0xc7, 0xab, 0xa3, 0x01, 0xe6, 0xaf, 0x3e, 0x0f, 0x33, 0xbb, 0xcd, 0x88, 0x2b,
-	0xcf, 0x12, 0x2d, 0x79, 0x98, 0xfd, 0x81, 0x11, 0xf7, 0x89, 0xf6, 0xf9, 0x97, 0xe6, 0x9c, 0x85,
-	0x41, 0x5e, 0xf5, 0xf7, 0xed, 0x56, 0x40, 0x66, 0xd4, 0xe8, 0x2e, 0x28, 0xf0, 0xaf, 0x39, 0xec,
-	0x11, 0xfb, 0x9c, 0x74, 0x55, 0xa5, 0xca, 0xba, 0x53, 0xf6, 0xdf, 0x93, 0x2e, 0x43, 0xa3, 0x40,
-	0x49, 0x7c, 0xa9, 0x81, 0xfc, 0x59, 0x8c, 0x1d, 0xa2, 0xba, 0x75, 0x5e, 0xed, 0x1c, 0xc6, 0x4a,
-	0x42, 0x21, 0xae, 0xcd, 0x0f, 0x95, 0xb6, 0x13, 0xf5, 0x44, 0xf6, 0x21, 0xcf, 0x88, 0x09, 0xb9,
-	0x20, 0x8e, 0xd8, 0x4b, 0x0d, 0x29, 0x04, 0xf7, 0xc0, 0xb2, 0xeb, 0x33, 0xdc, 0x08, 0xc4, 0xc7,
-	0xab, 0x73, 0x2e, 0x97, 0x5f, 0x35, 0x2e, 0x53, 0xb3, 0xa0, 0x1c, 0x75, 0x6e, 0x47, 0x63, 0x88,
-	0xd7, 0xd0, 0x30, 0x4d, 0xcc, 0x56, 0xec, 0x8d, 0x2e, 0x6b, 0x68, 0x10, 0x2a, 0x3c, 0x68, 0x02,
-	0xcb, 0x1b, 0xa3, 0xd1, 0xf6, 0x44, 0xf9, 0xea, 0x48, 0x02, 0x6e, 0x0d, 0xfc, 0xd0, 0x4f, 0x44,
-	0xb9, 0x2e, 0x20, 0x09, 0xe0, 0x07, 0x20, 0x47, 0x3b, 0x24, 0x8e, 0x7d, 0x97, 0x30, 0x51, 0xa6,
-	0xf9, 0xdd, 0x77, 0xa6, 0xcb, 0x60, 0xe4, 0x4b, 0x06, 0x0d, 0xe3, 0xf9, 0xe2, 0x48, 0x24, 0x26,
-	0x19, 0x92, 0x90, 0xc6, 0x5d, 0xd1, 0x5a, 0xa9, 0xc5, 0x49, 0xc7, 0x53, 0x61, 0x47, 0x63, 0x08,
-	0x56, 0x01, 0x54, 0x69, 0x31, 0x49, 0xda, 0x71, 0x64, 0x8b, 0x37, 0x48, 0x41, 0xe4, 0x8a, 0xe7,
-	0x58, 0x7a, 0x91, 0x70, 0x1e, 0xe2, 0x04, 0xa3, 0x29, 0x0b, 0xfc, 0x35, 0x80, 0xf2, 0x4c, 0xec,
-	0xcf, 0x18, 0x8d, 0xf8, 0xf7, 0xd8, 0x4b, 0xdf, 0x53, 0xbd, 0x91, 0xd0, 0x97, 0x5e, 0x35, 0x67,
-	0x43, 0xa2, 0x63, 0x46, 0xd5, 0x2a, 0x8e, 0x35, 0x5d, 0x33, 0x16, 0x8e, 0x35, 0x7d, 0xc9, 0xd0,
-	0x07, 0xfb, 0xa7, 0x56, 0x81, 0x56, 0xfb, 0x78, 0x64, 0x7a, 0xd6, 0x33, 0x00, 0x4e, 0x63, 0xe2,
-	0xf3, 0x0e, 0x36, 0x08, 0xf8, 0x6b, 0x2f, 0xc2, 0x21, 0xe9, 0xbf, 0x6f, 0xf9, 0x78, 0xb4, 0x30,
-	0xe7, 0xc7, 0x0b, 0x13, 0x02, 0xcd, 0xa1, 0x2e, 0x11, 0xa5, 0x91, 0x43, 0x62, 0x6c, 0xfd, 0x35,
-	0x03, 0xf2, 0xb5, 0x4e, 0x78, 0x40, 0xfd, 0xe8, 0x28, 0x7a, 0x49, 0x87, 0xd7, 0x7c, 0x66, 0xf4,
-	0x9a, 0x9f, 0xfe, 0xc2, 0x9f, 0xbf, 0xe6, 0x0b, 0x1f, 0xbe, 0x2b, 0xaa, 0xac, 0x15, 0xe0, 0xae,
-	0x8a, 0x92, 0x4a, 0x05, 0x65, 0x3c, 0x9c, 0x6a, 0x19, 0xf8, 0xb3, 0xb9, 0x3c, 0x6c, 0x19, 0x1e,
-	0xfc, 0x33, 0x03, 0x46, 0x3e, 0xa2, 0xe1, 0xaf, 0x40, 0x65, 0xff, 0xe0, 0xa0, 0x56, 0xaf, 0xdb,
-	0x67, 0x9f, 0x9c, 0xd6, 0xec, 0xd3, 0x1a, 0x7a, 0x7a, 0x54, 0xaf, 0x1f, 0x3d, 0x7f, 0x76, 0x52,
-	0xab, 0xd7, 0x8d, 0xb9, 0xca, 0xfd, 0xd7, 0x6f, 0x36, 0xca, 0xc3, 0xf8, 0x53, 0x12, 0x87, 0x3e,
-	0x63, 0x3e, 0x8d, 0x02, 0xbe, 0xdc, 0xf7, 0xc1, 0xda, 0x68, 0x36, 0xaa, 0xd5, 0xcf, 0xd0, 0xd1,
-	0xc1, 0x59, 0xed, 0xd0, 0xc8, 0x54, 0xca, 0xaf, 0xdf, 0x6c, 0x94, 0x86, 0x99, 0x88, 0xb0, 0x24,
-	0xf6, 0x1d, 0xfe, 0x1e, 0x78, 0x0c, 0xca, 0xd7, 0x6b, 0xd6, 0x0e, 0x8d, 0xf9, 0x4a, 0xe5, 0xf5,
-	0x9b, 0x8d, 0xb5, 0xeb, 0x14, 0x89, 0x5b, 0xd1, 0x3e, 0xff, 0xc7, 0xfa, 0x5c, 0xf5, 0xc9, 0x37,
-	0xbd, 0xf5, 0xcc, 0xb7, 0xbd, 0xf5, 0xcc, 0x7f, 0x7b, 0xeb, 0x99, 0x2f, 0xde, 0xae, 0xcf, 0x7d,
-	0xfb, 0x76, 0x7d, 0xee, 0x5f, 0x6f, 0xd7, 0xe7, 0xfe, 0xb8, 0xe1, 0xf9, 0x49, 0xb3, 0xdd, 0xd8,
-	0x72, 0x68, 0xb8, 0x3d, 0xf9, 0xcb, 0x4b, 0xd2, 0x6d, 0x11, 0xd6, 0x58, 0x14, 0x3f, 0xbe, 0x3d,
-	0xfa, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x48, 0x59, 0x0f, 0x70, 0xd5, 0x13, 0x00, 0x00,
+	0x19, 0x16, 0xc5, 0x95, 0x44, 0x0e, 0x29, 0x6a, 0x3d, 0x92, 0x65, 0x8a, 0xb2, 0xb5, 0xec, 0xba,
+	0x2d, 0x5c, 0x23, 0x95, 0x2c, 0x25, 0x4a, 0x5d, 0xa7, 0x49, 0x40, 0x4a, 0x74, 0x2a, 0x55, 0x96,
+	0x88, 0x21, 0x13, 0x23, 0x45, 0x8a, 0xc5, 0x70, 0x77, 0x4c, 0x6e, 0xb4, 0xbb, 0x43, 0xec, 0x2c,
+	0x69, 0xb2, 0x7f, 0xa0, 0x81, 0x7a, 0x49, 0x7b, 0xea, 0x45, 0x40, 0x80, 0x5e, 0x72, 0x0c, 0xd0,
+	0x3f, 0xd0, 0x63, 0x8e, 0x39, 0xf4, 0x50, 0x14, 0x28, 0x51, 0x28, 0x87, 0x00, 0x3a, 0xea, 0x17,
+	0x14, 0xf3, 0xc1, 0x6f, 0x59, 0x55, 0x01, 0xc1, 0x9e, 0xf7, 0x9d, 0xf7, 0x79, 0x9e, 0x99, 0x77,
+	0xde, 0x9d, 0x0f, 0x82, 0x9c, 0x4d, 0x99, 0x4f, 0xd9, 0x16, 0x69, 0xfb, 0x5b, 0xfc, 0x6f, 0x9b,
+	0xb7, 0x36, 0x9b, 0x21, 0x8d, 0x28, 0xd4, 0x65, 0xdf, 0x26, 0xf7, 0xf0, 0xbf, 0xed, 0xdc, 0x1d,
+	0xec, 0xbb, 0x01, 0xdd, 0x12, 0xff, 0xca, 0xa0, 0xdc, 0x4a, 0x9d, 0xd6, 0xa9, 0x68, 0x6e, 0xf1,
+	0x96, 0xf4, 0x9a, 0x7f, 0xd6, 0xc0, 0x7c, 0x19, 0x87, 0xd8, 0x67, 0x70, 0x1b, 0x24, 0x49, 0xdb,
+	0xb7, 0x1c, 0x12, 0x50, 0x3f, 0x1b, 0xcb, 0xc7, 0x1e, 0x25, 0x8b, 0x2b, 0x57, 0x3d, 0x43, 0xef,
+	0x62, 0xdf, 0x7b, 0x66, 0x0e, 0xba, 0x4c, 0x94, 0x20, 0x6d, 0x7f, 0x9f, 0x37, 0x61, 0x01, 0x00,
+	0xd2, 0x89, 0x42, 0x6c, 0x11, 0xb7, 0xc9, 0xb2, 0x5a, 0x3e, 0xfe, 0x28, 0x5e, 0x34, 0x2f, 0x7a,
+	0x46, 0xb2, 0xc4, 0xbd, 0xa5, 0x83, 0x32, 0xbb, 0xea, 0x19, 0x77, 0x14, 0xc1, 0x20, 0xd0, 0x44,
+	0x49, 0x61, 0x94, 0xdc, 0x26, 0x83, 0x3b, 0x20, 0xcd, 0xa9, 0xed, 0x06, 0x0e, 0x02, 0xe2, 0xb1,
+	0xec, 0x42, 0x3e, 0xfe, 0x28, 0x59, 0x5c, 0xba, 0xe8, 0x19, 0xa9, 0xd2, 0x27, 0x2f, 0xf6, 0x94,
+	0x1b, 0xa5, 0x48, 0xdb, 0xef, 0x1b, 0xf0, 0x77, 0x20, 0x83, 0x6d, 0x9b, 0x30, 0x66, 0xd9, 0x34,
+	0x88, 0x42, 0xea, 0x65, 0x13, 0xf9, 0xd8, 0xa3, 0xd4, 0x8e, 0xb1, 0x39, 0x99, 0x88, 0xcd, 0x82,
+	0x88, 0xdb, 0x93, 0x61, 0xc5, 0xbb, 0xdf, 0xf6, 0x8c, 0x99, 0x8b, 0x9e, 0xb1, 0x38, 0xe6, 0x46,
+	0x8b, 0x78, 0xd4, 0x84, 0xcf, 0xc0, 0x1a, 0xb6, 0x23, 0xb7, 0x4d, 0x2c, 0x16, 0xe1, 0xc8, 0xb5,
+	0xad, 0x66, 0x48, 0x6c, 0xea, 0x37, 0x5d, 0x8f, 0xb0, 0x6c, 0x92, 0x8f, 0x0f, 0xdd, 0x93, 0x01,
+	0x15, 0xd1, 0x5f, 0x1e, 0x76, 0xc3, 0x27, 0x60, 0xa5, 0xe1, 0xb2, 0x88, 0x86, 0x5d, 0x8b, 0x91,
+	0xb0, 0x4d, 0xac, 0xd7, 0x6e, 0xe0, 0xd0, 0xd7, 0x59, 0x90, 0x8f, 0x3d, 0xd2, 0x10, 0x54, 0x7d,
+	0x15, 0xde, 0xf5, 0x52, 0xf4, 0xc0, 0xcf, 0xc0, 0x2a, 0xe9, 0x44, 0x24, 0x70, 0x88, 0x23, 0x13,
+	0x6c, 0xd1, 0x66, 0xe4, 0xd2, 0x80, 0x65, 0x53, 0x62, 0x52, 0x3f, 0x9d, 0x9e, 0x54, 0x49, 0xc5,
+	0x8b, 0x45, 0x38, 0x91, 0xd1, 0x68, 0x85, 0x5c, 0xe3, 0x7d, 0xb6, 0x7e, 0xf6, 0xc3, 0x37, 0x8f,
+	0x57, 0x47, 0x6a, 0xa7, 0xc3, 0xab, 0x47, 0xae, 0xf8, 0xa1, 0x96, 0x98, 0xd5, 0xe3, 0x87, 0x5a,
+	0x22, 0xae, 0x6b, 0x87, 0x5a, 0x62, 0x4e, 0x9f, 0x3f, 0xd4, 0x12, 0xf3, 0xfa, 0x82, 0xf9, 0x3e,
+	0x58, 0xb9, 0x4e, 0x02, 0xfe, 0x04, 0x64, 0xc6, 0x87, 0x2a, 0xcb, 0x04, 0x2d, 0x8e, 0x49, 0x9b,
+	0x7f, 0x8a, 0x81, 0xf1, 0x04, 0xc3, 0x02, 0x98, 0xb7, 0x43, 0x82, 0x23, 0x22, 0x00, 0xa9, 0x9d,
+	0x87, 0xff, 0x63, 0xa1, 0xaa, 0xdd, 0x26, 0x29, 0x6a, 0x7c, 0xb1, 0x90, 0x02, 0xc2, 0xf7, 0x81,
+	0x66, 0x63, 0xcf, 0xcb, 0xce, 0xfe, 0xbf, 0x04, 0x02, 0x66, 0xfe, 0x3b, 0x06, 0xee, 0x4c, 0x45,
+	0x40, 0x1b, 0xa4, 0x54, 0x21, 0x45, 0xdd, 0xa6, 0x1c, 0x5c, 0x66, 0xe7, 0xfe, 0x9b, 0xb8, 0x05,
+	0xe9, 0x8f, 0x2f, 0x7a, 0x06, 0x18, 0xda, 0x57, 0x3d, 0x03, 0xca, 0xfa, 0x1e, 0x21, 0x32, 0x11,
+	0xc0, 0x83, 0x08, 0x68, 0x83, 0xe5, 0xf1, 0x6a, 0xb5, 0x3c, 0x97, 0x45, 0xd9, 0x59, 0x51, 0xe8,
+	0x6f, 0x5f, 0xf4, 0x8c, 0xf1, 0x81, 0x1d, 0xb9, 0x2c, 0xba, 0xea, 0x19, 0xb9, 0x31, 0xd6, 0x51,
+	0xa4, 0x89, 0xee, 0xe0, 0x49, 0x80, 0xf9, 0xb5, 0x0e, 0x52, 0x7b, 0x0d, 0xec, 0x06, 0x7b, 0x34,
+	0x78, 0xe5, 0xd6, 0xe1, 0x67, 0x60, 0xa9, 0x41, 0x7d, 0xc2, 0x22, 0x82, 0x1d, 0xab, 0xe6, 0x51,
+	0xfb, 0x54, 0x7d, 0xd2, 0x6f, 0xff, 0xab, 0x67, 0xdc, 0x95, 0x13, 0x64, 0xce, 0xe9, 0xa6, 0x4b,
+	0xb7, 0x7c, 0x1c, 0x35, 0x36, 0x0f, 0x02, 0x2e, 0xba, 0x2a, 0x45, 0x27, 0x90, 0x26, 0xca, 0x0c,
+	0x3c, 0x45, 0xee, 0x80, 0x0d, 0x90, 0x71, 0x30, 0xb5, 0x5e, 0xd1, 0xf0, 0x54, 0x91, 0xcf, 0x0a,
+	0xf2, 0xe2, 0x1b, 0xc9, 0x2f, 0x7a, 0x46, 0x7a, 0xbf, 0x70, 0xf2, 0x9c, 0x86, 0xa7, 0x82, 0xe2,
+	0xaa, 0x67, 0xdc, 0x95, 0x62, 0xe3, 0x44, 0x26, 0x4a, 0x3b, 0x98, 0x0e, 0xc2, 0xe0, 0x4b, 0xa0,
+	0x0f, 0x02, 0x58, 0xab, 0xd9, 0xa4, 0x61, 0x94, 0x8d, 0xe7, 0x63, 0x8f, 0x12, 0xc5, 0x9f, 0x5f,
+	0xf4, 0x8c, 0x8c, 0xa2, 0xac, 0xc8, 0x9e, 0xab, 0x9e, 0x71, 0x6f, 0x82, 0x54, 0x61, 0x4c, 0x94,
+	0x51, 0xb4, 0x2a, 0x14, 0xd6, 0x40, 0x9a, 0xb8, 0xcd, 0xed, 0xdd, 0x27, 0x6a, 0x02, 0x9a, 0x98,
+	0xc0, 0x87, 0x37, 0x4d, 0x20, 0x55, 0x3a, 0x28, 0x6f, 0xef, 0x3e, 0xe9, 0x8f, 0x7f, 0x59, 0x4a,
+	0x8d, 0xb2, 0x98, 0x28, 0x25, 0x4d, 0x39, 0xf8, 0xbe, 0xc6, 0xae, 0xd2, 0x98, 0xbf, 0xad, 0xc6,
+	0xee, 0x75, 0x1a, 0xbb, 0xe3, 0x1a, 0xbb, 0xe3, 0x1a, 0x4f, 0x95, 0xc6, 0xc2, 0x6d, 0x35, 0x9e,
+	0x5e, 0xa7, 0xf1, 0x74, 0x5c, 0x43, 0xc6, 0xf0, 0x62, 0xaa, 0x75, 0x7f, 0x8f, 0x83, 0xc8, 0x6d,
+	0xf9, 0x4a, 0x26, 0x71, 0xeb, 0x62, 0x9a, 0x40, 0x9a, 0x28, 0x33, 0xf0, 0x48, 0xf6, 0x53, 0xb0,
+	0x62, 0xd3, 0x80, 0x45, 0xdc, 0x17, 0xd0, 0xa6, 0x47, 0x94, 0x44, 0x52, 0x48, 0x3c, 0xbd, 0x49,
+	0x62, 0x5d, 0x4a, 0x5c, 0x07, 0x37, 0xd1, 0xf2, 0xb8, 0x5b, 0x8a, 0x59, 0x40, 0x6f, 0x92, 0x88,
+	0x84, 0xac, 0xd6, 0x0a, 0xeb, 0x4a, 0x08, 0x08, 0xa1, 0x77, 0x6e, 0x12, 0x52, 0x65, 0x35, 0x09,
+	0x35, 0xd1, 0xd2, 0xd0, 0x25, 0x05, 0x3e, 0x05, 0x19, 0x97, 0xab, 0xd6, 0x5a, 0x9e, 0xa2, 0x4f,
+	0x09, 0xfa, 0x9d, 0x9b, 0xe8, 0xd5, 0xa7, 0x30, 0x0e, 0x34, 0xd1, 0x62, 0xdf, 0x21, 0xa9, 0x1d,
+	0x00, 0xfd, 0x96, 0x1b, 0x5a, 0x75, 0x0f, 0xdb, 0x2e, 0x09, 0x15, 0x7d, 0x5a, 0xd0, 0xbf, 0x7b,
+	0x13, 0xfd, 0x9a, 0xa4, 0x9f, 0x06, 0x9b, 0x48, 0xe7, 0xce, 0x8f, 0xa4, 0x4f, 0xaa, 0x54, 0x40,
+	0xba, 0x46, 0x42, 0xcf, 0x0d, 0x14, 0xff, 0xa2, 0xe0, 0x7f, 0x72, 0x13, 0xbf, 0xaa, 0xa0, 0x51,
+	0x98, 0x89, 0x52, 0xd2, 0x1c, 0x90, 0x7a, 0x34, 0x70, 0x68, 0x9f, 0xf4, 0xce, 0xad, 0x49, 0x47,
+	0x61, 0x26, 0x4a, 0x49, 0x53, 0x92, 0xd6, 0xc1, 0x32, 0x0e, 0x43, 0xfa, 0x7a, 0x22, 0x21, 0x50,
+	0x70, 0xff, 0xe2, 0x26, 0xee, 0xfe, 0xe6, 0x3a, 0x8d, 0xe6, 0x9b, 0x2b, 0xf7, 0x8e, 0xa5, 0xc4,
+	0x01, 0xb0, 0x1e, 0xe2, 0xee, 0x84, 0xce, 0xca, 0xad, 0x13, 0x3f, 0x0d, 0x36, 0x91, 0xce, 0x9d,
+	0x63, 0x2a, 0x9f, 0x83, 0x15, 0x9f, 0x84, 0x75, 0x62, 0x05, 0x24, 0x62, 0x4d, 0xcf, 0x8d, 0x94,
+	0xce, 0xdd, 0x5b, 0x7f, 0x07, 0xd7, 0xc1, 0x4d, 0x04, 0x85, 0xfb, 0x58, 0x79, 0xa5, 0xd6, 0x1a,
+	0x48, 0xd8, 0xfc, 0xb4, 0xb0, 0x5c, 0x27, 0x9b, 0x15, 0x57, 0x93, 0x05, 0x61, 0x1f, 0x38, 0x70,
+	0x05, 0xcc, 0xc9, 0xb3, 0x7d, 0x4d, 0x9c, 0xed, 0xd2, 0x80, 0x39, 0x90, 0x70, 0x88, 0xed, 0xfa,
+	0xd8, 0x63, 0xd9, 0x9c, 0x00, 0x0c, 0x6c, 0xf8, 0x09, 0x58, 0x64, 0x0d, 0x1c, 0xd4, 0x1b, 0xd8,
+	0xb5, 0x22, 0xd7, 0x27, 0xd9, 0x75, 0x31, 0xe2, 0xed, 0x9b, 0x46, 0xbc, 0x22, 0x47, 0x3c, 0x86,
+	0x33, 0x51, 0xba, 0x6f, 0x57, 0x5d, 0x9f, 0xc0, 0x32, 0x48, 0xd9, 0x38, 0xb0, 0x5b, 0x81, 0x64,
+	0xbd, 0x2f, 0x58, 0xb7, 0x6e, 0x62, 0x55, 0x47, 0xf1, 0x08, 0xca, 0x44, 0x40, 0x5a, 0x7d, 0xc6,
+	0x66, 0x88, 0xeb, 0x2d, 0x22, 0x19, 0x1f, 0xdc, 0x9a, 0x71, 0x04, 0x65, 0x22, 0x20, 0xad, 0x3e,
+	0x63, 0x9b, 0x84, 0xa7, 0x9e, 0x62, 0xdc, 0xb8, 0x35, 0xe3, 0x08, 0xca, 0x44, 0x40, 0x5a, 0x82,
+	0xf1, 0x05, 0x00, 0x94, 0xe1, 0x53, 0x2c, 0x09, 0x0d, 0x41, 0xb8, 0x79, 0x13, 0xa1, 0xba, 0x5f,
+	0x0f, 0x41, 0x26, 0x4a, 0x0a, 0x83, 0xd3, 0x0d, 0xee, 0x75, 0xab, 0xfa, 0xbd, 0x43, 0x2d, 0x71,
+	0x4f, 0xcf, 0x9a, 0x5b, 0x60, 0x8e, 0xdf, 0x5b, 0x09, 0xd4, 0x41, 0xfc, 0x94, 0x74, 0xd5, 0x1d,
+	0x8e, 0x37, 0xf9, 0xda, 0xb7, 0xb1, 0xd7, 0x22, 0xf2, 0x38, 0x47, 0xd2, 0x30, 0xcb, 0x60, 0xa9,
+	0x1a, 0xe2, 0x80, 0xf1, 0x3b, 0x2f, 0x0d, 0x8e, 0x68, 0x9d, 0x41, 0x08, 0xb4, 0x06, 0x66, 0x0d,
+	0x85, 0x15, 0x6d, 0xf8, 0x33, 0xa0, 0x79, 0xb4, 0xce, 0xc4, 0xc5, 0x26, 0xb5, 0x73, 0x77, 0xfa,
+	0x16, 0x75, 0x44, 0xeb, 0x48, 0x84, 0x98, 0x7f, 0x88, 0x83, 0xf8, 0x11, 0xad, 0xc3, 0x2c, 0x58,
+	0xc0, 0x8e, 0x13, 0x12, 0xc6, 0x14, 0x53, 0xdf, 0x84, 0xab, 0x60, 0x3e, 0xa2, 0x4d, 0xd7, 0x96,
+	0x74, 0x49, 0xa4, 0x2c, 0x2e, 0xec, 0xe0, 0x08, 0x8b, 0x3b, 0x40, 0x1a, 0x89, 0x36, 0x7f, 0x42,
+	0x88, 0x52, 0xb7, 0x82, 0x96, 0x5f, 0x23, 0xa1, 0x38, 0xca, 0xb5, 0xe2, 0xd2, 0x65, 0xcf, 0x48,
+	0x09, 0xff, 0xb1, 0x70, 0xa3, 0x51, 0x03, 0xbe, 0x05, 0x16, 0xa2, 0x8e, 0x25, 0xe6, 0x30, 0x27,
+	0x52, 0xbc, 0x7c, 0xd9, 0x33, 0x96, 0xa2, 0xe1, 0x34, 0x7f, 0x8d, 0x59, 0x03, 0xcd, 0x47, 0x1d,
+	0xfe, 0x3f, 0xdc, 0x02, 0x89, 0xa8, 0x63, 0xb9, 0x81, 0x43, 0x3a, 0xe2, 0x10, 0xd7, 0x8a, 0x2b,
+	0x97, 0x3d, 0x43, 0x1f, 0x09, 0x3f, 0xe0, 0x7d, 0x68, 0x21, 0xea, 0x88, 0x06, 0x7c, 0x0b, 0x00,
+	0x39, 0x24, 0xa1, 0x20, 0xcf, 0xe4, 0xc5, 0xcb, 0x9e, 0x91, 0x14, 0x5e, 0xc1, 0x3d, 0x6c, 0x42,
+	0x13, 0xcc, 0x49, 0xee, 0x84, 0xe0, 0x4e, 0x5f, 0xf6, 0x8c, 0x84, 0x47, 0xeb, 0x92, 0x53, 0x76,
+	0xf1, 0x54, 0x85, 0xc4, 0xa7, 0x6d, 0xe2, 0x88, 0x83, 0x31, 0x81, 0xfa, 0x26, 0x7c, 0x0f, 0x2c,
+	0x49, 0x2d, 0xbe, 0xf6, 0x2c, 0xc2, 0x7e, 0x53, 0xbe, 0x36, 0x8a, 0xf0, 0xb2, 0x67, 0x64, 0x44,
+	0x57, 0xb5, 0xdf, 0x83, 0x26, 0x6c, 0xf3, 0xcb, 0x59, 0x90, 0xa8, 0x76, 0x10, 0x61, 0x2d, 0x2f,
+	0x82, 0xcf, 0x81, 0x2e, 0x2e, 0x9a, 0xd8, 0x8e, 0xac, 0xb1, 0x75, 0x29, 0xae, 0x0f, 0xcf, 0xc0,
+	0xc9, 0x08, 0x13, 0x2d, 0xf5, 0x5d, 0x05, 0xb5, 0x78, 0x2b, 0x60, 0xae, 0xe6, 0x51, 0xea, 0x8b,
+	0x32, 0x4a, 0x23, 0x69, 0xc0, 0x97, 0x22, 0xe5, 0xa2, 0x44, 0xe2, 0xe2, 0x12, 0xff, 0xa3, 0xe9,
+	0x12, 0x99, 0xa8, 0xb3, 0xe2, 0x3a, 0xbf, 0xc2, 0x5f, 0xf5, 0x8c, 0x8c, 0xd4, 0x56, 0x78, 0xf3,
+	0xeb, 0x1f, 0xbe, 0x79, 0x1c, 0xe3, 0xab, 0x23, 0x8a, 0x51, 0x07, 0xf1, 0x90, 0x44, 0x62, 0xd9,
+	0xd3, 0x88, 0x37, 0xf9, 0x6e, 0x15, 0x92, 0x36, 0x09, 0x23, 0xe2, 0x88, 0xe5, 0x4d, 0xa0, 0x81,
+	0xcd, 0xb7, 0xbe, 0x3a, 0x66, 0x56, 0x8b, 0x11, 0x47, 0xae, 0x25, 0x5a, 0xa8, 0x63, 0xf6, 0x31,
+	0x23, 0xce, 0x33, 0xed, 0x8b, 0xaf, 0x8c, 0x19, 0x13, 0x83, 0x94, 0xba, 0xdf, 0xb7, 0x9a, 0x1e,
+	0xb9, 0xa1, 0x46, 0x77, 0x40, 0x9a, 0xbf, 0xe6, 0x70, 0x9d, 0x58, 0xa7, 0xa4, 0xab, 0x2a, 0x55,
+	0xd6, 0x9d, 0xf2, 0xff, 0x86, 0x74, 0x19, 0x1a, 0x35, 0x94, 0xc4, 0x57, 0x1a, 0x48, 0x55, 0x43,
+	0x6c, 0x13, 0x75, 0x5b, 0xe7, 0xd5, 0xce, 0xcd, 0x50, 0x49, 0x28, 0x8b, 0x6b, 0xf3, 0x45, 0xa5,
+	0xad, 0x48, 0x7d, 0x91, 0x7d, 0x93, 0x23, 0x42, 0x42, 0x3a, 0xc4, 0x16, 0xb9, 0xd4, 0x90, 0xb2,
+	0xe0, 0x2e, 0x58, 0x74, 0x5c, 0x86, 0x6b, 0x9e, 0x78, 0xbc, 0xda, 0xa7, 0x72, 0xfa, 0x45, 0xfd,
+	0xb2, 0x67, 0xa4, 0x55, 0x47, 0x85, 0xfb, 0xd1, 0x98, 0xc5, 0x6b, 0x68, 0x08, 0x13, 0xa3, 0x15,
+	0xb9, 0x49, 0xc8, 0x1a, 0x1a, 0x84, 0x8a, 0x1e, 0x34, 0x61, 0xcb, 0x13, 0xa3, 0xd6, 0xaa, 0x8b,
+	0xf2, 0x4d, 0x20, 0x69, 0x70, 0xaf, 0xe7, 0xfa, 0x6e, 0x24, 0xca, 0x75, 0x0e, 0x49, 0x03, 0xbe,
+	0x07, 0x92, 0xb4, 0x4d, 0xc2, 0xd0, 0x75, 0x08, 0x13, 0x65, 0x9a, 0xda, 0x79, 0x30, 0x5d, 0x06,
+	0x23, 0x2f, 0x19, 0x34, 0x8c, 0xe7, 0x93, 0x23, 0x81, 0x18, 0xa4, 0x4f, 0x7c, 0x1a, 0x76, 0xb3,
+	0xa9, 0xe1, 0xe4, 0x64, 0xc7, 0x0b, 0xe1, 0x47, 0x63, 0x16, 0x2c, 0x02, 0xa8, 0x60, 0x21, 0x89,
+	0x5a, 0x61, 0x60, 0x89, 0x1d, 0x24, 0x2d, 0xb0, 0xe2, 0x3b, 0x96, 0xbd, 0x48, 0x74, 0xee, 0xe3,
+	0x08, 0xa3, 0x29, 0x0f, 0xfc, 0x00, 0x40, 0xb9, 0x26, 0xd6, 0xe7, 0x8c, 0x06, 0xfc, 0x3d, 0xf6,
+	0xca, 0xad, 0xab, 0xbb, 0x91, 0xd0, 0x97, 0xbd, 0x6a, 0xcc, 0xba, 0xb4, 0x0e, 0x19, 0x55, 0xb3,
+	0x38, 0xd4, 0x12, 0x9a, 0x3e, 0x77, 0xa8, 0x25, 0x16, 0xf4, 0xc4, 0x20, 0x7f, 0x6a, 0x16, 0x68,
+	0xb9, 0x6f, 0x8f, 0x0c, 0xcf, 0x3c, 0x06, 0xa0, 0x1c, 0x12, 0x97, 0xdf, 0x60, 0x3d, 0x8f, 0x6f,
+	0x7b, 0x01, 0xf6, 0x49, 0x7f, 0xbf, 0xe5, 0xed, 0xd1, 0xc2, 0x9c, 0x1d, 0x2f, 0x4c, 0x08, 0x34,
+	0x9b, 0x3a, 0x44, 0x94, 0x46, 0x12, 0x89, 0xb6, 0xf9, 0xc7, 0x18, 0x48, 0x95, 0xda, 0xfe, 0x1e,
+	0x75, 0x83, 0x83, 0xe0, 0x15, 0x1d, 0x1e, 0xf3, 0xb1, 0xd1, 0x63, 0x7e, 0xfa, 0x85, 0x3f, 0x7b,
+	0xcd, 0x0b, 0x1f, 0x3e, 0x14, 0x55, 0xd6, 0xf4, 0x70, 0x57, 0x45, 0x49, 0xa5, 0xb4, 0x72, 0xee,
+	0x4f, 0x5d, 0x19, 0xf8, 0xb7, 0xb9, 0x38, 0xbc, 0x32, 0x98, 0x65, 0x90, 0xe1, 0x8f, 0xb1, 0x02,
+	0xff, 0x15, 0x05, 0xf3, 0xaf, 0x9d, 0x8f, 0x99, 0x3f, 0xd8, 0xfa, 0x33, 0xe4, 0x6d, 0x5e, 0xe4,
+	0x0d, 0xe2, 0xd6, 0x1b, 0xb2, 0xfa, 0xe3, 0x48, 0x59, 0x3c, 0x56, 0x1c, 0x8e, 0xb2, 0xf4, 0x45,
+	0xdb, 0xfc, 0x4b, 0x0c, 0x2c, 0x95, 0x49, 0xe8, 0xbb, 0x8c, 0xb9, 0x34, 0x28, 0x05, 0x51, 0xd8,
+	0x85, 0xef, 0x00, 0x4d, 0x3c, 0xb5, 0xe5, 0xbb, 0x3e, 0x3f, 0x5d, 0x67, 0x43, 0x00, 0x7f, 0x44,
+	0x23, 0x11, 0x7d, 0x43, 0x5e, 0xf9, 0x67, 0x8a, 0xc3, 0x3a, 0x89, 0xd4, 0x7c, 0x95, 0x05, 0x1f,
+	0xf0, 0x9f, 0xc1, 0x9a, 0x6e, 0x48, 0x98, 0x85, 0xe5, 0x3e, 0xa4, 0xa1, 0xa4, 0xf2, 0x14, 0x22,
+	0xf3, 0x6f, 0xb3, 0x20, 0x55, 0x69, 0xd2, 0x80, 0xd1, 0x90, 0x35, 0xdc, 0x26, 0x17, 0x60, 0xd2,
+	0xec, 0xef, 0x28, 0xca, 0xe4, 0x29, 0xeb, 0xef, 0xa5, 0x4a, 0x7b, 0x60, 0xc3, 0x87, 0x20, 0xe3,
+	0xe3, 0x8e, 0xc5, 0xf7, 0xae, 0x26, 0x09, 0xad, 0xa8, 0xa3, 0xa6, 0x9f, 0xf2, 0x71, 0xe7, 0x23,
+	0xcc, 0xca, 0x24, 0xac, 0x76, 0xe0, 0x2e, 0x98, 0xaf, 0xb5, 0x9c, 0xba, 0xda, 0x0d, 0x93, 0xc5,
+	0x07, 0x7c, 0xff, 0x7c, 0xe3, 0xe5, 0x01, 0xa9, 0x60, 0xb8, 0x0f, 0x96, 0x38, 0xf7, 0x2b, 0x42,
+	0x04, 0x77, 0x1d, 0xb3, 0xec, 0xdc, 0x6d, 0xf0, 0x69, 0x1f, 0x77, 0x9e, 0x13, 0x52, 0x26, 0xe1,
+	0x47, 0x98, 0xc1, 0x23, 0xb0, 0xac, 0x58, 0xe4, 0x10, 0x19, 0xaf, 0x98, 0x30, 0x3b, 0x7f, 0x1b,
+	0x26, 0x5d, 0x32, 0xf1, 0x69, 0x54, 0x04, 0xcc, 0x6c, 0x83, 0x45, 0x95, 0x34, 0xe2, 0xf0, 0x9e,
+	0xb1, 0xe4, 0xc4, 0x26, 0x92, 0xb3, 0x0a, 0xe6, 0x95, 0x9a, 0x4c, 0x9b, 0xb2, 0xe0, 0x36, 0xd0,
+	0xf8, 0x70, 0xb2, 0xf1, 0xdb, 0x8c, 0x41, 0x84, 0x3e, 0xfe, 0x7b, 0x0c, 0x8c, 0xfc, 0xbe, 0x03,
+	0x7f, 0x05, 0x72, 0x85, 0xbd, 0xbd, 0x52, 0xa5, 0x62, 0x55, 0x3f, 0x2d, 0x97, 0xac, 0x72, 0x09,
+	0xbd, 0x38, 0xa8, 0x54, 0x0e, 0x4e, 0x8e, 0x8f, 0x4a, 0x95, 0x8a, 0x3e, 0x93, 0xbb, 0x7f, 0x76,
+	0x9e, 0xcf, 0x0e, 0xe3, 0x87, 0x15, 0xe5, 0xf1, 0x8a, 0x79, 0x07, 0xac, 0x8e, 0xa2, 0x51, 0xa9,
+	0x52, 0x45, 0x07, 0x7b, 0xd5, 0xd2, 0xbe, 0x1e, 0xcb, 0x65, 0xcf, 0xce, 0xf3, 0x2b, 0x43, 0x24,
+	0x22, 0x2c, 0x0a, 0x5d, 0x9b, 0x1f, 0x51, 0x4f, 0x41, 0xf6, 0x7a, 0xcd, 0xd2, 0xbe, 0x3e, 0x9b,
+	0xcb, 0x9d, 0x9d, 0xe7, 0x57, 0xaf, 0x53, 0x24, 0x4e, 0x4e, 0xfb, 0xe2, 0xaf, 0x1b, 0x33, 0x8f,
+	0xff, 0x11, 0x07, 0x99, 0xf1, 0xd2, 0x86, 0x1f, 0x80, 0xf5, 0x21, 0x8d, 0x75, 0x74, 0x50, 0xa9,
+	0x5a, 0x1f, 0x1f, 0x57, 0xca, 0xa5, 0xbd, 0x83, 0xe7, 0x07, 0xa5, 0x7d, 0x7d, 0x26, 0xf7, 0xe0,
+	0xec, 0x3c, 0xbf, 0x36, 0x0e, 0xfa, 0x38, 0x60, 0x4d, 0x62, 0xbb, 0xaf, 0x5c, 0xe2, 0xc0, 0x0f,
+	0xc1, 0xfd, 0x49, 0xfc, 0x1e, 0x2a, 0x15, 0xaa, 0x25, 0xab, 0x70, 0x74, 0x74, 0xf2, 0x52, 0x8f,
+	0x5d, 0x47, 0xb0, 0x27, 0x7e, 0xba, 0x2b, 0x78, 0x1e, 0x7d, 0x0d, 0xdf, 0x07, 0xeb, 0x6f, 0x20,
+	0xd8, 0x2f, 0x1d, 0x7f, 0xaa, 0xcf, 0xca, 0x44, 0x5e, 0x87, 0xdf, 0x27, 0x41, 0x17, 0xbe, 0x07,
+	0x72, 0x53, 0xf0, 0xc2, 0xd1, 0x91, 0x52, 0x8f, 0xe7, 0xd6, 0xcf, 0xce, 0xf3, 0xf7, 0x26, 0xd0,
+	0xd8, 0xf3, 0xa4, 0xf6, 0x2f, 0xc1, 0xda, 0xb5, 0x60, 0xa1, 0xac, 0xc9, 0x84, 0x4e, 0x63, 0x85,
+	0xee, 0x11, 0x78, 0x38, 0x05, 0x3d, 0x39, 0xae, 0xa2, 0xc2, 0xde, 0xd8, 0x00, 0xe6, 0x72, 0x0f,
+	0xcf, 0xce, 0xf3, 0xc6, 0x04, 0x89, 0xaa, 0xce, 0xe1, 0x40, 0xde, 0x05, 0xf7, 0x26, 0xd9, 0x2a,
+	0xe5, 0x93, 0xe3, 0xca, 0x09, 0xd2, 0xe7, 0x73, 0x6b, 0x67, 0xe7, 0xf9, 0xbb, 0xe3, 0x0c, 0xea,
+	0x03, 0x90, 0xcb, 0x5a, 0x7c, 0xf6, 0xed, 0xc5, 0x46, 0xec, 0xbb, 0x8b, 0x8d, 0xd8, 0x7f, 0x2e,
+	0x36, 0x62, 0x5f, 0x7e, 0xbf, 0x31, 0xf3, 0xdd, 0xf7, 0x1b, 0x33, 0xff, 0xfc, 0x7e, 0x63, 0xe6,
+	0xb7, 0xf9, 0xba, 0x1b, 0x35, 0x5a, 0xb5, 0x4d, 0x9b, 0xfa, 0x5b, 0x93, 0xbf, 0xf5, 0xf2, 0x1f,
+	0x24, 0x59, 0x6d, 0x5e, 0xfc, 0xdc, 0xff, 0xf6, 0x7f, 0x07, 0x00, 0x17, 0xbf, 0x55, 0xbd, 0x47,
+	0x18, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -2212,98 +2583,304 @@ func (m *EvmCoinInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvm(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvm(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ForkActivation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ForkActivation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForkActivation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.EvmDenom)
-	if l > 0 {
-		n += 1 + l + sovEvm(uint64(l))
-	}
-	if len(m.ExtraEIPs) > 0 {
-		l = 0
-		for _, e := range m.ExtraEIPs {
-			l += sovEvm(uint64(e))
-		}
-		n += 1 + sovEvm(uint64(l)) + l
-	}
-	if len(m.EVMChannels) > 0 {
-		for _, s := range m.EVMChannels {
-			l = len(s)
-			n += 1 + l + sovEvm(uint64(l))
-		}
-	}
-	l = m.AccessControl.Size()
-	n += 1 + l + sovEvm(uint64(l))
-	if len(m.ActiveStaticPrecompiles) > 0 {
-		for _, s := range m.ActiveStaticPrecompiles {
-			l = len(s)
-			n += 1 + l + sovEvm(uint64(l))
-		}
+	if m.Time != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.HistoryServeWindow != 0 {
-		n += 1 + sovEvm(uint64(m.HistoryServeWindow))
+	if m.Height != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.ExtendedDenomOptions != nil {
-		l = m.ExtendedDenomOptions.Size()
-		n += 1 + l + sovEvm(uint64(l))
+	if len(m.Fork) > 0 {
+		i -= len(m.Fork)
+		copy(dAtA[i:], m.Fork)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Fork)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ExtendedDenomOptions) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ExtendedDenom)
-	if l > 0 {
-		n += 1 + l + sovEvm(uint64(l))
+func (m *PermissionEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AccessControl) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Create.Size()
-	n += 1 + l + sovEvm(uint64(l))
-	l = m.Call.Size()
-	n += 1 + l + sovEvm(uint64(l))
-	return n
+func (m *PermissionEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccessControlType) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PermissionEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.AccessType != 0 {
-		n += 1 + sovEvm(uint64(m.AccessType))
-	}
-	if len(m.AccessControlList) > 0 {
-		for _, s := range m.AccessControlList {
-			l = len(s)
-			n += 1 + l + sovEvm(uint64(l))
-		}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.List != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.List))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Sponsorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Sponsorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Sponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxFeesPerSender.Size()
+		i -= size
+		if _, err := m.MaxFeesPerSender.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MaxFeePerGas.Size()
+		i -= size
+		if _, err := m.MaxFeePerGas.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Budget.Size()
+		i -= size
+		if _, err := m.Budget.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.MaxGasPerTx != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxGasPerTx))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SponsoredFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SponsoredFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SponsoredFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Fees.Size()
+		i -= size
+		if _, err := m.Fees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvm(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvm(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmDenom)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if len(m.ExtraEIPs) > 0 {
+		l = 0
+		for _, e := range m.ExtraEIPs {
+			l += sovEvm(uint64(e))
+		}
+		n += 1 + sovEvm(uint64(l)) + l
+	}
+	if len(m.EVMChannels) > 0 {
+		for _, s := range m.EVMChannels {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	l = m.AccessControl.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	if len(m.ActiveStaticPrecompiles) > 0 {
+		for _, s := range m.ActiveStaticPrecompiles {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if m.HistoryServeWindow != 0 {
+		n += 1 + sovEvm(uint64(m.HistoryServeWindow))
+	}
+	if m.ExtendedDenomOptions != nil {
+		l = m.ExtendedDenomOptions.Size()
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	return n
+}
+
+func (m *ExtendedDenomOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExtendedDenom)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	return n
+}
+
+func (m *AccessControl) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Create.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	l = m.Call.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	return n
+}
+
+func (m *AccessControlType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AccessType != 0 {
+		n += 1 + sovEvm(uint64(m.AccessType))
+	}
+	if len(m.AccessControlList) > 0 {
+		for _, s := range m.AccessControlList {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
 	}
 	return n
 }
@@ -2631,6 +3208,93 @@ func (m *EvmCoinInfo) Size() (n int) {
 	return n
 }
 
+func (m *ForkActivation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Fork)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvm(uint64(m.Height))
+	}
+	if m.Time != 0 {
+		n += 1 + sovEvm(uint64(m.Time))
+	}
+	return n
+}
+
+func (m *PermissionEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.List != 0 {
+		n += 1 + sovEvm(uint64(m.List))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovEvm(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func (m *Sponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.MaxGasPerTx != 0 {
+		n += 1 + sovEvm(uint64(m.MaxGasPerTx))
+	}
+	l = m.Budget.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	l = m.MaxFeePerGas.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	l = m.MaxFeesPerSender.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	return n
+}
+
+func (m *SponsoredFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = m.Fees.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	return n
+}
+
 func sovEvm(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5611,6 +6275,661 @@ func (m *EvmCoinInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ForkActivation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForkActivation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForkActivation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fork", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fork = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PermissionEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PermissionEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PermissionEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			}
+			m.List = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.List |= PermissionList(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Sponsorship) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sponsorship: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerTx", wireType)
+			}
+			m.MaxGasPerTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerTx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Budget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeePerGas", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFeePerGas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeesPerSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFeesPerSender.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SponsoredFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SponsoredFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SponsoredFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvm(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
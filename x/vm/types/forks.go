@@ -0,0 +1,93 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+)
+
+// names of the hard forks which can be scheduled by governance
+const (
+	ForkShanghai = "shanghai"
+	ForkCancun   = "cancun"
+	ForkPrague   = "prague"
+	ForkOsaka    = "osaka"
+)
+
+// ScheduledForks are the hard forks which can be scheduled by governance, in
+// their activation order.
+var ScheduledForks = []string{ForkShanghai, ForkCancun, ForkPrague, ForkOsaka}
+
+// ForkIndex returns the position of a fork in ScheduledForks, or -1 if it
+// can't be scheduled.
+func ForkIndex(fork string) int {
+	return slices.Index(ScheduledForks, fork)
+}
+
+// Validate performs a stateless validation of the fork activation
+func (fa ForkActivation) Validate() error {
+	if ForkIndex(fa.Fork) < 0 {
+		return errorsmod.Wrapf(ErrInvalidForkActivation, "unknown fork %q, expected one of %v", fa.Fork, ScheduledForks)
+	}
+	if fa.Height < 0 {
+		return errorsmod.Wrapf(ErrInvalidForkActivation, "negative activation height %d", fa.Height)
+	}
+	if (fa.Height == 0) == (fa.Time == 0) {
+		return errorsmod.Wrap(ErrInvalidForkActivation, "exactly one of the activation height and time must be set")
+	}
+	return nil
+}
+
+// ByHeight returns true if the fork is activated at a block height
+func (fa ForkActivation) ByHeight() bool {
+	return fa.Height > 0
+}
+
+// ActiveAt returns true if the fork is active at the given block height and time
+func (fa ForkActivation) ActiveAt(height int64, time uint64) bool {
+	if fa.ByHeight() {
+		return height >= fa.Height
+	}
+	return time >= fa.Time
+}
+
+// ForkTime returns the activation time of a scheduled fork in the chain
+// config, nil if the fork isn't configured.
+func (cc ChainConfig) ForkTime(fork string) *uint64 {
+	switch fork {
+	case ForkShanghai:
+		return getTimestampValue(cc.ShanghaiTime)
+	case ForkCancun:
+		return getTimestampValue(cc.CancunTime)
+	case ForkPrague:
+		return getTimestampValue(cc.PragueTime)
+	case ForkOsaka:
+		return getTimestampValue(cc.OsakaTime)
+	default:
+		return nil
+	}
+}
+
+// WithForkTime returns a copy of the chain config with the activation time of
+// a scheduled fork set to the given time.
+func (cc ChainConfig) WithForkTime(fork string, time uint64) (*ChainConfig, error) {
+	value := sdkmath.NewIntFromUint64(time)
+	switch fork {
+	case ForkShanghai:
+		cc.ShanghaiTime = &value
+	case ForkCancun:
+		cc.CancunTime = &value
+	case ForkPrague:
+		cc.PragueTime = &value
+	case ForkOsaka:
+		cc.OsakaTime = &value
+	default:
+		return nil, fmt.Errorf("unknown fork %q", fork)
+	}
+	if err := cc.Validate(); err != nil {
+		return nil, err
+	}
+	return &cc, nil
+}
@@ -2,6 +2,9 @@ package types
 
 import (
 	"fmt"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/cosmos/evm/utils"
 )
@@ -66,5 +69,64 @@ func (gs GenesisState) Validate() error {
 		seenPreinstalls[preinstall.Address] = true
 	}
 
+	// Validate the fork activations, a fork is either pending or activated
+	seenForks := make(map[string]bool)
+	for _, activation := range slices.Concat(gs.PendingForks, gs.ActivatedForks) {
+		if seenForks[activation.Fork] {
+			return fmt.Errorf("duplicated fork activation %s", activation.Fork)
+		}
+		if err := activation.Validate(); err != nil {
+			return fmt.Errorf("invalid fork activation %s: %w", activation.Fork, err)
+		}
+		seenForks[activation.Fork] = true
+	}
+	for _, activation := range gs.ActivatedForks {
+		if activation.ByHeight() {
+			return fmt.Errorf("activated fork %s must be recorded with its activation time", activation.Fork)
+		}
+	}
+
+	// Validate the entries of the permission lists
+	seenPermissions := make(map[string]bool)
+	for _, entry := range gs.Permissions {
+		if err := entry.Validate(); err != nil {
+			return fmt.Errorf("invalid permission entry %s: %w", entry.Address, err)
+		}
+		key := string(entry.Key())
+		if seenPermissions[key] {
+			return fmt.Errorf("duplicated permission entry %s in %s", entry.Address, entry.List)
+		}
+		seenPermissions[key] = true
+	}
+
+	// Validate the sponsorships
+	seenSponsorships := make(map[string]bool)
+	for _, sponsorship := range gs.Sponsorships {
+		if err := sponsorship.Validate(); err != nil {
+			return fmt.Errorf("invalid sponsorship of %s: %w", sponsorship.Contract, err)
+		}
+		key := string(sponsorship.Key())
+		if seenSponsorships[key] {
+			return fmt.Errorf("duplicated sponsorship of %s", sponsorship.Contract)
+		}
+		seenSponsorships[key] = true
+	}
+
+	// Validate the sponsored fees, which are only tracked for the sponsored contracts
+	seenSponsoredFees := make(map[string]bool)
+	for _, fees := range gs.SponsoredFees {
+		if err := fees.Validate(); err != nil {
+			return fmt.Errorf("invalid sponsored fees of %s for %s: %w", fees.Contract, fees.Sender, err)
+		}
+		if !seenSponsorships[string(SponsorshipKey(common.HexToAddress(fees.Contract)))] {
+			return fmt.Errorf("sponsored fees of %s for %s without sponsorship", fees.Contract, fees.Sender)
+		}
+		key := string(fees.Key())
+		if seenSponsoredFees[key] {
+			return fmt.Errorf("duplicated sponsored fees of %s for %s", fees.Contract, fees.Sender)
+		}
+		seenSponsoredFees[key] = true
+	}
+
 	return gs.Params.Validate()
 }
@@ -32,6 +32,20 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 	// preinstalls defines a set of predefined contracts
 	Preinstalls []Preinstall `protobuf:"bytes,3,rep,name=preinstalls,proto3" json:"preinstalls"`
+	// pending_forks defines the hard forks scheduled by governance which are not
+	// active yet
+	PendingForks []ForkActivation `protobuf:"bytes,4,rep,name=pending_forks,json=pendingForks,proto3" json:"pending_forks"`
+	// activated_forks defines the hard forks scheduled by governance which are
+	// active
+	ActivatedForks []ForkActivation `protobuf:"bytes,5,rep,name=activated_forks,json=activatedForks,proto3" json:"activated_forks"`
+	// permissions defines the entries of the on-chain permission lists
+	Permissions []PermissionEntry `protobuf:"bytes,6,rep,name=permissions,proto3" json:"permissions"`
+	// sponsorships defines the sponsors paying the fees of the transactions
+	// calling contracts
+	Sponsorships []Sponsorship `protobuf:"bytes,7,rep,name=sponsorships,proto3" json:"sponsorships"`
+	// sponsored_fees defines the fees paid by the sponsors for the transactions
+	// of each sender
+	SponsoredFees []SponsoredFees `protobuf:"bytes,8,rep,name=sponsored_fees,json=sponsoredFees,proto3" json:"sponsored_fees"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -88,6 +102,41 @@ func (m *GenesisState) GetPreinstalls() []Preinstall {
 	return nil
 }
 
+func (m *GenesisState) GetPendingForks() []ForkActivation {
+	if m != nil {
+		return m.PendingForks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetActivatedForks() []ForkActivation {
+	if m != nil {
+		return m.ActivatedForks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPermissions() []PermissionEntry {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSponsorships() []Sponsorship {
+	if m != nil {
+		return m.Sponsorships
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSponsoredFees() []SponsoredFees {
+	if m != nil {
+		return m.SponsoredFees
+	}
+	return nil
+}
+
 // GenesisAccount defines an account to be initialized in the genesis state.
 // Its main difference between with Geth's GenesisAccount is that it uses a
 // custom storage type and that it doesn't contain the private key field.
@@ -162,29 +211,37 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evm/vm/v1/genesis.proto", fileDescriptor_e6b6f3a3ceb84d18) }
 
 var fileDescriptor_e6b6f3a3ceb84d18 = []byte{
-	// 340 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0x07, 0x21, 0x43, 0xfd, 0xf4, 0xd4, 0xbc, 0xd4, 0xe2,
-	0xcc, 0x62, 0xbd, 0x82, 0xa2, 0xfc, 0x92, 0x7c, 0x21, 0x01, 0x88, 0xbc, 0x5e, 0x6a, 0x59, 0xae,
-	0x1e, 0x08, 0x19, 0x4a, 0x09, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x22, 0x29,
-	0x29, 0x0c, 0x43, 0x40, 0xca, 0x21, 0x72, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88,
-	0x05, 0x11, 0x55, 0xba, 0xcf, 0xc8, 0xc5, 0xe3, 0x0e, 0xb1, 0x28, 0xb8, 0x24, 0xb1, 0x24, 0x55,
-	0xc8, 0x9d, 0x8b, 0x23, 0x31, 0x39, 0x39, 0xbf, 0x34, 0xaf, 0xa4, 0x58, 0x82, 0x51, 0x81, 0x59,
-	0x83, 0xdb, 0x48, 0x41, 0x0f, 0xdd, 0x6a, 0x3d, 0xa8, 0x0e, 0x47, 0x88, 0x42, 0x27, 0xce, 0x13,
-	0xf7, 0xe4, 0x19, 0x56, 0x3c, 0xdf, 0xa0, 0xc5, 0x18, 0x04, 0xd7, 0x2c, 0x64, 0xcd, 0xc5, 0x56,
-	0x90, 0x58, 0x94, 0x98, 0x5b, 0x2c, 0xc1, 0xa4, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0x81, 0x69, 0x4c,
-	0x00, 0x58, 0x1e, 0x59, 0x3b, 0x54, 0x8b, 0x90, 0x27, 0x17, 0x77, 0x41, 0x51, 0x6a, 0x66, 0x5e,
-	0x71, 0x49, 0x62, 0x4e, 0x4e, 0xb1, 0x04, 0x33, 0xd8, 0x21, 0x32, 0x58, 0x4c, 0x80, 0x2b, 0x42,
-	0x36, 0x05, 0x59, 0xaf, 0x52, 0x3b, 0x23, 0x17, 0x1f, 0xaa, 0x7b, 0x85, 0x24, 0xb8, 0xd8, 0x13,
-	0x53, 0x52, 0x8a, 0x52, 0x8b, 0x41, 0x5e, 0x64, 0xd4, 0xe0, 0x0c, 0x82, 0x71, 0x85, 0x84, 0xb8,
-	0x58, 0x92, 0xf3, 0x53, 0x52, 0xc1, 0x4e, 0xe6, 0x0c, 0x02, 0xb3, 0x85, 0xdc, 0xb9, 0xd8, 0x8b,
-	0x4b, 0xf2, 0x8b, 0x12, 0xd3, 0x53, 0xa1, 0xee, 0x10, 0xc7, 0x74, 0x07, 0x38, 0xec, 0x9c, 0x44,
-	0x40, 0x4e, 0x58, 0x75, 0x5f, 0x9e, 0x3d, 0x18, 0xa2, 0x1e, 0xe2, 0x1a, 0x98, 0x6e, 0x27, 0xab,
-	0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39,
-	0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x52, 0x48, 0xcf, 0x2c, 0xc9, 0x28,
-	0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x47, 0x8a, 0xc2, 0x0a, 0x50, 0x24, 0x96, 0x54, 0x16, 0xa4,
-	0x16, 0x27, 0xb1, 0x81, 0xa3, 0xcb, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x3a, 0x23, 0x28, 0x5f,
-	0x27, 0x02, 0x00, 0x00,
+	// 478 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xb1, 0x6e, 0x13, 0x41,
+	0x10, 0x86, 0x7d, 0xc4, 0xd8, 0xf1, 0xda, 0x31, 0xb0, 0x8a, 0xc4, 0xc9, 0x82, 0xb3, 0x49, 0x65,
+	0x51, 0xdc, 0x29, 0xa1, 0x83, 0x2a, 0x96, 0x88, 0x85, 0x84, 0x50, 0xb0, 0xa9, 0x68, 0xd0, 0xe6,
+	0x6e, 0xb8, 0xac, 0x92, 0xdb, 0x3d, 0xed, 0x6c, 0x2c, 0xf2, 0x04, 0xb4, 0x3c, 0x06, 0xa2, 0xe2,
+	0x31, 0x52, 0xa6, 0xa4, 0x02, 0x64, 0x17, 0xbc, 0x02, 0x25, 0xda, 0xdd, 0x8b, 0x73, 0x87, 0x9d,
+	0x22, 0xd2, 0xca, 0x9a, 0xf5, 0xfc, 0xff, 0xb7, 0x33, 0xba, 0x19, 0x12, 0xc4, 0x12, 0x33, 0x89,
+	0x11, 0xcc, 0xb2, 0xc8, 0x9c, 0xdd, 0x28, 0x05, 0x01, 0xc8, 0x31, 0xcc, 0x95, 0xd4, 0x92, 0xde,
+	0x77, 0xf9, 0x10, 0x66, 0x59, 0x68, 0xce, 0x6e, 0xef, 0x01, 0xcb, 0xb8, 0x90, 0x91, 0xfd, 0x75,
+	0xa2, 0x5e, 0x6f, 0x05, 0x62, 0xe4, 0x2e, 0xb7, 0x9d, 0xca, 0x54, 0xda, 0x30, 0x32, 0x91, 0xfb,
+	0x77, 0xe7, 0x6f, 0x9d, 0x74, 0xc6, 0xee, 0xa1, 0xa9, 0x66, 0x1a, 0xe8, 0x98, 0x6c, 0xb2, 0x38,
+	0x96, 0x67, 0x42, 0xa3, 0xef, 0x0d, 0x36, 0x86, 0xed, 0xbd, 0x41, 0xf8, 0xff, 0xd3, 0x61, 0xe1,
+	0xd8, 0x77, 0xc2, 0x51, 0xeb, 0xe2, 0x67, 0xbf, 0xf6, 0xf5, 0xcf, 0xf7, 0xa7, 0xde, 0x64, 0x69,
+	0xa6, 0x2f, 0x48, 0x23, 0x67, 0x8a, 0x65, 0xe8, 0xdf, 0x19, 0x78, 0xc3, 0xf6, 0x9e, 0xbf, 0x8a,
+	0x39, 0xb4, 0xf9, 0xb2, 0xbd, 0xb0, 0xd0, 0x57, 0xa4, 0x9d, 0x2b, 0xe0, 0x02, 0x35, 0x3b, 0x3d,
+	0x45, 0x7f, 0xc3, 0x16, 0xf2, 0x68, 0x0d, 0x61, 0x29, 0x2a, 0x53, 0xca, 0x5e, 0x7a, 0x48, 0xb6,
+	0x72, 0x10, 0x09, 0x17, 0xe9, 0x87, 0x8f, 0x52, 0x9d, 0xa0, 0x5f, 0xbf, 0xa9, 0xab, 0x03, 0xa9,
+	0x4e, 0xf6, 0x63, 0xcd, 0x67, 0x4c, 0x73, 0x29, 0xca, 0xc0, 0x4e, 0x41, 0x30, 0x0a, 0xa4, 0xef,
+	0xc8, 0x3d, 0xe6, 0x64, 0x90, 0x14, 0xcc, 0xbb, 0xb7, 0x67, 0x76, 0x97, 0x0c, 0x47, 0x7d, 0x43,
+	0xda, 0x39, 0xa8, 0x8c, 0x23, 0x72, 0x29, 0xd0, 0x6f, 0x58, 0xe2, 0x93, 0x35, 0x2d, 0x2f, 0x45,
+	0x2f, 0x85, 0x56, 0xe7, 0xd5, 0xbe, 0xaf, 0x01, 0xf4, 0x35, 0xe9, 0x60, 0x2e, 0x05, 0x4a, 0x85,
+	0xc7, 0x3c, 0x47, 0xbf, 0x69, 0x81, 0x8f, 0x57, 0x81, 0xd3, 0x6b, 0x55, 0xa5, 0xe7, 0xb2, 0x9b,
+	0xbe, 0x25, 0xdd, 0xe2, 0x6e, 0x7a, 0x06, 0x40, 0x7f, 0xd3, 0xf2, 0xfa, 0x37, 0xf2, 0x20, 0x39,
+	0x00, 0xa8, 0x7c, 0xdc, 0x2d, 0x2c, 0x67, 0x76, 0x3e, 0x7b, 0xa4, 0x5b, 0x1d, 0x24, 0xea, 0x93,
+	0x26, 0x4b, 0x12, 0x05, 0x68, 0x66, 0xcf, 0x1b, 0xb6, 0x26, 0x57, 0x57, 0x4a, 0x49, 0x3d, 0x96,
+	0x09, 0xd8, 0x59, 0x6a, 0x4d, 0x6c, 0x4c, 0xc7, 0xa4, 0x89, 0x5a, 0x2a, 0x96, 0x42, 0x31, 0x20,
+	0x0f, 0xd7, 0x14, 0x63, 0x86, 0x7a, 0xb4, 0x6d, 0x8a, 0xf8, 0xf6, 0xab, 0xdf, 0x9c, 0x3a, 0xbd,
+	0xab, 0xe7, 0xca, 0x3d, 0x7a, 0x7e, 0x31, 0x0f, 0xbc, 0xcb, 0x79, 0xe0, 0xfd, 0x9e, 0x07, 0xde,
+	0x97, 0x45, 0x50, 0xbb, 0x5c, 0x04, 0xb5, 0x1f, 0x8b, 0xa0, 0xf6, 0x7e, 0x90, 0x72, 0x7d, 0x7c,
+	0x76, 0x14, 0xc6, 0x32, 0x8b, 0x4a, 0xbb, 0xf5, 0xc9, 0x6c, 0x97, 0x3e, 0xcf, 0x01, 0x8f, 0x1a,
+	0x76, 0x8f, 0x9e, 0xfd, 0x1b, 0x00, 0x73, 0x27, 0x40, 0x4a, 0xc0, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -207,6 +264,76 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SponsoredFees) > 0 {
+		for iNdEx := len(m.SponsoredFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SponsoredFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Sponsorships) > 0 {
+		for iNdEx := len(m.Sponsorships) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sponsorships[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Permissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ActivatedForks) > 0 {
+		for iNdEx := len(m.ActivatedForks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActivatedForks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PendingForks) > 0 {
+		for iNdEx := len(m.PendingForks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingForks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Preinstalls) > 0 {
 		for iNdEx := len(m.Preinstalls) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -330,6 +457,36 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PendingForks) > 0 {
+		for _, e := range m.PendingForks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ActivatedForks) > 0 {
+		for _, e := range m.ActivatedForks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Permissions) > 0 {
+		for _, e := range m.Permissions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Sponsorships) > 0 {
+		for _, e := range m.Sponsorships {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SponsoredFees) > 0 {
+		for _, e := range m.SponsoredFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -492,6 +649,176 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingForks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingForks = append(m.PendingForks, ForkActivation{})
+			if err := m.PendingForks[len(m.PendingForks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivatedForks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivatedForks = append(m.ActivatedForks, ForkActivation{})
+			if err := m.ActivatedForks[len(m.ActivatedForks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, PermissionEntry{})
+			if err := m.Permissions[len(m.Permissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsorships", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsorships = append(m.Sponsorships, Sponsorship{})
+			if err := m.Sponsorships[len(m.Sponsorships)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SponsoredFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SponsoredFees = append(m.SponsoredFees, SponsoredFees{})
+			if err := m.SponsoredFees[len(m.SponsoredFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -81,6 +81,22 @@ type EvmHooks interface {
 	PostTxProcessing(ctx sdk.Context, sender common.Address, msg core.Message, receipt *ethtypes.Receipt) error
 }
 
+// EvmPreTxHooks are optional EvmHooks called before the execution of the evm txs
+type EvmPreTxHooks interface {
+	// Called before the tx is executed, before any state change. If it returns an error, the tx isn't executed
+	// and fails consuming its whole gas limit.
+	PreTxProcessing(ctx sdk.Context, sender common.Address, msg core.Message) error
+}
+
+// EvmCallHooks are optional EvmHooks called before every call of the evm executions, the top-level
+// call of the tx included.
+type EvmCallHooks interface {
+	// Called before a CALL, CALLCODE, DELEGATECALL or STATICCALL, with the context of the execution, which
+	// doesn't include the state changes of the execution yet. If it returns an error, the whole execution
+	// is reverted, even if the calling contract ignores the failure of the call.
+	PreCallProcessing(ctx sdk.Context, caller common.Address, recipient common.Address) error
+}
+
 // BankWrapper defines the methods required by the wrapper around
 // the Cosmos SDK x/bank keeper that is used to manage an EVM coin
 // with a configurable value for decimals.
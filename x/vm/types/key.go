@@ -30,12 +30,18 @@ const (
 	prefixParams
 	prefixCodeHash
 	prefixEvmCoinInfo
+	prefixPendingFork
+	prefixActivatedFork
+	prefixPermission
+	prefixSponsorship
+	prefixSponsoredFees
 )
 
 // prefix bytes for the EVM object store
 const (
 	prefixObjectBloom = iota + 1
 	prefixObjectGasUsed
+	prefixObjectTxSponsor
 )
 
 // KVStore key prefixes
@@ -45,12 +51,24 @@ var (
 	KeyPrefixParams      = []byte{prefixParams}
 	KeyPrefixCodeHash    = []byte{prefixCodeHash}
 	KeyPrefixEvmCoinInfo = []byte{prefixEvmCoinInfo}
+	// KeyPrefixPendingFork prefixes the fork activations scheduled by governance, by fork name
+	KeyPrefixPendingFork = []byte{prefixPendingFork}
+	// KeyPrefixActivatedFork prefixes the fork activations applied to the chain config, by fork name
+	KeyPrefixActivatedFork = []byte{prefixActivatedFork}
+	// KeyPrefixPermission prefixes the entries of the permission lists, by list, target and address
+	KeyPrefixPermission = []byte{prefixPermission}
+	// KeyPrefixSponsorship prefixes the sponsorships of the contracts, by contract address
+	KeyPrefixSponsorship = []byte{prefixSponsorship}
+	// KeyPrefixSponsoredFees prefixes the fees paid by the sponsors, by contract and sender address
+	KeyPrefixSponsoredFees = []byte{prefixSponsoredFees}
 )
 
 // Object Store key prefixes
 var (
 	KeyPrefixObjectBloom   = []byte{prefixObjectBloom}
 	KeyPrefixObjectGasUsed = []byte{prefixObjectGasUsed}
+	// KeyPrefixObjectTxSponsor prefixes the sponsor paying the fees of a tx, by tx index
+	KeyPrefixObjectTxSponsor = []byte{prefixObjectTxSponsor}
 )
 
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
@@ -77,3 +95,44 @@ func ObjectBloomKey(txIndex, msgIndex int) []byte {
 	binary.BigEndian.PutUint64(key[9:], uint64(msgIndex)) //nolint:gosec
 	return key[:]
 }
+
+// PermissionKey defines the key of an entry of a permission list. The target is only part of the
+// key of the lists with a target.
+func PermissionKey(list PermissionList, target, address common.Address) []byte {
+	if list.HasTarget() {
+		return append(targetPermissionPrefix(list, target), address.Bytes()...)
+	}
+	return append([]byte{prefixPermission, byte(list)}, address.Bytes()...)
+}
+
+// ContractPermissionPrefix returns a prefix to iterate over the call allowlist of a contract.
+func ContractPermissionPrefix(target common.Address) []byte {
+	return targetPermissionPrefix(PermissionListContractCallAllow, target)
+}
+
+func targetPermissionPrefix(list PermissionList, target common.Address) []byte {
+	return append([]byte{prefixPermission, byte(list)}, target.Bytes()...)
+}
+
+// ObjectTxSponsorKey defines the key of the sponsor paying the fees of the tx at the given index.
+func ObjectTxSponsorKey(txIndex int) []byte {
+	var key [1 + 8]byte
+	key[0] = prefixObjectTxSponsor
+	binary.BigEndian.PutUint64(key[1:], uint64(txIndex)) //nolint:gosec
+	return key[:]
+}
+
+// SponsorshipKey defines the key of the sponsorship of a contract.
+func SponsorshipKey(contract common.Address) []byte {
+	return append([]byte{prefixSponsorship}, contract.Bytes()...)
+}
+
+// SponsoredFeesPrefix returns a prefix to iterate over the fees paid by the sponsor of a contract.
+func SponsoredFeesPrefix(contract common.Address) []byte {
+	return append([]byte{prefixSponsoredFees}, contract.Bytes()...)
+}
+
+// SponsoredFeesKey defines the key of the fees paid by the sponsor of a contract for a sender.
+func SponsoredFeesKey(contract, sender common.Address) []byte {
+	return append(SponsoredFeesPrefix(contract), sender.Bytes()...)
+}
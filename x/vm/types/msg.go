@@ -13,6 +13,7 @@ import (
 	protov2 "google.golang.org/protobuf/proto"
 
 	evmapi "github.com/cosmos/evm/api/cosmos/evm/vm/v1"
+	"github.com/cosmos/evm/utils"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
@@ -34,6 +35,10 @@ var (
 	_ sdk.Tx     = &MsgEthereumTx{}
 	_ ante.GasTx = &MsgEthereumTx{}
 	_ sdk.Msg    = &MsgUpdateParams{}
+	_ sdk.Msg    = &MsgScheduleForkActivation{}
+	_ sdk.Msg    = &MsgUpdatePermissions{}
+	_ sdk.Msg    = &MsgSetSponsorship{}
+	_ sdk.Msg    = &MsgRemoveSponsorship{}
 )
 
 // message type and route constants
@@ -359,3 +364,82 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return AminoCdc.MustMarshalJSON(&m)
 }
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgScheduleForkActivation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.Activation.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgScheduleForkActivation) GetSignBytes() []byte {
+	return AminoCdc.MustMarshalJSON(&m)
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdatePermissions) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if len(m.Add) == 0 && len(m.Remove) == 0 {
+		return errorsmod.Wrap(ErrInvalidPermissionEntry, "no permission entry to add or remove")
+	}
+	for _, entry := range m.Add {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, entry := range m.Remove {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdatePermissions) GetSignBytes() []byte {
+	return AminoCdc.MustMarshalJSON(&m)
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSetSponsorship) ValidateBasic() error {
+	sponsor, err := sdk.AccAddressFromBech32(m.Sponsor)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid sponsor address")
+	}
+
+	return Sponsorship{
+		Sponsor:          common.BytesToAddress(sponsor).Hex(),
+		Contract:         m.Contract,
+		MaxGasPerTx:      m.MaxGasPerTx,
+		Budget:           m.Budget,
+		MaxFeePerGas:     m.MaxFeePerGas,
+		MaxFeesPerSender: m.MaxFeesPerSender,
+	}.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetSponsorship) GetSignBytes() []byte {
+	return AminoCdc.MustMarshalJSON(&m)
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRemoveSponsorship) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(err, "invalid signer address")
+	}
+	if err := utils.ValidateNonZeroAddress(m.Contract); err != nil {
+		return errorsmod.Wrapf(ErrInvalidSponsorship, "invalid contract: %s", err)
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRemoveSponsorship) GetSignBytes() []byte {
+	return AminoCdc.MustMarshalJSON(&m)
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/utils"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Validate performs a stateless validation of the permission entry
+func (pe PermissionEntry) Validate() error {
+	switch pe.List {
+	case PermissionListCreateAllow, PermissionListCreateDeny, PermissionListCallAllow, PermissionListCallDeny:
+		if pe.Target != "" {
+			return errorsmod.Wrapf(ErrInvalidPermissionEntry, "target %s set for %s, only %s and %s entries have a target", pe.Target, pe.List, PermissionListContractCallAllow, PermissionListSponsor)
+		}
+	case PermissionListContractCallAllow, PermissionListSponsor:
+		if err := utils.ValidateNonZeroAddress(pe.Target); err != nil {
+			return errorsmod.Wrapf(ErrInvalidPermissionEntry, "invalid target: %s", err)
+		}
+	default:
+		return errorsmod.Wrapf(ErrInvalidPermissionEntry, "unknown permission list %s", pe.List)
+	}
+	if err := utils.ValidateAddress(pe.Address); err != nil {
+		return errorsmod.Wrapf(ErrInvalidPermissionEntry, "invalid address: %s", err)
+	}
+	return nil
+}
+
+// HasTarget returns true if the entries of the permission list apply to a target contract. Besides
+// the contract call allowlists, this is the case of the sponsor list, which extends the permission
+// lists to approve the sponsors of the contracts.
+func (pl PermissionList) HasTarget() bool {
+	return pl == PermissionListContractCallAllow || pl == PermissionListSponsor
+}
+
+// Key returns the store key of the permission entry
+func (pe PermissionEntry) Key() []byte {
+	return PermissionKey(pe.List, common.HexToAddress(pe.Target), common.HexToAddress(pe.Address))
+}
+
+// ActiveAt returns true if the permission entry isn't expired at the given unix time
+func (pe PermissionEntry) ActiveAt(time uint64) bool {
+	return pe.ExpiresAt == 0 || time < pe.ExpiresAt
+}
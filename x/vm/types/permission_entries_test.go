@@ -0,0 +1,53 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPermissionEntryValidate(t *testing.T) {
+	address := common.Address{1}.Hex()
+	target := common.Address{2}.Hex()
+
+	testCases := []struct {
+		name   string
+		entry  PermissionEntry
+		expErr string
+	}{
+		{"valid deny entry", PermissionEntry{List: PermissionListCallDeny, Address: address}, ""},
+		{"valid contract entry", PermissionEntry{List: PermissionListContractCallAllow, Address: address, Target: target, ExpiresAt: 10}, ""},
+		{"unspecified list", PermissionEntry{Address: address}, "unknown permission list"},
+		{"invalid address", PermissionEntry{List: PermissionListCreateAllow, Address: "0x1"}, "invalid address"},
+		{"target of a global list", PermissionEntry{List: PermissionListCallAllow, Address: address, Target: target}, "only PERMISSION_LIST_CONTRACT_CALL_ALLOW and PERMISSION_LIST_SPONSOR entries"},
+		{"contract entry without target", PermissionEntry{List: PermissionListContractCallAllow, Address: address}, "invalid target"},
+		{"valid sponsor entry", PermissionEntry{List: PermissionListSponsor, Address: address, Target: target}, ""},
+		{"sponsor entry without target", PermissionEntry{List: PermissionListSponsor, Address: address}, "invalid target"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.entry.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrInvalidPermissionEntry)
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+}
+
+func TestGenesisDuplicatedPermissionEntries(t *testing.T) {
+	gs := DefaultGenesisState()
+	gs.Permissions = []PermissionEntry{
+		{List: PermissionListCallDeny, Address: "0x000000000000000000000000000000000000dEaD"},
+		{List: PermissionListCallAllow, Address: "0x000000000000000000000000000000000000dEaD"},
+	}
+	require.NoError(t, gs.Validate())
+
+	gs.Permissions = append(gs.Permissions, PermissionEntry{
+		List: PermissionListCallDeny, Address: "0x000000000000000000000000000000000000dead", ExpiresAt: 1,
+	})
+	require.ErrorContains(t, gs.Validate(), "duplicated permission entry")
+}
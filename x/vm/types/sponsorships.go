@@ -0,0 +1,64 @@
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/utils"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Validate performs a stateless validation of the sponsorship
+func (s Sponsorship) Validate() error {
+	if err := utils.ValidateNonZeroAddress(s.Sponsor); err != nil {
+		return errorsmod.Wrapf(ErrInvalidSponsorship, "invalid sponsor: %s", err)
+	}
+	if err := utils.ValidateNonZeroAddress(s.Contract); err != nil {
+		return errorsmod.Wrapf(ErrInvalidSponsorship, "invalid contract: %s", err)
+	}
+	if s.MaxGasPerTx == 0 {
+		return errorsmod.Wrap(ErrInvalidSponsorship, "max gas per tx must be positive")
+	}
+	if s.Budget.IsNil() || s.Budget.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidSponsorship, "invalid budget %s, must be non-negative", s.Budget)
+	}
+	if s.MaxFeePerGas.IsNil() || !s.MaxFeePerGas.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidSponsorship, "invalid max fee per gas %s, must be positive", s.MaxFeePerGas)
+	}
+	if s.MaxFeesPerSender.IsNil() || !s.MaxFeesPerSender.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidSponsorship, "invalid max fees per sender %s, must be positive", s.MaxFeesPerSender)
+	}
+	return nil
+}
+
+// Key returns the store key of the sponsorship
+func (s Sponsorship) Key() []byte {
+	return SponsorshipKey(common.HexToAddress(s.Contract))
+}
+
+// CoversGas returns true if the gas limit doesn't exceed the maximum gas per tx of the sponsorship
+// and if the fee cap doesn't exceed its maximum fee per gas
+func (s Sponsorship) CoversGas(gasLimit uint64, gasFeeCap *big.Int) bool {
+	return gasLimit <= s.MaxGasPerTx && gasFeeCap.Cmp(s.MaxFeePerGas.BigInt()) <= 0
+}
+
+// Validate performs a stateless validation of the sponsored fees
+func (sf SponsoredFees) Validate() error {
+	if err := utils.ValidateNonZeroAddress(sf.Contract); err != nil {
+		return errorsmod.Wrapf(ErrInvalidSponsorship, "invalid contract: %s", err)
+	}
+	if err := utils.ValidateAddress(sf.Sender); err != nil {
+		return errorsmod.Wrapf(ErrInvalidSponsorship, "invalid sender: %s", err)
+	}
+	if sf.Fees.IsNil() || !sf.Fees.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidSponsorship, "invalid fees %s, must be positive", sf.Fees)
+	}
+	return nil
+}
+
+// Key returns the store key of the sponsored fees
+func (sf SponsoredFees) Key() []byte {
+	return SponsoredFeesKey(common.HexToAddress(sf.Contract), common.HexToAddress(sf.Sender))
+}
@@ -0,0 +1,104 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+func TestSponsorshipValidate(t *testing.T) {
+	newSponsorship := func(malleate func(*Sponsorship)) Sponsorship {
+		s := Sponsorship{
+			Sponsor:          common.Address{1}.Hex(),
+			Contract:         common.Address{2}.Hex(),
+			MaxGasPerTx:      100_000,
+			Budget:           sdkmath.NewInt(1),
+			MaxFeePerGas:     sdkmath.NewInt(1e9),
+			MaxFeesPerSender: sdkmath.NewInt(1e18),
+		}
+		malleate(&s)
+		return s
+	}
+
+	testCases := []struct {
+		name        string
+		sponsorship Sponsorship
+		expErr      string
+	}{
+		{"valid sponsorship", newSponsorship(func(*Sponsorship) {}), ""},
+		{"empty budget", newSponsorship(func(s *Sponsorship) { s.Budget = sdkmath.ZeroInt() }), ""},
+		{"zero sponsor", newSponsorship(func(s *Sponsorship) { s.Sponsor = common.Address{}.Hex() }), "invalid sponsor"},
+		{"invalid contract", newSponsorship(func(s *Sponsorship) { s.Contract = "0x1" }), "invalid contract"},
+		{"zero max gas per tx", newSponsorship(func(s *Sponsorship) { s.MaxGasPerTx = 0 }), "max gas per tx"},
+		{"nil budget", newSponsorship(func(s *Sponsorship) { s.Budget = sdkmath.Int{} }), "invalid budget"},
+		{"negative budget", newSponsorship(func(s *Sponsorship) { s.Budget = sdkmath.NewInt(-1) }), "invalid budget"},
+		{"nil max fee per gas", newSponsorship(func(s *Sponsorship) { s.MaxFeePerGas = sdkmath.Int{} }), "invalid max fee per gas"},
+		{"zero max fee per gas", newSponsorship(func(s *Sponsorship) { s.MaxFeePerGas = sdkmath.ZeroInt() }), "invalid max fee per gas"},
+		{"zero max fees per sender", newSponsorship(func(s *Sponsorship) { s.MaxFeesPerSender = sdkmath.ZeroInt() }), "invalid max fees per sender"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.sponsorship.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrInvalidSponsorship)
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+}
+
+func TestSponsorshipCoversGas(t *testing.T) {
+	sponsorship := Sponsorship{MaxGasPerTx: 21000, MaxFeePerGas: sdkmath.NewInt(10)}
+	require.True(t, sponsorship.CoversGas(21000, big.NewInt(10)))
+	require.False(t, sponsorship.CoversGas(21001, big.NewInt(10)))
+	require.False(t, sponsorship.CoversGas(21000, big.NewInt(11)))
+	// a zero maximum gas per tx doesn't mean unlimited
+	require.False(t, Sponsorship{MaxFeePerGas: sdkmath.NewInt(10)}.CoversGas(1, big.NewInt(1)))
+}
+
+func TestGenesisDuplicatedSponsorships(t *testing.T) {
+	sponsorship := Sponsorship{
+		Sponsor:          "0x000000000000000000000000000000000000dEaD",
+		Contract:         common.Address{1}.Hex(),
+		MaxGasPerTx:      100_000,
+		Budget:           sdkmath.NewInt(1),
+		MaxFeePerGas:     sdkmath.NewInt(1),
+		MaxFeesPerSender: sdkmath.NewInt(1),
+	}
+	gs := DefaultGenesisState()
+	gs.Sponsorships = []Sponsorship{sponsorship}
+	require.NoError(t, gs.Validate())
+
+	sponsorship.Sponsor = "0x000000000000000000000000000000000000bEEF"
+	gs.Sponsorships = append(gs.Sponsorships, sponsorship)
+	require.ErrorContains(t, gs.Validate(), "duplicated sponsorship")
+}
+
+func TestGenesisSponsoredFees(t *testing.T) {
+	gs := DefaultGenesisState()
+	gs.Sponsorships = []Sponsorship{{
+		Sponsor:          "0x000000000000000000000000000000000000dEaD",
+		Contract:         common.Address{1}.Hex(),
+		MaxGasPerTx:      100_000,
+		Budget:           sdkmath.NewInt(1),
+		MaxFeePerGas:     sdkmath.NewInt(1),
+		MaxFeesPerSender: sdkmath.NewInt(1),
+	}}
+	gs.SponsoredFees = []SponsoredFees{{Contract: common.Address{1}.Hex(), Sender: common.Address{2}.Hex(), Fees: sdkmath.NewInt(1)}}
+	require.NoError(t, gs.Validate())
+
+	gs.SponsoredFees = append(gs.SponsoredFees, gs.SponsoredFees[0])
+	require.ErrorContains(t, gs.Validate(), "duplicated sponsored fees")
+
+	gs.SponsoredFees = []SponsoredFees{{Contract: common.Address{3}.Hex(), Sender: common.Address{2}.Hex(), Fees: sdkmath.NewInt(1)}}
+	require.ErrorContains(t, gs.Validate(), "without sponsorship")
+
+	gs.SponsoredFees = []SponsoredFees{{Contract: common.Address{1}.Hex(), Sender: common.Address{2}.Hex(), Fees: sdkmath.ZeroInt()}}
+	require.ErrorContains(t, gs.Validate(), "invalid fees")
+}
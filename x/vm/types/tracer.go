@@ -20,6 +20,26 @@ const (
 	TracerMarkdown   = "markdown"
 )
 
+// TracerConstructor creates the hooks of a tracer registered with RegisterTracer.
+type TracerConstructor func(msg core.Message, cfg *params.ChainConfig, height int64, timestamp uint64) *tracing.Hooks
+
+// tracers holds the tracers registered in addition to the built-in ones
+var tracers = map[string]TracerConstructor{}
+
+// RegisterTracer registers a tracer that can be selected by name with
+// NewTracer. It is meant to be called from the init function of the package
+// implementing the tracer and overrides any tracer registered with the same
+// name.
+func RegisterTracer(name string, ctor TracerConstructor) {
+	tracers[name] = ctor
+}
+
+// IsRegisteredTracer returns true if a tracer was registered with RegisterTracer under the name.
+func IsRegisteredTracer(name string) bool {
+	_, ok := tracers[name]
+	return ok
+}
+
 // NewTracer creates a new Logger tracer to collect execution traces from an
 // EVM transaction.
 func NewTracer(tracer string, msg core.Message, cfg *params.ChainConfig, height int64, timestamp uint64) *tracing.Hooks {
@@ -45,6 +65,9 @@ func NewTracer(tracer string, msg core.Message, cfg *params.ChainConfig, height
 	case TracerStruct:
 		return logger.NewStructLogger(logCfg).Hooks()
 	default:
+		if ctor, ok := tracers[tracer]; ok {
+			return ctor(msg, cfg, height, timestamp)
+		}
 		return NewNoOpTracer()
 	}
 }
@@ -665,6 +665,82 @@ func (mr *MockEvmHooksMockRecorder) PostTxProcessing(ctx, sender, msg, receipt a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostTxProcessing", reflect.TypeOf((*MockEvmHooks)(nil).PostTxProcessing), ctx, sender, msg, receipt)
 }
 
+// MockEvmPreTxHooks is a mock of EvmPreTxHooks interface.
+type MockEvmPreTxHooks struct {
+	ctrl     *gomock.Controller
+	recorder *MockEvmPreTxHooksMockRecorder
+	isgomock struct{}
+}
+
+// MockEvmPreTxHooksMockRecorder is the mock recorder for MockEvmPreTxHooks.
+type MockEvmPreTxHooksMockRecorder struct {
+	mock *MockEvmPreTxHooks
+}
+
+// NewMockEvmPreTxHooks creates a new mock instance.
+func NewMockEvmPreTxHooks(ctrl *gomock.Controller) *MockEvmPreTxHooks {
+	mock := &MockEvmPreTxHooks{ctrl: ctrl}
+	mock.recorder = &MockEvmPreTxHooksMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEvmPreTxHooks) EXPECT() *MockEvmPreTxHooksMockRecorder {
+	return m.recorder
+}
+
+// PreTxProcessing mocks base method.
+func (m *MockEvmPreTxHooks) PreTxProcessing(ctx types.Context, sender common.Address, msg core.Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreTxProcessing", ctx, sender, msg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PreTxProcessing indicates an expected call of PreTxProcessing.
+func (mr *MockEvmPreTxHooksMockRecorder) PreTxProcessing(ctx, sender, msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreTxProcessing", reflect.TypeOf((*MockEvmPreTxHooks)(nil).PreTxProcessing), ctx, sender, msg)
+}
+
+// MockEvmCallHooks is a mock of EvmCallHooks interface.
+type MockEvmCallHooks struct {
+	ctrl     *gomock.Controller
+	recorder *MockEvmCallHooksMockRecorder
+	isgomock struct{}
+}
+
+// MockEvmCallHooksMockRecorder is the mock recorder for MockEvmCallHooks.
+type MockEvmCallHooksMockRecorder struct {
+	mock *MockEvmCallHooks
+}
+
+// NewMockEvmCallHooks creates a new mock instance.
+func NewMockEvmCallHooks(ctrl *gomock.Controller) *MockEvmCallHooks {
+	mock := &MockEvmCallHooks{ctrl: ctrl}
+	mock.recorder = &MockEvmCallHooksMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEvmCallHooks) EXPECT() *MockEvmCallHooksMockRecorder {
+	return m.recorder
+}
+
+// PreCallProcessing mocks base method.
+func (m *MockEvmCallHooks) PreCallProcessing(ctx types.Context, caller, recipient common.Address) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreCallProcessing", ctx, caller, recipient)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PreCallProcessing indicates an expected call of PreCallProcessing.
+func (mr *MockEvmCallHooksMockRecorder) PreCallProcessing(ctx, caller, recipient any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreCallProcessing", reflect.TypeOf((*MockEvmCallHooks)(nil).PreCallProcessing), ctx, caller, recipient)
+}
+
 // MockBankWrapper is a mock of BankWrapper interface.
 type MockBankWrapper struct {
 	ctrl     *gomock.Controller